@@ -0,0 +1,37 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/domino14/scrabfutures/pkg/marketapi"
+	pb "github.com/domino14/scrabfutures/rpc/proto"
+)
+
+// audit prints every row that breaks one of the store's invariants, and
+// exits with status 1 if there are any, so that it can be run from cron.
+func audit(ctx context.Context, store marketapi.AuditStore, args []string) error {
+	fs := flag.NewFlagSet("audit", flag.ExitOnError)
+	fs.Parse(args)
+
+	resp, err := marketapi.NewAdminService(store).Audit(ctx, &pb.AuditRequest{})
+	if err != nil {
+		return err
+	}
+	if len(resp.Violations) == 0 {
+		fmt.Println("no violations")
+		return nil
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "INVARIANT\tMARKET\tSECURITY\tUSER\tEXPECTED\tACTUAL")
+	for _, v := range resp.Violations {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", v.Invariant, v.MarketId,
+			v.SecurityId, v.Username, v.Expected, v.Actual)
+	}
+	w.Flush()
+	os.Exit(1)
+	return nil
+}
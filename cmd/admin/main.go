@@ -0,0 +1,81 @@
+// Command admin runs administrative tasks directly against the market
+// database, which is configured with the DB_PATH and DB_MIGRATIONS_PATH
+// environment variables, or with DATABASE_URL for a Postgres database as for
+// the server.
+//
+// Usage:
+//
+//	admin resolve-tsh -market <market id> -file <path to .t file> [-dry-run] [-force]
+//	admin watch-tsh -market <market id> -dir <tsh directory> [-division a.t] [-interval 10s]
+//	admin audit
+//	admin replay -out <path to new database>
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/domino14/scrabfutures/pkg/marketapi"
+)
+
+const usage = `usage: admin <command> [flags]
+
+commands:
+  resolve-tsh    resolve a market from a tsh player file
+  watch-tsh      submit game results from a tsh directory as they come in
+  audit          report every row that doesn't add up in the books
+  replay         rebuild a SQLite database from its event log, and diff the two
+`
+
+// adminStore is what the commands need from the market database. Both
+// SqliteStore and PostgresStore are one.
+type adminStore interface {
+	marketapi.ResultsStore
+	marketapi.AuditStore
+}
+
+func openStore(cfg *marketapi.Config) (adminStore, error) {
+	if cfg.DatabaseURL != "" {
+		marketapi.EnsurePostgresMigrations(cfg)
+		return marketapi.NewPostgresStore(cfg.DatabaseURL)
+	}
+	marketapi.EnsureMigrations(cfg)
+	return marketapi.NewSqliteStore(cfg.DBPath)
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	cfg := &marketapi.Config{
+		DBMigrationsPath: os.Getenv("DB_MIGRATIONS_PATH"),
+		DBPath:           os.Getenv("DB_PATH"),
+		DatabaseURL:      os.Getenv("DATABASE_URL"),
+	}
+	store, err := openStore(cfg)
+	if err != nil {
+		log.Fatal().Err(err).Msg("open-store")
+	}
+	ctx := context.Background()
+
+	switch os.Args[1] {
+	case "resolve-tsh":
+		err = resolveTSH(ctx, store, os.Args[2:])
+	case "watch-tsh":
+		err = watchTSH(ctx, store, os.Args[2:])
+	case "audit":
+		err = audit(ctx, store, os.Args[2:])
+	case "replay":
+		err = replay(ctx, store, os.Args[2:])
+	default:
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	if err != nil {
+		log.Fatal().Err(err).Str("command", os.Args[1]).Msg("failed")
+	}
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/domino14/scrabfutures/pkg/marketapi"
+)
+
+// replay rebuilds the market database from its event log into a new
+// database, and prints everything that came out differently. If the live
+// database was broken by a bug, the replayed one can take its place once the
+// bug is fixed. Only SQLite databases can be replayed.
+func replay(ctx context.Context, store marketapi.Store, args []string) error {
+	fs := flag.NewFlagSet("replay", flag.ExitOnError)
+	out := fs.String("out", "", "where to write the replayed database; it must not exist yet")
+	fs.Parse(args)
+	if *out == "" {
+		fs.Usage()
+		return errors.New("out is required")
+	}
+	live, ok := store.(*marketapi.SqliteStore)
+	if !ok {
+		return errors.New("replay only works on a SQLite database; unset DATABASE_URL and set DB_PATH")
+	}
+	if _, err := os.Stat(*out); err == nil {
+		return fmt.Errorf("%s already exists", *out)
+	}
+
+	marketapi.EnsureMigrations(&marketapi.Config{
+		DBMigrationsPath: os.Getenv("DB_MIGRATIONS_PATH"),
+		DBPath:           *out,
+	})
+	fresh, err := marketapi.NewSqliteStore(*out)
+	if err != nil {
+		return err
+	}
+	diffs, err := live.Replay(ctx, fresh)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("replayed into %s\n", *out)
+	if len(diffs) == 0 {
+		fmt.Println("no differences")
+		return nil
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "TABLE\tKEY\tCOLUMN\tLIVE\tREPLAYED")
+	for _, d := range diffs {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", d.Table, d.Key, d.Column, d.Live, d.Replayed)
+	}
+	w.Flush()
+	os.Exit(1)
+	return nil
+}
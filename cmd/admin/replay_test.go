@@ -0,0 +1,18 @@
+package main
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/matryer/is"
+
+	"github.com/domino14/scrabfutures/pkg/marketapi"
+)
+
+func TestReplayNeedsSqlite(t *testing.T) {
+	is := is.New(t)
+	out := filepath.Join(t.TempDir(), "replayed.db")
+	err := replay(context.Background(), marketapi.NewMemoryStore(), []string{"-out", out})
+	is.True(err != nil)
+}
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/domino14/scrabfutures/pkg/marketapi"
+	"github.com/domino14/scrabfutures/pkg/tsh"
+	pb "github.com/domino14/scrabfutures/rpc/proto"
+)
+
+// resolveTSH resolves a market from the standings in a tsh player file.
+// Securities in exclusive markets are matched to tsh players by shortname,
+// and the players in ranking markets by name. It refuses to resolve while any
+// paired round is missing a score, since the standings aren't final yet,
+// unless -force is given.
+func resolveTSH(ctx context.Context, store marketapi.Store, args []string) error {
+	fs := flag.NewFlagSet("resolve-tsh", flag.ExitOnError)
+	marketID := fs.String("market", "", "the market to resolve")
+	file := fs.String("file", "", "the tsh .t file with the final results")
+	dryRun := fs.Bool("dry-run", false, "print the resolution without resolving")
+	force := fs.Bool("force", false, "resolve even if some rounds haven't been played")
+	fs.Parse(args)
+	if *marketID == "" || *file == "" {
+		fs.Usage()
+		return errors.New("market and file are required")
+	}
+
+	division, err := tsh.ParseFile(*file)
+	if err != nil {
+		return err
+	}
+	m, err := store.GetMarket(ctx, *marketID)
+	if err != nil {
+		return err
+	}
+	secs, err := store.GetSecurities(ctx, *marketID)
+	if err != nil {
+		return err
+	}
+	req, err := tshResolution(m, secs, division, *force)
+	if err != nil {
+		return err
+	}
+
+	for _, r := range req.Resolutions {
+		log.Info().Str("securityID", r.SecurityId).Bool("wins", r.Wins).Msg("resolution")
+	}
+	if len(req.Standings) > 0 {
+		log.Info().Strs("standings", req.Standings).Msg("resolution")
+	}
+	if *dryRun {
+		return nil
+	}
+	_, err = marketapi.NewAdminService(store).ResolveMarket(ctx, req)
+	return err
+}
+
+func tshResolution(m *pb.Market, secs []*pb.Security, division *tsh.Division, force bool) (*pb.ResolveMarketRequest, error) {
+	if unfinished := division.Unfinished(); len(unfinished) > 0 && !force {
+		names := []string{}
+		for _, p := range unfinished {
+			names = append(names, p.Name)
+		}
+		return nil, fmt.Errorf("the event isn't over; still missing scores for: %s",
+			strings.Join(names, "; "))
+	}
+	req := &pb.ResolveMarketRequest{MarketId: m.Id}
+
+	switch m.MarketType {
+	case pb.MarketType_EXCLUSIVE:
+		leaders := division.Leaders()
+		winners := 0
+		for _, sec := range secs {
+			wins := false
+			for _, p := range leaders {
+				if samePlayer(sec.Shortname, p.Name) {
+					wins = true
+					winners++
+				}
+			}
+			req.Resolutions = append(req.Resolutions,
+				&pb.ResolveMarketRequest_SecurityResolution{SecurityId: sec.Id, Wins: wins})
+		}
+		if winners == 0 {
+			names := []string{}
+			for _, p := range leaders {
+				names = append(names, p.Name)
+			}
+			return nil, fmt.Errorf("no security's shortname matches the winner(s): %s",
+				strings.Join(names, "; "))
+		}
+		if winners > 1 {
+			return nil, errors.New("more than one security matches the players tied for first; " +
+				"only one can win, so void the market instead")
+		}
+
+	case pb.MarketType_RANKING:
+		// The market's field is usually a subset of the division, so only
+		// keep the players in it.
+		for _, st := range division.Standings() {
+			for _, p := range m.Players {
+				if samePlayer(p, st.Player.Name) {
+					req.Standings = append(req.Standings, p)
+				}
+			}
+		}
+		if len(req.Standings) != len(m.Players) {
+			return nil, errors.New("not every player in the market was found in the tsh file")
+		}
+
+	default:
+		return nil, fmt.Errorf("cannot resolve %s markets from tsh results",
+			strings.ToLower(m.MarketType.String()))
+	}
+	return req, nil
+}
+
+func samePlayer(a, b string) bool {
+	return strings.EqualFold(strings.TrimSpace(a), strings.TrimSpace(b))
+}
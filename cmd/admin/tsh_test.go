@@ -0,0 +1,99 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/matryer/is"
+
+	"github.com/domino14/scrabfutures/pkg/tsh"
+	pb "github.com/domino14/scrabfutures/rpc/proto"
+)
+
+func parseDivision(is *is.I, file string) *tsh.Division {
+	d, err := tsh.Parse(strings.NewReader(file))
+	is.NoErr(err)
+	return d
+}
+
+const finished = `
+Matsumoto, Kenji  2050 2 3 ; 455 420
+Hercules, Noah    2000 1 0 ; 390 50
+del Solar, César  1850 0 1 ; 50 380
+`
+
+// Kenji and César haven't entered their round 2 scores.
+const midEvent = `
+Matsumoto, Kenji  2050 2 3 ; 455
+Hercules, Noah    2000 1 0 ; 390 50
+del Solar, César  1850 0 1 ; 50
+`
+
+// Kenji and Noah tied their only game.
+const tied = `
+Matsumoto, Kenji  2050 2 ; 400
+Hercules, Noah    2000 1 ; 400
+`
+
+func TestTSHResolutionExclusive(t *testing.T) {
+	is := is.New(t)
+	m := &pb.Market{Id: "m1", MarketType: pb.MarketType_EXCLUSIVE}
+	secs := []*pb.Security{
+		{Id: "s1", Shortname: "Matsumoto, Kenji"},
+		{Id: "s2", Shortname: "hercules, noah "},
+		{Id: "s3", Shortname: "del Solar, César"},
+	}
+	req, err := tshResolution(m, secs, parseDivision(is, finished), false)
+	is.NoErr(err)
+	is.Equal(req.MarketId, "m1")
+	is.Equal(len(req.Resolutions), 3)
+	is.True(req.Resolutions[0].Wins)
+	is.True(!req.Resolutions[1].Wins)
+	is.True(!req.Resolutions[2].Wins)
+
+	// Nobody in the market won.
+	_, err = tshResolution(m, secs[1:], parseDivision(is, finished), false)
+	is.Equal(err.Error(), "no security's shortname matches the winner(s): Matsumoto, Kenji")
+
+	// Only one security can win, so a tie for first can't be resolved.
+	_, err = tshResolution(m, secs, parseDivision(is, tied), false)
+	is.True(err != nil)
+	req, err = tshResolution(m, secs[1:], parseDivision(is, tied), false)
+	is.NoErr(err)
+	is.True(req.Resolutions[0].Wins)
+}
+
+func TestTSHResolutionRanking(t *testing.T) {
+	is := is.New(t)
+	m := &pb.Market{Id: "m1", MarketType: pb.MarketType_RANKING,
+		Players: []string{"del Solar, César", "Matsumoto, Kenji"}}
+	req, err := tshResolution(m, nil, parseDivision(is, finished), false)
+	is.NoErr(err)
+	is.Equal(req.Standings, []string{"Matsumoto, Kenji", "del Solar, César"})
+
+	m.Players = append(m.Players, "Weinstein, Josh")
+	_, err = tshResolution(m, nil, parseDivision(is, finished), false)
+	is.Equal(err.Error(), "not every player in the market was found in the tsh file")
+}
+
+func TestTSHResolutionMidEvent(t *testing.T) {
+	is := is.New(t)
+	m := &pb.Market{Id: "m1", MarketType: pb.MarketType_EXCLUSIVE}
+	secs := []*pb.Security{{Id: "s1", Shortname: "Matsumoto, Kenji"}}
+
+	// Kenji leads for now, but the event isn't over.
+	_, err := tshResolution(m, secs, parseDivision(is, midEvent), false)
+	is.Equal(err.Error(),
+		"the event isn't over; still missing scores for: Matsumoto, Kenji; del Solar, César")
+
+	req, err := tshResolution(m, secs, parseDivision(is, midEvent), true)
+	is.NoErr(err)
+	is.True(req.Resolutions[0].Wins)
+}
+
+func TestTSHResolutionUnsupported(t *testing.T) {
+	is := is.New(t)
+	m := &pb.Market{Id: "m1", MarketType: pb.MarketType_SCALAR}
+	_, err := tshResolution(m, nil, parseDivision(is, finished), false)
+	is.Equal(err.Error(), "cannot resolve scalar markets from tsh results")
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/domino14/scrabfutures/pkg/marketapi"
+	"github.com/domino14/scrabfutures/pkg/tsh"
+	pb "github.com/domino14/scrabfutures/rpc/proto"
+)
+
+// watchTSH polls a tsh directory and submits the results in a division's
+// player file whenever it changes, until interrupted.
+func watchTSH(ctx context.Context, store marketapi.ResultsStore, args []string) error {
+	fs := flag.NewFlagSet("watch-tsh", flag.ExitOnError)
+	marketID := fs.String("market", "", "the market the tournament is for")
+	dir := fs.String("dir", "", "the tsh directory")
+	division := fs.String("division", "", "the division's player file, e.g. a.t (optional if there is only one)")
+	interval := fs.Duration("interval", 10*time.Second, "how often to check for changes")
+	fs.Parse(args)
+	if *marketID == "" || *dir == "" {
+		fs.Usage()
+		return errors.New("market and dir are required")
+	}
+	path, err := divisionFile(*dir, *division)
+	if err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt)
+	defer stop()
+	admin := marketapi.NewAdminService(store)
+	ticker := time.NewTicker(*interval)
+	defer ticker.Stop()
+	var lastMod time.Time
+	log.Info().Str("file", path).Msg("watching")
+	for {
+		fi, err := os.Stat(path)
+		if err != nil {
+			log.Err(err).Msg("stat")
+		} else if fi.ModTime() != lastMod {
+			// tsh might be in the middle of writing the file, so if it
+			// can't be read, try again next time.
+			if err := submitTSHResults(ctx, admin, *marketID, path); err != nil {
+				log.Err(err).Str("file", path).Msg("submit-results")
+			} else {
+				lastMod = fi.ModTime()
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func divisionFile(dir, division string) (string, error) {
+	if division != "" {
+		return filepath.Join(dir, division), nil
+	}
+	files, err := filepath.Glob(filepath.Join(dir, "*.t"))
+	if err != nil {
+		return "", err
+	}
+	if len(files) != 1 {
+		return "", fmt.Errorf("found %d player files in %s; pick one with -division", len(files), dir)
+	}
+	return files[0], nil
+}
+
+func submitTSHResults(ctx context.Context, admin *marketapi.AdminService, marketID, path string) error {
+	division, err := tsh.ParseFile(path)
+	if err != nil {
+		return err
+	}
+	resp, err := admin.SubmitGameResults(ctx, &pb.SubmitGameResultsRequest{
+		MarketId: marketID,
+		Results:  tshResults(division),
+	})
+	if err != nil {
+		return err
+	}
+	log.Info().Int32("changed", resp.Changed).Msg("submitted-results")
+	return nil
+}
+
+// tshResults returns every game played in a division. Each game shows up
+// once for each player, which the store takes care of.
+func tshResults(division *tsh.Division) []*pb.GameResult {
+	results := []*pb.GameResult{}
+	for _, p := range division.Players {
+		for _, g := range division.Games(p) {
+			r := &pb.GameResult{
+				Round:         int32(g.Round),
+				Player:        p.Name,
+				PlayerScore:   int32(g.Score),
+				OpponentScore: int32(g.OpponentScore),
+			}
+			if g.Opponent != nil {
+				r.Opponent = g.Opponent.Name
+			}
+			results = append(results, r)
+		}
+	}
+	return results
+}
@@ -0,0 +1,68 @@
+// Command bot runs a fair-value trading bot against a market server.
+//
+// Usage:
+//
+//	bot -server http://localhost:8080 -username <user> -market <market id> \
+//		[-secret <API secret>] [-model rating|sim] [-rounds 7] \
+//		[-pairing swiss] [-budget 1000] [-edge 2] [-interval 30s]
+package main
+
+import (
+	"context"
+	"flag"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/domino14/scrabfutures/pkg/bot"
+	pb "github.com/domino14/scrabfutures/rpc/proto"
+)
+
+func main() {
+	server := flag.String("server", "http://localhost:8080", "market server URL")
+	username := flag.String("username", "", "user to trade as")
+	secret := flag.String("secret", os.Getenv("API_SECRET"), "the server's API secret (default $API_SECRET)")
+	market := flag.String("market", "", "market to trade in")
+	model := flag.String("model", "rating", "fair value model: rating or sim")
+	rounds := flag.Int("rounds", 7, "rounds in the tournament, for the sim model")
+	pairing := flag.String("pairing", "round_robin", "round_robin, swiss or king_of_the_hill, for the sim model")
+	budget := flag.Float64("budget", 1000, "most tokens to hold in the market")
+	edge := flag.Float64("edge", 2, "how far a price must be from fair value to trade it")
+	interval := flag.Duration("interval", 30*time.Second, "time between trades")
+	flag.Parse()
+	if *username == "" || *market == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	client := pb.NewMarketServiceProtobufClient(*server, http.DefaultClient)
+	var m bot.Model
+	switch *model {
+	case "rating":
+		m = bot.RatingModel{}
+	case "sim":
+		p, ok := pb.PairingSystem_value[strings.ToUpper(*pairing)]
+		if !ok {
+			log.Fatal().Str("pairing", *pairing).Msg("unknown-pairing")
+		}
+		m = bot.SimulationModel{Client: client, Rounds: int32(*rounds), Pairing: pb.PairingSystem(p)}
+	default:
+		log.Fatal().Str("model", *model).Msg("unknown-model")
+	}
+
+	r := &bot.Runner{
+		Client:   client,
+		Username: *username,
+		Secret:   *secret,
+		MarketID: *market,
+		Strategy: &bot.FairValue{Model: m, Budget: *budget, Edge: *edge},
+		Interval: *interval,
+	}
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	r.Run(ctx)
+}
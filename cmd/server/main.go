@@ -0,0 +1,92 @@
+// Command server serves the market API over Twirp. It is configured with
+// environment variables:
+//
+//	DB_PATH, DB_MIGRATIONS_PATH  the market database, as for the admin command
+//	DATABASE_URL                 a Postgres database to use instead of the
+//	                             SQLite one at DB_PATH. DB_MIGRATIONS_PATH
+//	                             should then point at migrations/postgres.
+//	LISTEN_ADDR                  where to serve the MarketService, and the
+//	                             event stream at /events (default
+//	                             localhost:8080)
+//	API_SECRET                   the secret MarketService callers must send
+//	                             in the X-Api-Secret header for their
+//	                             X-Username to be believed. Required unless
+//	                             TRUST_USERNAME_HEADER is set.
+//	TRUST_USERNAME_HEADER        set to true to believe X-Username without a
+//	                             secret, for local development and bots on
+//	                             the same machine. Anyone who can reach
+//	                             LISTEN_ADDR can then trade as anyone.
+//	ADMIN_LISTEN_ADDR            where to serve the AdminService (default
+//	                             localhost:8081). Keep this off the public
+//	                             network.
+//	SCHEDULER_INTERVAL           how often to open and close scheduled
+//	                             markets (default 10s)
+package main
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/domino14/scrabfutures/pkg/marketapi"
+	pb "github.com/domino14/scrabfutures/rpc/proto"
+)
+
+func getenv(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
+
+func openStore(cfg *marketapi.Config) (marketapi.Store, error) {
+	if cfg.DatabaseURL != "" {
+		marketapi.EnsurePostgresMigrations(cfg)
+		return marketapi.NewPostgresStore(cfg.DatabaseURL)
+	}
+	marketapi.EnsureMigrations(cfg)
+	return marketapi.NewSqliteStore(cfg.DBPath)
+}
+
+func main() {
+	cfg := &marketapi.Config{
+		DBMigrationsPath: os.Getenv("DB_MIGRATIONS_PATH"),
+		DBPath:           os.Getenv("DB_PATH"),
+		DatabaseURL:      os.Getenv("DATABASE_URL"),
+	}
+	store, err := openStore(cfg)
+	if err != nil {
+		log.Fatal().Err(err).Msg("open-store")
+	}
+
+	interval := marketapi.DefaultSchedulerInterval
+	if v := os.Getenv("SCHEDULER_INTERVAL"); v != "" {
+		interval, err = time.ParseDuration(v)
+		if err != nil || interval <= 0 {
+			log.Fatal().Str("interval", v).Msg("bad-scheduler-interval")
+		}
+	}
+	go marketapi.NewScheduler(store, interval).Run(context.Background())
+
+	adminAddr := getenv("ADMIN_LISTEN_ADDR", "localhost:8081")
+	adminHandler := pb.NewAdminServiceServer(marketapi.NewAdminService(store))
+	go func() {
+		log.Info().Str("addr", adminAddr).Msg("serving-admin")
+		log.Fatal().Err(http.ListenAndServe(adminAddr, adminHandler)).Msg("admin-server")
+	}()
+
+	secret := os.Getenv("API_SECRET")
+	if secret == "" && os.Getenv("TRUST_USERNAME_HEADER") != "true" {
+		log.Fatal().Msg("API_SECRET must be set, or TRUST_USERNAME_HEADER=true to trust X-Username without one")
+	}
+	addr := getenv("LISTEN_ADDR", "localhost:8080")
+	handler := pb.NewMarketServiceServer(marketapi.NewMarketService(store))
+	mux := http.NewServeMux()
+	mux.Handle(handler.PathPrefix(), marketapi.UsernameMiddleware(secret, handler))
+	mux.Handle(marketapi.EventStreamPath, marketapi.NewEventStream(store.Events()))
+	log.Info().Str("addr", addr).Msg("serving")
+	log.Fatal().Err(http.ListenAndServe(addr, mux)).Msg("server")
+}
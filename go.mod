@@ -3,7 +3,9 @@ module github.com/domino14/scrabfutures
 go 1.18
 
 require (
+	github.com/fergusstrange/embedded-postgres v1.30.0
 	github.com/golang-migrate/migrate/v4 v4.15.2
+	github.com/lib/pq v1.10.9
 	github.com/lithammer/shortuuid v3.0.0+incompatible
 	github.com/matryer/is v1.4.0
 	github.com/mattn/go-sqlite3 v1.14.14
@@ -18,6 +20,7 @@ require (
 	github.com/hashicorp/go-multierror v1.1.1 // indirect
 	github.com/mattn/go-colorable v0.1.12 // indirect
 	github.com/mattn/go-isatty v0.0.14 // indirect
+	github.com/xi2/xz v0.0.0-20171230120015-48954b6210f8 // indirect
 	go.uber.org/atomic v1.7.0 // indirect
 	golang.org/x/sys v0.0.0-20220317061510-51cd9980dadf // indirect
 )
@@ -0,0 +1,83 @@
+-- every change to a token balance moves amount micro-tokens from one account
+-- to another. accounts are 'house', 'user:<username>' or 'market:<uuid>'.
+-- portfolios.tokens is kept in step with it.
+CREATE TABLE IF NOT EXISTS ledger (
+    id INTEGER PRIMARY KEY,
+    uuid TEXT UNIQUE,
+    kind TEXT, -- grant, trade, fee, payout, refund or transfer
+    from_account TEXT,
+    to_account TEXT,
+    amount INTEGER, -- always positive
+    market_id INTEGER,
+    order_id INTEGER,
+    date TEXT,
+    FOREIGN KEY (market_id) REFERENCES markets(id) ON DELETE SET NULL,
+    FOREIGN KEY (order_id) REFERENCES orders(id) ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS ledger_from_account_index ON ledger(from_account);
+CREATE INDEX IF NOT EXISTS ledger_to_account_index ON ledger(to_account);
+
+-- write the history of the balances we already have. each user was granted
+-- what they have now, plus what they spent, less what they were paid.
+INSERT INTO ledger(uuid, kind, from_account, to_account, amount, market_id,
+    order_id, date)
+SELECT lower(hex(randomblob(16))), kind,
+    CASE WHEN amount > 0 THEN from_account ELSE to_account END,
+    CASE WHEN amount > 0 THEN to_account ELSE from_account END,
+    abs(amount), market_id, order_id, date
+FROM (
+    SELECT 0 AS seq, users.id AS row_id, 'grant' AS kind,
+        'house' AS from_account, 'user:' || users.username AS to_account,
+        portfolios.tokens
+            + COALESCE((SELECT SUM(cost) FROM orders WHERE user_id = users.id), 0)
+            - COALESCE((SELECT SUM(payout) FROM payouts WHERE user_id = users.id), 0)
+            AS amount,
+        NULL AS market_id, NULL AS order_id,
+        COALESCE((SELECT MIN(date) FROM orders WHERE user_id = users.id),
+            strftime('%Y-%m-%dT%H:%M:%SZ', 'now')) AS date
+    FROM users
+    JOIN portfolios ON portfolios.user_id = users.id
+
+    UNION ALL
+
+    SELECT 1, orders.id, 'trade', 'user:' || users.username,
+        'market:' || markets.uuid, orders.cost, markets.id, orders.id,
+        orders.date
+    FROM orders
+    JOIN users ON orders.user_id = users.id
+    JOIN securities ON orders.security_id = securities.id
+    JOIN markets ON securities.market_id = markets.id
+
+    UNION ALL
+
+    SELECT 2, payouts.rowid,
+        CASE WHEN markets.voided = 1 THEN 'refund' ELSE 'payout' END,
+        'market:' || markets.uuid, 'user:' || users.username, payouts.payout,
+        markets.id, NULL, payouts.date
+    FROM payouts
+    JOIN users ON payouts.user_id = users.id
+    JOIN securities ON payouts.security_id = securities.id
+    JOIN markets ON securities.market_id = markets.id
+)
+WHERE amount != 0
+ORDER BY date, seq, row_id;
+
+-- and settle whatever is left in the escrow of markets that are done.
+INSERT INTO ledger(uuid, kind, from_account, to_account, amount, market_id,
+    date)
+SELECT lower(hex(randomblob(16))), 'transfer',
+    CASE WHEN escrow > 0 THEN account ELSE 'house' END,
+    CASE WHEN escrow > 0 THEN 'house' ELSE account END,
+    abs(escrow), id, date_resolved
+FROM (
+    SELECT markets.id, markets.date_resolved, 'market:' || markets.uuid AS account,
+        COALESCE((SELECT SUM(amount) FROM ledger
+            WHERE to_account = 'market:' || markets.uuid), 0)
+        - COALESCE((SELECT SUM(amount) FROM ledger
+            WHERE from_account = 'market:' || markets.uuid), 0) AS escrow
+    FROM markets
+    WHERE markets.date_resolved IS NOT NULL
+)
+WHERE escrow != 0
+ORDER BY date_resolved, id;
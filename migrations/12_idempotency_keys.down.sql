@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS orders_idempotency_key_index;
+ALTER TABLE orders DROP COLUMN idempotency_key;
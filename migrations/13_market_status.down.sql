@@ -0,0 +1,7 @@
+-- halted and scheduled markets come back closed and unopened.
+ALTER TABLE markets ADD COLUMN is_open TINYINT;
+UPDATE markets SET is_open = CASE WHEN status = 1 THEN 1 ELSE 0 END;
+
+ALTER TABLE markets DROP COLUMN close_at;
+ALTER TABLE markets DROP COLUMN open_at;
+ALTER TABLE markets DROP COLUMN status;
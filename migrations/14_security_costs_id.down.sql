@@ -0,0 +1,15 @@
+CREATE TABLE security_costs_old (
+    security_id INTEGER,
+    cost REAL,
+    date TEXT,
+    FOREIGN KEY(security_id) REFERENCES securities
+);
+
+INSERT INTO security_costs_old(rowid, security_id, cost, date)
+SELECT id, security_id, cost, date FROM security_costs;
+
+DROP INDEX IF EXISTS security_costs_date_index;
+DROP TABLE security_costs;
+ALTER TABLE security_costs_old RENAME TO security_costs;
+
+CREATE INDEX IF NOT EXISTS security_costs_date_index ON security_costs(date);
@@ -0,0 +1,19 @@
+-- give every price an id, as the postgres schema does, so that prices set in
+-- the same second are ordered the same way in both. the ids are the rowids
+-- the prices already had.
+CREATE TABLE security_costs_new (
+    id INTEGER PRIMARY KEY,
+    security_id INTEGER,
+    cost REAL,
+    date TEXT,
+    FOREIGN KEY(security_id) REFERENCES securities
+);
+
+INSERT INTO security_costs_new(id, security_id, cost, date)
+SELECT rowid, security_id, cost, date FROM security_costs;
+
+DROP INDEX IF EXISTS security_costs_date_index;
+DROP TABLE security_costs;
+ALTER TABLE security_costs_new RENAME TO security_costs;
+
+CREATE INDEX IF NOT EXISTS security_costs_date_index ON security_costs(date);
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS payouts;
+
+ALTER TABLE securities DROP COLUMN payout;
+ALTER TABLE markets DROP COLUMN date_resolved;
+ALTER TABLE markets DROP COLUMN market_type;
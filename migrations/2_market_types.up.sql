@@ -0,0 +1,18 @@
+-- market_type is one of the MarketType enum values in market.proto.
+ALTER TABLE markets ADD COLUMN market_type TINYINT NOT NULL DEFAULT 0;
+ALTER TABLE markets ADD COLUMN date_resolved TEXT;
+
+-- how many tokens one share of this security paid out when its market
+-- resolved. NULL until then.
+ALTER TABLE securities ADD COLUMN payout REAL;
+
+-- a record of every position that was settled when a market resolved.
+CREATE TABLE IF NOT EXISTS payouts (
+    user_id INTEGER,
+    security_id INTEGER,
+    amount REAL, -- how many securities were held
+    payout REAL, -- total tokens paid out for them
+    date TEXT,
+    FOREIGN KEY (user_id) REFERENCES users(id),
+    FOREIGN KEY (security_id) REFERENCES securities(id)
+);
@@ -0,0 +1,2 @@
+ALTER TABLE markets DROP COLUMN upper_bound;
+ALTER TABLE markets DROP COLUMN lower_bound;
@@ -0,0 +1,3 @@
+-- the range of a scalar market. NULL for other market types.
+ALTER TABLE markets ADD COLUMN lower_bound REAL;
+ALTER TABLE markets ADD COLUMN upper_bound REAL;
@@ -0,0 +1,2 @@
+ALTER TABLE markets DROP COLUMN voided;
+ALTER TABLE markets DROP COLUMN condition_security_id;
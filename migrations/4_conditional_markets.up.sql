@@ -0,0 +1,4 @@
+-- a conditional market is voided unless this security (in another market)
+-- wins.
+ALTER TABLE markets ADD COLUMN condition_security_id INTEGER;
+ALTER TABLE markets ADD COLUMN voided TINYINT NOT NULL DEFAULT 0;
@@ -0,0 +1,5 @@
+ALTER TABLE securities DROP COLUMN positions;
+ALTER TABLE securities DROP COLUMN player_idx;
+
+DROP INDEX IF EXISTS market_players_uniq;
+DROP TABLE IF EXISTS market_players;
@@ -0,0 +1,15 @@
+-- the field of players in a ranking market.
+CREATE TABLE IF NOT EXISTS market_players (
+    market_id INTEGER,
+    idx INTEGER, -- the player's position in the list of players
+    name TEXT,
+    FOREIGN KEY (market_id) REFERENCES markets(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS market_players_uniq ON market_players(market_id, idx);
+
+-- securities in ranking markets are predicates: that the player finishes in
+-- one of the positions, which are stored as comma-separated, 1-indexed
+-- positions.
+ALTER TABLE securities ADD COLUMN player_idx INTEGER;
+ALTER TABLE securities ADD COLUMN positions TEXT;
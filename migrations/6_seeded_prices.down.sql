@@ -0,0 +1,2 @@
+ALTER TABLE securities DROP COLUMN rating;
+ALTER TABLE securities DROP COLUMN seed_shares;
@@ -0,0 +1,6 @@
+-- the shares that a security's market opened with, so that its opening price
+-- matched an initial probability. These are included in shares_outstanding
+-- but are not held by anyone.
+ALTER TABLE securities ADD COLUMN seed_shares REAL NOT NULL DEFAULT 0;
+-- the rating that the initial probability was calculated from, if any.
+ALTER TABLE securities ADD COLUMN rating REAL;
@@ -0,0 +1,16 @@
+-- the results of games in the tournament that a market is on, as they come
+-- in. each game is stored once, with the players in alphabetical order; a
+-- bye has an empty opponent.
+CREATE TABLE IF NOT EXISTS game_results (
+    id INTEGER PRIMARY KEY autoincrement,
+    market_id INTEGER,
+    round INTEGER,
+    player TEXT,
+    opponent TEXT,
+    player_score INTEGER,
+    opponent_score INTEGER,
+    date TEXT,
+    FOREIGN KEY (market_id) REFERENCES markets(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS game_results_uniq ON game_results(market_id, round, player, opponent);
@@ -0,0 +1,18 @@
+-- security_costs used to be logged with the security's uuid instead of its
+-- id. point those rows at the right security.
+UPDATE security_costs
+SET security_id = (SELECT id FROM securities WHERE uuid = security_costs.security_id)
+WHERE security_id IN (SELECT uuid FROM securities);
+
+-- how well a resolved market's prices predicted its outcome. checkpoint is
+-- one of open, final_day, close or time_weighted.
+CREATE TABLE IF NOT EXISTS market_scores (
+    market_id INTEGER,
+    checkpoint TEXT,
+    brier REAL,
+    log_loss REAL,
+    date_scored TEXT,
+    FOREIGN KEY (market_id) REFERENCES markets(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS market_scores_uniq ON market_scores(market_id, checkpoint);
@@ -0,0 +1,39 @@
+ALTER TABLE portfolios ADD COLUMN tokens_new REAL;
+UPDATE portfolios SET tokens_new = tokens / 1000000.0;
+ALTER TABLE portfolios DROP COLUMN tokens;
+ALTER TABLE portfolios RENAME COLUMN tokens_new TO tokens;
+
+ALTER TABLE portfolio_securities ADD COLUMN amount_new REAL;
+UPDATE portfolio_securities SET amount_new = amount / 1000000.0;
+ALTER TABLE portfolio_securities DROP COLUMN amount;
+ALTER TABLE portfolio_securities RENAME COLUMN amount_new TO amount;
+
+ALTER TABLE orders ADD COLUMN amount_new REAL;
+UPDATE orders SET amount_new = amount / 1000000.0;
+ALTER TABLE orders DROP COLUMN amount;
+ALTER TABLE orders RENAME COLUMN amount_new TO amount;
+
+ALTER TABLE orders ADD COLUMN cost_new REAL;
+UPDATE orders SET cost_new = cost / 1000000.0;
+ALTER TABLE orders DROP COLUMN cost;
+ALTER TABLE orders RENAME COLUMN cost_new TO cost;
+
+ALTER TABLE securities ADD COLUMN shares_outstanding_new REAL;
+UPDATE securities SET shares_outstanding_new = shares_outstanding / 1000000.0;
+ALTER TABLE securities DROP COLUMN shares_outstanding;
+ALTER TABLE securities RENAME COLUMN shares_outstanding_new TO shares_outstanding;
+
+ALTER TABLE securities ADD COLUMN seed_shares_new REAL NOT NULL DEFAULT 0;
+UPDATE securities SET seed_shares_new = seed_shares / 1000000.0;
+ALTER TABLE securities DROP COLUMN seed_shares;
+ALTER TABLE securities RENAME COLUMN seed_shares_new TO seed_shares;
+
+ALTER TABLE payouts ADD COLUMN amount_new REAL;
+UPDATE payouts SET amount_new = amount / 1000000.0;
+ALTER TABLE payouts DROP COLUMN amount;
+ALTER TABLE payouts RENAME COLUMN amount_new TO amount;
+
+ALTER TABLE payouts ADD COLUMN payout_new REAL;
+UPDATE payouts SET payout_new = payout / 1000000.0;
+ALTER TABLE payouts DROP COLUMN payout;
+ALTER TABLE payouts RENAME COLUMN payout_new TO payout;
@@ -0,0 +1,43 @@
+-- tokens and shares are counted in whole micro-units (a millionth of a token
+-- or a share) from now on, so that balances add up exactly. prices, and
+-- what a share pays out, are still REAL.
+
+ALTER TABLE portfolios ADD COLUMN tokens_new INTEGER;
+UPDATE portfolios SET tokens_new = CAST(ROUND(tokens * 1000000) AS INTEGER);
+ALTER TABLE portfolios DROP COLUMN tokens;
+ALTER TABLE portfolios RENAME COLUMN tokens_new TO tokens;
+
+ALTER TABLE portfolio_securities ADD COLUMN amount_new INTEGER;
+UPDATE portfolio_securities SET amount_new = CAST(ROUND(amount * 1000000) AS INTEGER);
+ALTER TABLE portfolio_securities DROP COLUMN amount;
+ALTER TABLE portfolio_securities RENAME COLUMN amount_new TO amount;
+
+ALTER TABLE orders ADD COLUMN amount_new INTEGER;
+UPDATE orders SET amount_new = CAST(ROUND(amount * 1000000) AS INTEGER);
+ALTER TABLE orders DROP COLUMN amount;
+ALTER TABLE orders RENAME COLUMN amount_new TO amount;
+
+ALTER TABLE orders ADD COLUMN cost_new INTEGER;
+UPDATE orders SET cost_new = CAST(ROUND(cost * 1000000) AS INTEGER);
+ALTER TABLE orders DROP COLUMN cost;
+ALTER TABLE orders RENAME COLUMN cost_new TO cost;
+
+ALTER TABLE securities ADD COLUMN shares_outstanding_new INTEGER;
+UPDATE securities SET shares_outstanding_new = CAST(ROUND(shares_outstanding * 1000000) AS INTEGER);
+ALTER TABLE securities DROP COLUMN shares_outstanding;
+ALTER TABLE securities RENAME COLUMN shares_outstanding_new TO shares_outstanding;
+
+ALTER TABLE securities ADD COLUMN seed_shares_new INTEGER NOT NULL DEFAULT 0;
+UPDATE securities SET seed_shares_new = CAST(ROUND(seed_shares * 1000000) AS INTEGER);
+ALTER TABLE securities DROP COLUMN seed_shares;
+ALTER TABLE securities RENAME COLUMN seed_shares_new TO seed_shares;
+
+ALTER TABLE payouts ADD COLUMN amount_new INTEGER;
+UPDATE payouts SET amount_new = CAST(ROUND(amount * 1000000) AS INTEGER);
+ALTER TABLE payouts DROP COLUMN amount;
+ALTER TABLE payouts RENAME COLUMN amount_new TO amount;
+
+ALTER TABLE payouts ADD COLUMN payout_new INTEGER;
+UPDATE payouts SET payout_new = CAST(ROUND(payout * 1000000) AS INTEGER);
+ALTER TABLE payouts DROP COLUMN payout;
+ALTER TABLE payouts RENAME COLUMN payout_new TO payout;
@@ -0,0 +1,12 @@
+DROP TABLE IF EXISTS market_scores;
+DROP TABLE IF EXISTS game_results;
+DROP TABLE IF EXISTS payouts;
+DROP TABLE IF EXISTS security_costs;
+DROP TABLE IF EXISTS portfolio_securities;
+DROP TABLE IF EXISTS orders;
+ALTER TABLE IF EXISTS markets DROP CONSTRAINT IF EXISTS markets_condition_fk;
+DROP TABLE IF EXISTS securities;
+DROP TABLE IF EXISTS market_players;
+DROP TABLE IF EXISTS markets;
+DROP TABLE IF EXISTS portfolios;
+DROP TABLE IF EXISTS users;
@@ -0,0 +1,135 @@
+-- the postgres schema matches the sqlite one in the parent directory, as of
+-- its 8_market_scores migration. all dates are RFC3339 text, so that they
+-- compare the same way in both.
+CREATE TABLE IF NOT EXISTS users (
+    id BIGSERIAL PRIMARY KEY,
+    username TEXT UNIQUE,
+    email TEXT,
+    password TEXT
+);
+
+CREATE TABLE IF NOT EXISTS portfolios (
+    user_id BIGINT PRIMARY KEY REFERENCES users(id),
+    tokens DOUBLE PRECISION
+);
+
+CREATE TABLE IF NOT EXISTS markets (
+    id BIGSERIAL PRIMARY KEY,
+    uuid TEXT UNIQUE,
+    description TEXT,
+    date_created TEXT,
+    is_open BOOLEAN NOT NULL DEFAULT FALSE,
+    date_closed TEXT,
+    -- one of the MarketType enum values in market.proto.
+    market_type SMALLINT NOT NULL DEFAULT 0,
+    date_resolved TEXT,
+    -- the range of a scalar market. NULL for other market types.
+    lower_bound DOUBLE PRECISION,
+    upper_bound DOUBLE PRECISION,
+    -- a conditional market is voided unless this security (in another
+    -- market) wins. the foreign key is added once securities exists.
+    condition_security_id BIGINT,
+    voided BOOLEAN NOT NULL DEFAULT FALSE
+);
+
+-- the field of players in a ranking market.
+CREATE TABLE IF NOT EXISTS market_players (
+    market_id BIGINT REFERENCES markets(id) ON DELETE CASCADE,
+    idx INTEGER, -- the player's position in the list of players
+    name TEXT,
+    PRIMARY KEY (market_id, idx)
+);
+
+CREATE TABLE IF NOT EXISTS securities (
+    id BIGSERIAL PRIMARY KEY,
+    uuid TEXT UNIQUE,
+    description TEXT,
+    shortname TEXT,
+    date_created TEXT,
+    market_id BIGINT REFERENCES markets(id) ON DELETE CASCADE,
+    shares_outstanding DOUBLE PRECISION,
+    last_price DOUBLE PRECISION,
+    -- how many tokens one share paid out when its market resolved. NULL
+    -- until then.
+    payout DOUBLE PRECISION,
+    -- securities in ranking markets are predicates: that the player finishes
+    -- in one of the positions, which are stored as comma-separated, 1-indexed
+    -- positions.
+    player_idx INTEGER,
+    positions TEXT,
+    -- the shares that the security's market opened with. these are included
+    -- in shares_outstanding but are not held by anyone.
+    seed_shares DOUBLE PRECISION NOT NULL DEFAULT 0,
+    -- the rating that the opening price was calculated from, if any.
+    rating DOUBLE PRECISION
+);
+
+CREATE INDEX IF NOT EXISTS securities_market_index ON securities(market_id);
+
+ALTER TABLE markets ADD CONSTRAINT markets_condition_fk
+    FOREIGN KEY (condition_security_id) REFERENCES securities(id);
+
+CREATE TABLE IF NOT EXISTS orders (
+    id BIGSERIAL PRIMARY KEY,
+    uuid TEXT UNIQUE,
+    user_id BIGINT REFERENCES users(id),
+    security_id BIGINT REFERENCES securities(id) ON DELETE CASCADE,
+    amount DOUBLE PRECISION, -- how many securities
+    cost DOUBLE PRECISION,   -- total cost (negative if sale)
+    date TEXT
+);
+
+CREATE INDEX IF NOT EXISTS orders_security_index ON orders(security_id);
+
+CREATE TABLE IF NOT EXISTS portfolio_securities (
+    user_id BIGINT REFERENCES users(id),
+    security_id BIGINT REFERENCES securities(id) ON DELETE CASCADE,
+    amount DOUBLE PRECISION,
+    PRIMARY KEY (user_id, security_id)
+);
+
+-- a log of all the prices for a security.
+CREATE TABLE IF NOT EXISTS security_costs (
+    id BIGSERIAL PRIMARY KEY,
+    security_id BIGINT REFERENCES securities(id) ON DELETE CASCADE,
+    cost DOUBLE PRECISION,
+    date TEXT
+);
+
+CREATE INDEX IF NOT EXISTS security_costs_security_date_index ON security_costs(security_id, date);
+
+-- a record of every position that was settled when a market resolved or was
+-- voided.
+CREATE TABLE IF NOT EXISTS payouts (
+    user_id BIGINT REFERENCES users(id),
+    security_id BIGINT REFERENCES securities(id),
+    amount DOUBLE PRECISION, -- how many securities were held
+    payout DOUBLE PRECISION, -- total tokens paid out for them
+    date TEXT
+);
+
+-- the results of games in the tournament that a market is on. each game is
+-- stored once, with the players in alphabetical order; a bye has an empty
+-- opponent.
+CREATE TABLE IF NOT EXISTS game_results (
+    id BIGSERIAL PRIMARY KEY,
+    market_id BIGINT REFERENCES markets(id) ON DELETE CASCADE,
+    round INTEGER,
+    player TEXT,
+    opponent TEXT,
+    player_score INTEGER,
+    opponent_score INTEGER,
+    date TEXT,
+    UNIQUE (market_id, round, player, opponent)
+);
+
+-- how well a resolved market's prices predicted its outcome. checkpoint is
+-- one of open, final_day, close or time_weighted.
+CREATE TABLE IF NOT EXISTS market_scores (
+    market_id BIGINT REFERENCES markets(id) ON DELETE CASCADE,
+    checkpoint TEXT,
+    brier DOUBLE PRECISION,
+    log_loss DOUBLE PRECISION,
+    date_scored TEXT,
+    PRIMARY KEY (market_id, checkpoint)
+);
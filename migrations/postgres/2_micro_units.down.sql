@@ -0,0 +1,8 @@
+ALTER TABLE portfolios ALTER COLUMN tokens TYPE DOUBLE PRECISION USING tokens / 1000000.0;
+ALTER TABLE portfolio_securities ALTER COLUMN amount TYPE DOUBLE PRECISION USING amount / 1000000.0;
+ALTER TABLE orders ALTER COLUMN amount TYPE DOUBLE PRECISION USING amount / 1000000.0;
+ALTER TABLE orders ALTER COLUMN cost TYPE DOUBLE PRECISION USING cost / 1000000.0;
+ALTER TABLE securities ALTER COLUMN shares_outstanding TYPE DOUBLE PRECISION USING shares_outstanding / 1000000.0;
+ALTER TABLE securities ALTER COLUMN seed_shares TYPE DOUBLE PRECISION USING seed_shares / 1000000.0;
+ALTER TABLE payouts ALTER COLUMN amount TYPE DOUBLE PRECISION USING amount / 1000000.0;
+ALTER TABLE payouts ALTER COLUMN payout TYPE DOUBLE PRECISION USING payout / 1000000.0;
@@ -0,0 +1,12 @@
+-- tokens and shares are counted in whole micro-units (a millionth of a token
+-- or a share) from now on, so that balances add up exactly. prices, and
+-- what a share pays out, are still DOUBLE PRECISION.
+
+ALTER TABLE portfolios ALTER COLUMN tokens TYPE BIGINT USING ROUND(tokens * 1000000)::BIGINT;
+ALTER TABLE portfolio_securities ALTER COLUMN amount TYPE BIGINT USING ROUND(amount * 1000000)::BIGINT;
+ALTER TABLE orders ALTER COLUMN amount TYPE BIGINT USING ROUND(amount * 1000000)::BIGINT;
+ALTER TABLE orders ALTER COLUMN cost TYPE BIGINT USING ROUND(cost * 1000000)::BIGINT;
+ALTER TABLE securities ALTER COLUMN shares_outstanding TYPE BIGINT USING ROUND(shares_outstanding * 1000000)::BIGINT;
+ALTER TABLE securities ALTER COLUMN seed_shares TYPE BIGINT USING ROUND(seed_shares * 1000000)::BIGINT;
+ALTER TABLE payouts ALTER COLUMN amount TYPE BIGINT USING ROUND(amount * 1000000)::BIGINT;
+ALTER TABLE payouts ALTER COLUMN payout TYPE BIGINT USING ROUND(payout * 1000000)::BIGINT;
@@ -0,0 +1,13 @@
+-- every change to a market, in order. together with the orders, this is
+-- enough to rebuild securities, portfolios and portfolio_securities. rows are
+-- never updated or deleted, and outlive the markets they're about.
+CREATE TABLE IF NOT EXISTS market_events (
+    id BIGSERIAL PRIMARY KEY,
+    kind TEXT, -- create_market, add_securities, delete_security, open_market,
+               -- close_market, delete_market or resolve_market
+    market_id TEXT, -- the market's uuid
+    payload TEXT, -- the request, as protojson
+    ids TEXT, -- the uuids the event created, comma-separated
+    last_order_id BIGINT, -- the event came after this order
+    date TEXT
+);
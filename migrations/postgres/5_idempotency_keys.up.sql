@@ -0,0 +1,6 @@
+-- a client can send an order again with the same key, and get the first
+-- one's result instead of trading twice.
+ALTER TABLE orders ADD COLUMN idempotency_key TEXT;
+
+CREATE UNIQUE INDEX IF NOT EXISTS orders_idempotency_key_index
+    ON orders(user_id, idempotency_key);
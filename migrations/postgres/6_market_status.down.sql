@@ -0,0 +1,7 @@
+-- halted and scheduled markets come back closed and unopened.
+ALTER TABLE markets ADD COLUMN is_open BOOLEAN NOT NULL DEFAULT FALSE;
+UPDATE markets SET is_open = (status = 1);
+
+ALTER TABLE markets DROP COLUMN close_at;
+ALTER TABLE markets DROP COLUMN open_at;
+ALTER TABLE markets DROP COLUMN status;
@@ -0,0 +1,16 @@
+-- status is one of the Market.Status enum values in market.proto. It
+-- replaces is_open. open_at and close_at are when the scheduler opens and
+-- closes the market.
+ALTER TABLE markets ADD COLUMN status SMALLINT NOT NULL DEFAULT 0;
+ALTER TABLE markets ADD COLUMN open_at TEXT;
+ALTER TABLE markets ADD COLUMN close_at TEXT;
+
+UPDATE markets SET status = CASE
+    WHEN voided THEN 6
+    WHEN date_resolved IS NOT NULL THEN 3
+    WHEN is_open THEN 1
+    WHEN date_closed IS NOT NULL THEN 2
+    ELSE 0
+END;
+
+ALTER TABLE markets DROP COLUMN is_open;
@@ -0,0 +1,164 @@
+// package bot runs automated traders against the market API. Bots provide
+// liquidity and exercise the system the same way a user would, through the
+// MarketService Twirp client.
+package bot
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/twitchtv/twirp"
+
+	"github.com/domino14/scrabfutures/pkg/lmsr"
+	"github.com/domino14/scrabfutures/pkg/marketapi"
+	pb "github.com/domino14/scrabfutures/rpc/proto"
+)
+
+// Observation is what a strategy sees of a market before deciding how to
+// trade in it.
+type Observation struct {
+	MarketID   string
+	Securities []*pb.Security
+	// Costs is each security's price history, oldest first, keyed by
+	// security id. It is only filled in if the runner asks for history.
+	Costs     map[string][]*pb.GetSecurityCostsResponse_SecurityCost
+	Portfolio *pb.Portfolio
+}
+
+// Held returns how many shares of a security the bot holds.
+func (o *Observation) Held(securityID string) float64 {
+	for _, p := range o.Portfolio.Positions {
+		if p.Security.Id == securityID {
+			return lmsr.FromMicros(p.AmountMicros)
+		}
+	}
+	return 0
+}
+
+// Order is a trade that a strategy wants to make. The runner rounds its
+// amount to a whole number of micro-shares.
+type Order struct {
+	SecurityID string
+	Amount     float64
+	Buy        bool
+}
+
+// A Strategy decides which orders to place given the state of a market.
+type Strategy interface {
+	Decide(ctx context.Context, obs *Observation) ([]Order, error)
+}
+
+// Runner trades in a market on behalf of a user, asking its strategy what to
+// do every Interval.
+type Runner struct {
+	Client   pb.MarketService
+	Username string
+	// Secret is the server's API secret, if it has one. See
+	// marketapi.UsernameMiddleware.
+	Secret   string
+	MarketID string
+	Strategy Strategy
+	Interval time.Duration
+	// History is how far back to fetch each security's price history. If it
+	// is zero, no history is fetched.
+	History time.Duration
+}
+
+// Run trades until the context is done. Errors while trading are logged and
+// retried on the next tick.
+func (r *Runner) Run(ctx context.Context) error {
+	ticker := time.NewTicker(r.Interval)
+	defer ticker.Stop()
+	for {
+		if err := r.Step(ctx); err != nil {
+			log.Err(err).Str("username", r.Username).Str("marketID", r.MarketID).Msg("bot-step")
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Step observes the market, asks the strategy what to do, and places its
+// orders. A failed order doesn't stop the rest from being placed; the first
+// error is returned.
+func (r *Runner) Step(ctx context.Context) error {
+	header := http.Header{marketapi.UsernameHeader: []string{r.Username}}
+	if r.Secret != "" {
+		header.Set(marketapi.SecretHeader, r.Secret)
+	}
+	ctx, err := twirp.WithHTTPRequestHeaders(ctx, header)
+	if err != nil {
+		return err
+	}
+	obs, err := r.observe(ctx)
+	if err != nil {
+		return err
+	}
+	orders, err := r.Strategy.Decide(ctx, obs)
+	if err != nil {
+		return err
+	}
+	var firstErr error
+	for _, o := range orders {
+		req := &pb.SecurityRequest{
+			SecurityId:   o.SecurityID,
+			MarketId:     r.MarketID,
+			AmountMicros: lmsr.ToMicros(o.Amount),
+		}
+		var resp *pb.MarketActionResponse
+		if o.Buy {
+			resp, err = r.Client.BuySecurity(ctx, req)
+		} else {
+			req.BuyOrSell = pb.SecurityRequest_SELL
+			resp, err = r.Client.SellSecurity(ctx, req)
+		}
+		if err != nil {
+			log.Err(err).Str("username", r.Username).Str("securityID", o.SecurityID).Msg("bot-order")
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		log.Info().Str("username", r.Username).Str("securityID", o.SecurityID).
+			Bool("buy", o.Buy).Float64("amount", o.Amount).Float64("cost", lmsr.FromMicros(resp.CostMicros)).
+			Msg("bot-order")
+	}
+	return firstErr
+}
+
+func (r *Runner) observe(ctx context.Context) (*Observation, error) {
+	secs, err := r.Client.GetSecurities(ctx, &pb.GetSecuritiesRequest{MarketId: r.MarketID})
+	if err != nil {
+		return nil, err
+	}
+	portfolio, err := r.Client.GetPortfolio(ctx, &pb.GetPortfolioRequest{})
+	if err != nil {
+		return nil, err
+	}
+	obs := &Observation{
+		MarketID:   r.MarketID,
+		Securities: secs.Securities,
+		Costs:      map[string][]*pb.GetSecurityCostsResponse_SecurityCost{},
+		Portfolio:  portfolio.Portfolio,
+	}
+	if r.History == 0 {
+		return obs, nil
+	}
+	begin := time.Now().Add(-r.History).Format(time.RFC3339)
+	for _, sec := range secs.Securities {
+		costs, err := r.Client.GetSecurityCosts(ctx, &pb.GetSecurityCostsRequest{
+			SecurityId: sec.Id,
+			BeginDate:  begin,
+		})
+		if err != nil {
+			return nil, err
+		}
+		obs.Costs[sec.Id] = costs.Costs
+	}
+	return obs, nil
+}
@@ -0,0 +1,83 @@
+package bot
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/matryer/is"
+	"github.com/twitchtv/twirp"
+
+	"github.com/domino14/scrabfutures/pkg/lmsr"
+	"github.com/domino14/scrabfutures/pkg/marketapi"
+	pb "github.com/domino14/scrabfutures/rpc/proto"
+)
+
+// fakeClient is a MarketService that records the orders sent to it.
+type fakeClient struct {
+	pb.MarketService
+	securities []*pb.Security
+	buys       []*pb.SecurityRequest
+	sells      []*pb.SecurityRequest
+}
+
+func (f *fakeClient) GetSecurities(ctx context.Context, req *pb.GetSecuritiesRequest) (*pb.GetSecuritiesResponse, error) {
+	return &pb.GetSecuritiesResponse{Securities: f.securities}, nil
+}
+
+func (f *fakeClient) GetPortfolio(ctx context.Context, req *pb.GetPortfolioRequest) (*pb.GetPortfolioResponse, error) {
+	header, _ := twirp.HTTPRequestHeaders(ctx)
+	return &pb.GetPortfolioResponse{Portfolio: &pb.Portfolio{
+		Username:     header.Get(marketapi.UsernameHeader),
+		TokensMicros: 1000 * lmsr.Micros,
+	}}, nil
+}
+
+func (f *fakeClient) BuySecurity(ctx context.Context, req *pb.SecurityRequest) (*pb.MarketActionResponse, error) {
+	if req.SecurityId == "bad" {
+		return nil, errors.New("no such security")
+	}
+	f.buys = append(f.buys, req)
+	return &pb.MarketActionResponse{CostMicros: 10 * lmsr.Micros}, nil
+}
+
+func (f *fakeClient) SellSecurity(ctx context.Context, req *pb.SecurityRequest) (*pb.MarketActionResponse, error) {
+	f.sells = append(f.sells, req)
+	return &pb.MarketActionResponse{CostMicros: -10 * lmsr.Micros}, nil
+}
+
+type strategyFunc func(ctx context.Context, obs *Observation) ([]Order, error)
+
+func (f strategyFunc) Decide(ctx context.Context, obs *Observation) ([]Order, error) {
+	return f(ctx, obs)
+}
+
+func TestRunnerStep(t *testing.T) {
+	is := is.New(t)
+	client := &fakeClient{securities: []*pb.Security{{Id: "a"}, {Id: "b"}}}
+	var seen *Observation
+	r := &Runner{
+		Client:   client,
+		Username: "bot",
+		MarketID: "m",
+		Strategy: strategyFunc(func(ctx context.Context, obs *Observation) ([]Order, error) {
+			seen = obs
+			return []Order{
+				{SecurityID: "bad", Amount: 1, Buy: true},
+				{SecurityID: "a", Amount: 2, Buy: true},
+				{SecurityID: "b", Amount: 3, Buy: false},
+			}, nil
+		}),
+	}
+	err := r.Step(context.Background())
+	// The bad order fails without stopping the others.
+	is.True(err != nil)
+	is.Equal(seen.Portfolio.Username, "bot")
+	is.Equal(len(seen.Securities), 2)
+	is.Equal(len(client.buys), 1)
+	is.Equal(client.buys[0].SecurityId, "a")
+	is.Equal(client.buys[0].MarketId, "m")
+	is.Equal(len(client.sells), 1)
+	is.Equal(client.sells[0].BuyOrSell, pb.SecurityRequest_SELL)
+	is.Equal(client.sells[0].AmountMicros, int64(3*lmsr.Micros))
+}
@@ -0,0 +1,136 @@
+package bot
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sort"
+
+	"github.com/domino14/scrabfutures/pkg/lmsr"
+	"github.com/domino14/scrabfutures/pkg/rating"
+	pb "github.com/domino14/scrabfutures/rpc/proto"
+)
+
+// A Model estimates the probability that each security in a market wins, in
+// the same order as obs.Securities.
+type Model interface {
+	Probabilities(ctx context.Context, obs *Observation) ([]float64, error)
+}
+
+// RatingModel prices each player's chances from their rating alone.
+type RatingModel struct{}
+
+func (RatingModel) Probabilities(ctx context.Context, obs *Observation) ([]float64, error) {
+	ratings := make([]float64, len(obs.Securities))
+	for idx, sec := range obs.Securities {
+		if sec.Rating == 0 {
+			return nil, errors.New("security " + sec.Shortname + " has no rating")
+		}
+		ratings[idx] = sec.Rating
+	}
+	return rating.FieldProbabilities(ratings), nil
+}
+
+// SimulationModel asks the server to simulate the tournament.
+type SimulationModel struct {
+	Client      pb.MarketService
+	Rounds      int32
+	Pairing     pb.PairingSystem
+	Simulations int32
+}
+
+func (m SimulationModel) Probabilities(ctx context.Context, obs *Observation) ([]float64, error) {
+	resp, err := m.Client.GetModelProbabilities(ctx, &pb.GetModelProbabilitiesRequest{
+		MarketId:      obs.MarketID,
+		Rounds:        m.Rounds,
+		PairingSystem: m.Pairing,
+		Simulations:   m.Simulations,
+	})
+	if err != nil {
+		return nil, err
+	}
+	bySecurity := map[string]float64{}
+	for _, p := range resp.Probabilities {
+		bySecurity[p.SecurityId] = p.ModelProbability
+	}
+	probs := make([]float64, len(obs.Securities))
+	for idx, sec := range obs.Securities {
+		probs[idx] = bySecurity[sec.Id]
+	}
+	return probs, nil
+}
+
+// FairValue trades an exclusive market towards a model's probabilities. Each
+// step it makes the one trade that would move the most mispriced security
+// back to its fair value, as far as its risk budget allows.
+type FairValue struct {
+	Model Model
+	// Budget is the most that the bot will hold in this market, valuing its
+	// positions at their current prices.
+	Budget float64
+	// Edge is how far, in tokens, a price has to be from fair value before
+	// the bot trades it.
+	Edge float64
+}
+
+// minProbability keeps fair values away from 0 and 1, which no amount of
+// shares can reach.
+const minProbability = 0.001
+
+func (f *FairValue) Decide(ctx context.Context, obs *Observation) ([]Order, error) {
+	probs, err := f.Model.Probabilities(ctx, obs)
+	if err != nil {
+		return nil, err
+	}
+	if len(probs) != len(obs.Securities) {
+		return nil, errors.New("model returned the wrong number of probabilities")
+	}
+
+	exposure := float64(0)
+	for _, sec := range obs.Securities {
+		exposure += obs.Held(sec.Id) * sec.LastPrice
+	}
+
+	order := make([]int, len(obs.Securities))
+	for idx := range order {
+		order[idx] = idx
+	}
+	mispricing := func(idx int) float64 {
+		return math.Abs(lmsr.MaxPayout*probs[idx] - obs.Securities[idx].LastPrice)
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return mispricing(order[i]) > mispricing(order[j])
+	})
+
+	for _, idx := range order {
+		if mispricing(idx) <= f.Edge {
+			break
+		}
+		sec := obs.Securities[idx]
+		fair := math.Max(minProbability, math.Min(1-minProbability, probs[idx]))
+		shares := sharesToPrice(sec.LastPrice/lmsr.MaxPayout, fair)
+		if shares > 0 {
+			// Buying pushes the price up towards fair value, so no share
+			// costs more than that.
+			maxCost := math.Min(f.Budget-exposure, lmsr.FromMicros(obs.Portfolio.TokensMicros))
+			shares = math.Min(shares, maxCost/(lmsr.MaxPayout*fair))
+			if shares <= 0 {
+				continue
+			}
+			return []Order{{SecurityID: sec.Id, Amount: shares, Buy: true}}, nil
+		}
+		shares = math.Min(-shares, obs.Held(sec.Id))
+		if shares <= 0 {
+			continue
+		}
+		return []Order{{SecurityID: sec.Id, Amount: shares, Buy: false}}, nil
+	}
+	return nil, nil
+}
+
+// sharesToPrice calculates how many shares of a security in an exclusive
+// market have to be bought (or sold, if negative) to move its price from
+// price to target, both as probabilities.
+func sharesToPrice(price, target float64) float64 {
+	return lmsr.Liquidity * math.Log(target*(1-price)/((1-target)*price))
+}
@@ -0,0 +1,127 @@
+package bot
+
+import (
+	"context"
+	"math"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/matryer/is"
+
+	"github.com/domino14/scrabfutures/pkg/lmsr"
+	"github.com/domino14/scrabfutures/pkg/marketapi"
+	pb "github.com/domino14/scrabfutures/rpc/proto"
+)
+
+type fixedModel []float64
+
+func (m fixedModel) Probabilities(ctx context.Context, obs *Observation) ([]float64, error) {
+	return m, nil
+}
+
+func TestSharesToPrice(t *testing.T) {
+	is := is.New(t)
+	allShares := []float64{0, 0, 0, 0}
+	shares := sharesToPrice(0.25, 0.4)
+	lmsr.TradeCost(lmsr.Liquidity, shares, allShares, 0)
+	is.True(math.Abs(lmsr.Price(lmsr.Liquidity, allShares, 0)-40) < 1e-9)
+
+	shares = sharesToPrice(0.4, 0.1)
+	is.True(shares < 0)
+	lmsr.TradeCost(lmsr.Liquidity, shares, allShares, 0)
+	is.True(math.Abs(lmsr.Price(lmsr.Liquidity, allShares, 0)-10) < 1e-9)
+}
+
+func fourPlayers() *Observation {
+	return &Observation{
+		MarketID: "m",
+		Securities: []*pb.Security{
+			{Id: "a", LastPrice: 25}, {Id: "b", LastPrice: 25},
+			{Id: "c", LastPrice: 25}, {Id: "d", LastPrice: 25},
+		},
+		Portfolio: &pb.Portfolio{TokensMicros: 10000 * lmsr.Micros},
+	}
+}
+
+func TestFairValueBuysMostUnderpriced(t *testing.T) {
+	is := is.New(t)
+	f := &FairValue{Model: fixedModel{0.3, 0.5, 0.1, 0.1}, Budget: 100000, Edge: 1}
+	orders, err := f.Decide(context.Background(), fourPlayers())
+	is.NoErr(err)
+	is.Equal(len(orders), 1)
+	is.Equal(orders[0].SecurityID, "b")
+	is.True(orders[0].Buy)
+	is.True(math.Abs(orders[0].Amount-sharesToPrice(0.25, 0.5)) < 1e-9)
+}
+
+func TestFairValueRespectsBudget(t *testing.T) {
+	is := is.New(t)
+	f := &FairValue{Model: fixedModel{0.3, 0.5, 0.1, 0.1}, Budget: 1000, Edge: 1}
+	obs := fourPlayers()
+	obs.Portfolio.Positions = []*pb.Position{{Security: obs.Securities[0], AmountMicros: 20 * lmsr.Micros}}
+	orders, err := f.Decide(context.Background(), obs)
+	is.NoErr(err)
+	is.Equal(len(orders), 1)
+	// 500 tokens are already held in a; the rest can buy at most 10 shares
+	// of b at its fair value of 50.
+	is.True(math.Abs(orders[0].Amount-10) < 1e-9)
+
+	obs.Portfolio.Positions[0].AmountMicros = 40 * lmsr.Micros
+	orders, err = f.Decide(context.Background(), obs)
+	is.NoErr(err)
+	// Out of budget, and c and d are overpriced but not held.
+	is.Equal(len(orders), 0)
+}
+
+func TestFairValueSellsOverpriced(t *testing.T) {
+	is := is.New(t)
+	f := &FairValue{Model: fixedModel{0.25, 0.25, 0.1, 0.4}, Budget: 0, Edge: 1}
+	obs := fourPlayers()
+	obs.Portfolio.Positions = []*pb.Position{{Security: obs.Securities[2], AmountMicros: 5 * lmsr.Micros}}
+	orders, err := f.Decide(context.Background(), obs)
+	is.NoErr(err)
+	is.Equal(orders, []Order{{SecurityID: "c", Amount: 5, Buy: false}})
+}
+
+func TestFairValueWithinEdge(t *testing.T) {
+	is := is.New(t)
+	f := &FairValue{Model: fixedModel{0.26, 0.24, 0.25, 0.25}, Budget: 1000, Edge: 2}
+	orders, err := f.Decide(context.Background(), fourPlayers())
+	is.NoErr(err)
+	is.Equal(len(orders), 0)
+}
+
+func TestFairValueAgainstServer(t *testing.T) {
+	is := is.New(t)
+	ctx := context.Background()
+	store := marketapi.NewMemoryStore()
+	store.AddUser("bot", 1000*lmsr.Micros)
+	id, err := store.CreateMarket(ctx, &pb.CreateMarketRequest{Description: "Nationals 2023"})
+	is.NoErr(err)
+	is.NoErr(store.AddSecurities(ctx, id, []*pb.AddSecuritiesRequest_Security{
+		{Shortname: "KNJI"}, {Shortname: "NOAH"},
+	}))
+	is.NoErr(store.OpenMarket(ctx, id))
+
+	srv := httptest.NewServer(marketapi.UsernameMiddleware("hunter2",
+		pb.NewMarketServiceServer(marketapi.NewMarketService(store))))
+	defer srv.Close()
+	r := &Runner{
+		Client:   pb.NewMarketServiceProtobufClient(srv.URL, srv.Client()),
+		Username: "bot",
+		Secret:   "hunter2",
+		MarketID: id,
+		Strategy: &FairValue{Model: fixedModel{0.8, 0.2}, Budget: 500, Edge: 1},
+	}
+	is.NoErr(r.Step(ctx))
+	p, err := store.GetPortfolio(ctx, "bot")
+	is.NoErr(err)
+	// The budget only stretches to 500 tokens' worth at fair value.
+	is.Equal(len(p.Positions), 1)
+	is.Equal(p.Positions[0].AmountMicros, int64(6250000))
+	cost := lmsr.TradeCostMicros(lmsr.Exclusive{B: lmsr.Liquidity}, 6250000, []float64{0, 0}, 0)
+	is.Equal(p.TokensMicros, 1000*lmsr.Micros-cost)
+	secs, err := store.GetSecurities(ctx, id)
+	is.NoErr(err)
+	is.True(secs[0].LastPrice > 50)
+}
@@ -0,0 +1,62 @@
+// package candles aggregates a security's price log into open/high/low/close
+// candles for charting.
+package candles
+
+import (
+	"math"
+	"time"
+)
+
+// Point is a price logged at a time.
+type Point struct {
+	Time  time.Time
+	Price float64
+}
+
+// Trade is a number of shares bought or sold at a time. Sales are negative.
+type Trade struct {
+	Time   time.Time
+	Amount float64
+}
+
+type Candle struct {
+	Start  time.Time
+	Open   float64
+	High   float64
+	Low    float64
+	Close  float64
+	Volume float64 // shares traded, bought or sold
+}
+
+// Build buckets prices and trades, both oldest first, into candles that are
+// interval long, starting on multiples of interval since the zero time in
+// UTC. Intervals without any prices are left out; a trade in one of them,
+// which can't happen with a complete price log, is dropped.
+func Build(points []Point, trades []Trade, interval time.Duration) []Candle {
+	candles := []Candle{}
+	for _, p := range points {
+		start := p.Time.UTC().Truncate(interval)
+		if len(candles) == 0 || !candles[len(candles)-1].Start.Equal(start) {
+			candles = append(candles, Candle{
+				Start: start, Open: p.Price, High: p.Price, Low: p.Price, Close: p.Price,
+			})
+			continue
+		}
+		c := &candles[len(candles)-1]
+		c.High = math.Max(c.High, p.Price)
+		c.Low = math.Min(c.Low, p.Price)
+		c.Close = p.Price
+	}
+
+	idx := 0
+	for _, t := range trades {
+		start := t.Time.UTC().Truncate(interval)
+		for idx < len(candles) && candles[idx].Start.Before(start) {
+			idx++
+		}
+		if idx < len(candles) && candles[idx].Start.Equal(start) {
+			candles[idx].Volume += math.Abs(t.Amount)
+		}
+	}
+	return candles
+}
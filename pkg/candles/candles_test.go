@@ -0,0 +1,42 @@
+package candles
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+)
+
+var t0 = time.Date(2022, 7, 8, 14, 0, 0, 0, time.UTC)
+
+func at(minutes float64) time.Time {
+	return t0.Add(time.Duration(minutes * float64(time.Minute)))
+}
+
+func TestBuild(t *testing.T) {
+	is := is.New(t)
+	points := []Point{
+		{at(0), 25}, {at(10), 30}, {at(20), 20}, {at(50), 22},
+		{at(61), 40},
+		// nothing happens in the third hour
+		{at(185), 35}, {at(186), 36},
+	}
+	trades := []Trade{{at(10), 5}, {at(20), -8}, {at(50), 1}, {at(61), 20}, {at(185), -3}, {at(186), 1}}
+	candles := Build(points, trades, time.Hour)
+	is.Equal(candles, []Candle{
+		{Start: at(0), Open: 25, High: 30, Low: 20, Close: 22, Volume: 14},
+		{Start: at(60), Open: 40, High: 40, Low: 40, Close: 40, Volume: 20},
+		{Start: at(180), Open: 35, High: 36, Low: 35, Close: 36, Volume: 4},
+	})
+
+	is.Equal(len(Build(points, trades, time.Minute)), len(points))
+	is.Equal(len(Build(points, trades, 24*time.Hour)), 1)
+	is.Equal(len(Build(nil, trades, time.Hour)), 0)
+}
+
+func TestBuildBucketsInUTC(t *testing.T) {
+	is := is.New(t)
+	est := time.FixedZone("EST", -5*60*60)
+	candles := Build([]Point{{time.Date(2022, 7, 8, 22, 30, 0, 0, est), 50}}, nil, 24*time.Hour)
+	is.Equal(candles[0].Start, time.Date(2022, 7, 9, 0, 0, 0, 0, time.UTC))
+}
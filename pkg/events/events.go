@@ -0,0 +1,86 @@
+// package events is an in-process publish/subscribe hub for things that
+// happen in the market, so that bots and admins can react to them.
+package events
+
+import (
+	"sync"
+)
+
+type Type string
+
+const (
+	// Prices is published with a market's new prices after every trade.
+	Prices Type = "prices"
+	// SecuritiesAdded is published with a market's prices when securities
+	// are added to it.
+	SecuritiesAdded Type = "securities_added"
+	MarketOpened    Type = "market_opened"
+	MarketClosed    Type = "market_closed"
+	// MarketHalted is published when trading in a market is paused. A
+	// MarketOpened event follows when it resumes.
+	MarketHalted   Type = "market_halted"
+	MarketResolved Type = "market_resolved"
+	// MarketVoided is published when an admin voids a market, or when a
+	// conditional market is voided because its condition didn't come true.
+	MarketVoided Type = "market_voided"
+	// GameResults is published when new or corrected game results are
+	// submitted for a market's tournament.
+	GameResults Type = "game_results"
+)
+
+type SecurityPrice struct {
+	SecurityID string  `json:"security_id"`
+	Price      float64 `json:"price"`
+}
+
+type Event struct {
+	Type     Type   `json:"type"`
+	MarketID string `json:"market_id"`
+	// Prices are every security's price, for Prices and SecuritiesAdded
+	// events.
+	Prices []SecurityPrice `json:"prices,omitempty"`
+	// Rounds are the rounds that have new results, for GameResults events.
+	Rounds []int32 `json:"rounds,omitempty"`
+	Date   string  `json:"date"` // RFC3339
+}
+
+// Hub delivers every published event to every subscriber.
+type Hub struct {
+	mu   sync.Mutex
+	subs map[chan Event]struct{}
+}
+
+func NewHub() *Hub {
+	return &Hub{subs: map[chan Event]struct{}{}}
+}
+
+// Subscribe returns a channel that receives published events, and a function
+// that unsubscribes and closes it. The channel holds up to buffer events; a
+// subscriber that falls further behind than that misses events rather than
+// holding up the publisher.
+func (h *Hub) Subscribe(buffer int) (<-chan Event, func()) {
+	ch := make(chan Event, buffer)
+	h.mu.Lock()
+	h.subs[ch] = struct{}{}
+	h.mu.Unlock()
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			h.mu.Lock()
+			delete(h.subs, ch)
+			h.mu.Unlock()
+			close(ch)
+		})
+	}
+}
+
+func (h *Hub) Publish(e Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for ch := range h.subs {
+		select {
+		case ch <- e:
+		default:
+		}
+	}
+}
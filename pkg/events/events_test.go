@@ -0,0 +1,34 @@
+package events
+
+import (
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestPublish(t *testing.T) {
+	is := is.New(t)
+	h := NewHub()
+	a, unsubA := h.Subscribe(1)
+	b, unsubB := h.Subscribe(1)
+	defer unsubB()
+
+	h.Publish(Event{Type: GameResults, MarketID: "m1"})
+	is.Equal((<-a).MarketID, "m1")
+	is.Equal((<-b).MarketID, "m1")
+
+	unsubA()
+	unsubA()
+	_, open := <-a
+	is.True(!open)
+
+	// b's buffer is full after this, so the second event is dropped.
+	h.Publish(Event{Type: GameResults, MarketID: "m2"})
+	h.Publish(Event{Type: GameResults, MarketID: "m3"})
+	is.Equal((<-b).MarketID, "m2")
+	select {
+	case e := <-b:
+		t.Fatalf("unexpected event %v", e)
+	default:
+	}
+}
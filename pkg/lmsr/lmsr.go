@@ -6,6 +6,34 @@ import "math"
 
 const Liquidity = float64(100.0)
 
+// MaxPayout is how many tokens a winning share pays out. Prices range from 0
+// to MaxPayout.
+const MaxPayout = float64(100.0)
+
+// Pricer prices all of the securities in a market from their outstanding
+// shares.
+type Pricer interface {
+	Prices(allShares []float64) []float64
+	TradeCost(shares float64, allShares []float64, idx int) float64
+}
+
+// Exclusive is an LMSR over a set of mutually exclusive securities.
+type Exclusive struct {
+	B float64
+}
+
+func (e Exclusive) Prices(allShares []float64) []float64 {
+	prices := make([]float64, len(allShares))
+	for idx := range allShares {
+		prices[idx] = Price(e.B, allShares, idx)
+	}
+	return prices
+}
+
+func (e Exclusive) TradeCost(shares float64, allShares []float64, idx int) float64 {
+	return TradeCost(e.B, shares, allShares, idx)
+}
+
 // Price calculates the price of a stock given a liquidity constant (b),
 // the number of outstanding shares for all stocks, represented as an array,
 // and the index of this stock in the array.
@@ -16,7 +44,7 @@ func Price(b float64, allShares []float64, shareIdx int) float64 {
 	for _, s := range allShares {
 		sum += math.Exp(s / b)
 	}
-	return 100 * num / sum
+	return MaxPayout * num / sum
 }
 
 // TradeCost calculates the price of buying `shares` shares of a stock, given
@@ -34,5 +62,33 @@ func cost(b float64, allShares []float64) float64 {
 	for _, s := range allShares {
 		sum += math.Exp(s / b)
 	}
-	return 100 * b * math.Log(sum)
+	return MaxPayout * b * math.Log(sum)
+}
+
+// ScalarPayouts calculates how many tokens one long share and one short share
+// pay out when a scalar market ranging from lower to upper resolves to value.
+// The long share pays out linearly across the range and the short share pays
+// out the rest; values outside of the range are clamped to it. value must be
+// finite, or the payouts are NaN.
+func ScalarPayouts(lower, upper, value float64) (long, short float64) {
+	frac := (value - lower) / (upper - lower)
+	frac = math.Max(0, math.Min(1, frac))
+	long = MaxPayout * frac
+	return long, MaxPayout - long
+}
+
+// SharesForProbabilities calculates how many outstanding shares each stock
+// needs for Price to return the given probabilities (scaled up to
+// MaxPayout), given a liquidity constant b. The least likely stock gets no
+// shares. All of the probabilities must be positive and add up to 1.
+func SharesForProbabilities(b float64, probs []float64) []float64 {
+	min := probs[0]
+	for _, p := range probs {
+		min = math.Min(min, p)
+	}
+	shares := make([]float64, len(probs))
+	for idx, p := range probs {
+		shares[idx] = b * math.Log(p/min)
+	}
+	return shares
 }
@@ -50,3 +50,64 @@ func TestTradeCost3(t *testing.T) {
 // 	fmt.Println(TradeCost(100, 50, []float64{300, 300, 300, 300}, 2))
 // 	is.True(false)
 // }
+
+func TestScalarPayouts(t *testing.T) {
+	is := is.New(t)
+	long, short := ScalarPayouts(400, 800, 700)
+	is.True(withinEpsilon(long, 75))
+	is.True(withinEpsilon(short, 25))
+}
+
+func TestScalarPayoutsClamped(t *testing.T) {
+	is := is.New(t)
+	long, short := ScalarPayouts(400, 800, 850)
+	is.Equal(long, 100.0)
+	is.Equal(short, 0.0)
+	long, short = ScalarPayouts(400, 800, 350)
+	is.Equal(long, 0.0)
+	is.Equal(short, 100.0)
+}
+
+func TestSharesForProbabilities(t *testing.T) {
+	is := is.New(t)
+	probs := []float64{0.5, 0.3, 0.15, 0.05}
+	shares := SharesForProbabilities(100, probs)
+	is.Equal(shares[3], 0.0)
+	for idx, p := range probs {
+		is.True(withinEpsilon(Price(100, shares, idx), MaxPayout*p))
+	}
+}
+
+func TestMicrosRoundTrip(t *testing.T) {
+	is := is.New(t)
+	is.Equal(ToMicros(2.5), int64(2500000))
+	is.Equal(ToMicros(0.1+0.2), int64(300000))
+	is.Equal(FromMicros(ToMicros(6.25)), 6.25)
+}
+
+func TestTradeCostMicrosFavoursHouse(t *testing.T) {
+	is := is.New(t)
+	p := Exclusive{B: Liquidity}
+	allShares := []float64{0, 0, 0}
+	var spent int64
+	// Buying and selling back the same shares, over and over, can never
+	// make the trader any tokens.
+	for i := 0; i < 100; i++ {
+		spent += TradeCostMicros(p, 3333333, allShares, 1)
+		spent += TradeCostMicros(p, -3333333, allShares, 1)
+	}
+	is.True(spent >= 0)
+	is.True(spent <= 200)
+
+	exact := TradeCost(Liquidity, 0.5, []float64{0, 0}, 0) * Micros
+	is.Equal(TradeCostMicros(p, 500000, []float64{0, 0}, 0), int64(math.Ceil(exact)))
+}
+
+func TestPayoutMicros(t *testing.T) {
+	is := is.New(t)
+	is.Equal(PayoutMicros(1500000, MaxPayout), int64(150000000))
+	// A three-way tie pays 33.333... tokens a share; the fraction of a
+	// micro-token is kept.
+	is.Equal(PayoutMicros(1, MaxPayout/3), int64(33))
+	is.Equal(PayoutMicros(1000000, MaxPayout/3), int64(33333333))
+}
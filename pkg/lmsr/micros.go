@@ -0,0 +1,33 @@
+package lmsr
+
+import "math"
+
+// Micros is how many micro-units make up one token or one share. Balances and
+// quantities are kept as whole numbers of micro-units, so that adding them up
+// is exact; only prices are floating point.
+const Micros = 1_000_000
+
+// ToMicros converts tokens or shares to the nearest whole number of
+// micro-units.
+func ToMicros(x float64) int64 {
+	return int64(math.Round(x * Micros))
+}
+
+// FromMicros converts micro-units back to tokens or shares.
+func FromMicros(micros int64) float64 {
+	return float64(micros) / Micros
+}
+
+// TradeCostMicros is TradeCost for a trade of amount micro-shares, in
+// micro-tokens. It rounds up, in the house's favour: buyers pay for the
+// fraction of a micro-token, and sellers, whose costs are negative, don't
+// get it.
+func TradeCostMicros(p Pricer, amount int64, allShares []float64, idx int) int64 {
+	return int64(math.Ceil(p.TradeCost(FromMicros(amount), allShares, idx) * Micros))
+}
+
+// PayoutMicros is how many micro-tokens amount micro-shares pay out when each
+// share pays out payout tokens. It rounds down, in the house's favour.
+func PayoutMicros(amount int64, payout float64) int64 {
+	return int64(math.Floor(float64(amount) * payout))
+}
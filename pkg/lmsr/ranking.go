@@ -0,0 +1,157 @@
+package lmsr
+
+import (
+	"math"
+	"math/bits"
+)
+
+// MaxRankingPlayers is the largest field of players that a ranking market
+// can have. Pricing a ranking market takes time and memory proportional to
+// 2^players.
+const MaxRankingPlayers = 16
+
+// Predicate is a statement about the final ranking of a field of players:
+// that Player finishes in one of Positions. Both players and positions are
+// zero-indexed.
+type Predicate struct {
+	Player    int
+	Positions []int
+}
+
+// Ranking is an LMSR over every possible ranking of a field of players. Its
+// securities are predicates; buying a share of a predicate buys a share of
+// every ranking in which the predicate holds. Since all predicates are about
+// a single player's position, the state of the market reduces to a
+// players x positions matrix of shares, and prices can be calculated exactly
+// without enumerating every ranking.
+type Ranking struct {
+	B          float64
+	Players    int
+	Predicates []Predicate
+}
+
+// Prices calculates the price of every predicate, given the number of
+// outstanding shares for each of them.
+func (r Ranking) Prices(allShares []float64) []float64 {
+	w, _ := r.weights(allShares)
+	marginals := rankingMarginals(w)
+	prices := make([]float64, len(r.Predicates))
+	for idx, p := range r.Predicates {
+		for _, pos := range p.Positions {
+			prices[idx] += marginals[p.Player][pos]
+		}
+		prices[idx] *= MaxPayout
+	}
+	return prices
+}
+
+// TradeCost calculates the price of buying `shares` shares of the predicate
+// at index idx, and adds them to allShares.
+func (r Ranking) TradeCost(shares float64, allShares []float64, idx int) float64 {
+	costBefore := r.cost(allShares)
+	allShares[idx] += shares
+	costAfter := r.cost(allShares)
+	return costAfter - costBefore
+}
+
+// cost is b times the log of the sum, over every ranking, of the exponential
+// of the shares held in that ranking divided by b.
+func (r Ranking) cost(allShares []float64) float64 {
+	w, offset := r.weights(allShares)
+	f := rankingForward(w)
+	return MaxPayout * r.B * (math.Log(f[len(f)-1]) + offset)
+}
+
+// weights calculates w[player][position] = exp(q / b), where q is the number
+// of shares held in the player finishing in that position. Each player's
+// weights are scaled down by their largest one to avoid overflowing; since
+// every ranking contains each player exactly once this doesn't change any
+// prices. The log of the total scale factor is returned as the offset.
+func (r Ranking) weights(allShares []float64) ([][]float64, float64) {
+	q := make([][]float64, r.Players)
+	for i := range q {
+		q[i] = make([]float64, r.Players)
+	}
+	for idx, p := range r.Predicates {
+		for _, pos := range p.Positions {
+			q[p.Player][pos] += allShares[idx]
+		}
+	}
+	offset := float64(0)
+	for i := range q {
+		max := q[i][0]
+		for _, s := range q[i] {
+			max = math.Max(max, s)
+		}
+		for j := range q[i] {
+			q[i][j] = math.Exp((q[i][j] - max) / r.B)
+		}
+		offset += max / r.B
+	}
+	return q, offset
+}
+
+// rankingForward calculates, for every subset of players (as a bitmask),
+// the sum of the weights of all ways to place exactly those players in the
+// top positions. The last element is the sum over all rankings.
+func rankingForward(w [][]float64) []float64 {
+	n := len(w)
+	f := make([]float64, 1<<n)
+	f[0] = 1
+	for mask := 0; mask < len(f); mask++ {
+		if f[mask] == 0 {
+			continue
+		}
+		pos := bits.OnesCount(uint(mask))
+		for i := 0; i < n; i++ {
+			if mask&(1<<i) == 0 {
+				f[mask|1<<i] += f[mask] * w[i][pos]
+			}
+		}
+	}
+	return f
+}
+
+// rankingBackward calculates, for every subset of players, the sum of the
+// weights of all ways to place the remaining players in the bottom
+// positions.
+func rankingBackward(w [][]float64) []float64 {
+	n := len(w)
+	g := make([]float64, 1<<n)
+	g[len(g)-1] = 1
+	for mask := len(g) - 2; mask >= 0; mask-- {
+		pos := bits.OnesCount(uint(mask))
+		for i := 0; i < n; i++ {
+			if mask&(1<<i) == 0 {
+				g[mask] += w[i][pos] * g[mask|1<<i]
+			}
+		}
+	}
+	return g
+}
+
+// rankingMarginals calculates the probability that each player finishes in
+// each position.
+func rankingMarginals(w [][]float64) [][]float64 {
+	n := len(w)
+	f := rankingForward(w)
+	g := rankingBackward(w)
+	z := f[len(f)-1]
+
+	m := make([][]float64, n)
+	for i := range m {
+		m[i] = make([]float64, n)
+	}
+	for mask := 0; mask < len(f); mask++ {
+		if f[mask] == 0 {
+			continue
+		}
+		pos := bits.OnesCount(uint(mask))
+		for i := 0; i < n; i++ {
+			if mask&(1<<i) == 0 {
+				m[i][pos] += f[mask] * w[i][pos] * g[mask|1<<i] / z
+			}
+		}
+	}
+	return m
+}
@@ -0,0 +1,127 @@
+package lmsr
+
+import (
+	"math"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+// bruteForcePrices prices predicates by enumerating every ranking.
+func bruteForcePrices(r Ranking, allShares []float64) []float64 {
+	perms := [][]int{}
+	var permute func(prefix []int, rest []int)
+	permute = func(prefix []int, rest []int) {
+		if len(rest) == 0 {
+			perms = append(perms, append([]int{}, prefix...))
+			return
+		}
+		for i := range rest {
+			others := append(append([]int{}, rest[:i]...), rest[i+1:]...)
+			permute(append(prefix, rest[i]), others)
+		}
+	}
+	players := []int{}
+	for i := 0; i < r.Players; i++ {
+		players = append(players, i)
+	}
+	permute(nil, players)
+
+	holds := func(p Predicate, ranking []int) bool {
+		for _, pos := range p.Positions {
+			if ranking[pos] == p.Player {
+				return true
+			}
+		}
+		return false
+	}
+	sum := float64(0)
+	weights := make([]float64, len(perms))
+	for k, ranking := range perms {
+		q := float64(0)
+		for idx, p := range r.Predicates {
+			if holds(p, ranking) {
+				q += allShares[idx]
+			}
+		}
+		weights[k] = math.Exp(q / r.B)
+		sum += weights[k]
+	}
+	prices := make([]float64, len(r.Predicates))
+	for k, ranking := range perms {
+		for idx, p := range r.Predicates {
+			if holds(p, ranking) {
+				prices[idx] += MaxPayout * weights[k] / sum
+			}
+		}
+	}
+	return prices
+}
+
+func TestRankingPricesEmptyShares(t *testing.T) {
+	is := is.New(t)
+	r := Ranking{B: 100, Players: 4, Predicates: []Predicate{
+		{Player: 0, Positions: []int{0}},
+		{Player: 1, Positions: []int{0, 1}},
+		{Player: 2, Positions: []int{0, 1, 2}},
+	}}
+	prices := r.Prices([]float64{0, 0, 0})
+	is.True(withinEpsilon(prices[0], 25))
+	is.True(withinEpsilon(prices[1], 50))
+	is.True(withinEpsilon(prices[2], 75))
+}
+
+func TestRankingPricesMatchBruteForce(t *testing.T) {
+	is := is.New(t)
+	r := Ranking{B: 100, Players: 5, Predicates: []Predicate{
+		{Player: 0, Positions: []int{0}},
+		{Player: 1, Positions: []int{0}},
+		{Player: 1, Positions: []int{0, 1, 2}},
+		{Player: 2, Positions: []int{4}},
+		{Player: 3, Positions: []int{1, 3}},
+	}}
+	shares := []float64{120, 40, 75, 200, 10}
+	expected := bruteForcePrices(r, shares)
+	for idx, p := range r.Prices(shares) {
+		is.True(withinEpsilon(p, expected[idx]))
+	}
+}
+
+func TestRankingWinnerMarketIsExclusive(t *testing.T) {
+	is := is.New(t)
+	// A ranking market whose only predicates are "player i wins" is the
+	// same as an exclusive market over the players.
+	r := Ranking{B: 100, Players: 4, Predicates: []Predicate{
+		{Player: 0, Positions: []int{0}},
+		{Player: 1, Positions: []int{0}},
+		{Player: 2, Positions: []int{0}},
+		{Player: 3, Positions: []int{0}},
+	}}
+	shares := []float64{100, 200, 230, 0}
+	prices := r.Prices(shares)
+	for idx := range shares {
+		is.True(withinEpsilon(prices[idx], Price(100, shares, idx)))
+	}
+	expected := TradeCost(100, 70, append([]float64{}, shares...), 0)
+	is.True(withinEpsilon(r.TradeCost(70, shares, 0), expected))
+	is.Equal(shares[0], 170.0)
+}
+
+func TestRankingTradeCostMovesPrices(t *testing.T) {
+	is := is.New(t)
+	r := Ranking{B: 100, Players: 3, Predicates: []Predicate{
+		{Player: 0, Positions: []int{0, 1}},
+		{Player: 1, Positions: []int{0}},
+	}}
+	shares := []float64{0, 0}
+	cost := r.TradeCost(50, shares, 0)
+	// Buying shares costs less than they pay out, and more than the
+	// opening price.
+	is.True(cost > 50*MaxPayout*2/3)
+	is.True(cost < 50*MaxPayout)
+	prices := r.Prices(shares)
+	is.True(prices[0] > MaxPayout*2/3)
+	// Player 0 being more likely to finish in the top two makes it less
+	// likely that player 1 wins.
+	is.True(prices[1] < MaxPayout/3)
+}
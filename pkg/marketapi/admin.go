@@ -0,0 +1,295 @@
+package marketapi
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/twitchtv/twirp"
+
+	pb "github.com/domino14/scrabfutures/rpc/proto"
+)
+
+type AdminService struct {
+	store Store
+}
+
+func NewAdminService(store Store) *AdminService {
+	return &AdminService{store: store}
+}
+
+func (a *AdminService) CreateMarket(ctx context.Context, req *pb.CreateMarketRequest) (*pb.CreateMarketResponse, error) {
+	if req.Description == "" {
+		return nil, twirp.RequiredArgumentError("description")
+	}
+	id, err := a.store.CreateMarket(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateMarketResponse{Id: id}, nil
+}
+
+func (a *AdminService) OpenMarket(ctx context.Context, req *pb.OpenMarketRequest) (*pb.AdminServiceResponse, error) {
+	err := a.store.OpenMarket(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AdminServiceResponse{}, nil
+}
+
+func (a *AdminService) CloseMarket(ctx context.Context, req *pb.CloseMarketRequest) (*pb.AdminServiceResponse, error) {
+	err := a.store.CloseMarket(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AdminServiceResponse{}, nil
+}
+
+func (a *AdminService) HaltMarket(ctx context.Context, req *pb.HaltMarketRequest) (*pb.AdminServiceResponse, error) {
+	err := a.store.HaltMarket(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AdminServiceResponse{}, nil
+}
+
+func (a *AdminService) VoidMarket(ctx context.Context, req *pb.VoidMarketRequest) (*pb.AdminServiceResponse, error) {
+	err := a.store.VoidMarket(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AdminServiceResponse{}, nil
+}
+
+func (a *AdminService) ScheduleMarket(ctx context.Context, req *pb.ScheduleMarketRequest) (*pb.AdminServiceResponse, error) {
+	err := a.store.ScheduleMarket(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AdminServiceResponse{}, nil
+}
+
+func (a *AdminService) DeleteMarket(ctx context.Context, req *pb.DeleteMarketRequest) (*pb.AdminServiceResponse, error) {
+	err := a.store.DeleteMarket(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AdminServiceResponse{}, nil
+}
+
+func (a *AdminService) AddSecurities(ctx context.Context, req *pb.AddSecuritiesRequest) (*pb.AdminServiceResponse, error) {
+	err := a.store.AddSecurities(ctx, req.MarketId, req.Securities)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AdminServiceResponse{}, nil
+}
+
+func (a *AdminService) DeleteSecurity(ctx context.Context, req *pb.DeleteSecurityRequest) (*pb.AdminServiceResponse, error) {
+	err := a.store.DeleteSecurity(ctx, req.MarketId, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AdminServiceResponse{}, nil
+}
+
+func (a *AdminService) ResolveMarket(ctx context.Context, req *pb.ResolveMarketRequest) (*pb.ResolveMarketResponse, error) {
+	if math.IsNaN(req.Value) || math.IsInf(req.Value, 0) {
+		return nil, twirp.InvalidArgumentError("value", "must be a finite number")
+	}
+	err := a.store.ResolveMarket(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ResolveMarketResponse{}, nil
+}
+
+func (a *AdminService) GrantTokens(ctx context.Context, req *pb.GrantTokensRequest) (*pb.AdminServiceResponse, error) {
+	if req.Username == "" {
+		return nil, twirp.RequiredArgumentError("username")
+	}
+	if req.AmountMicros <= 0 {
+		return nil, twirp.InvalidArgumentError("amount_micros", "must be positive")
+	}
+	err := a.store.GrantTokens(ctx, req.Username, req.AmountMicros)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AdminServiceResponse{}, nil
+}
+
+func (a *AdminService) Audit(ctx context.Context, req *pb.AuditRequest) (*pb.AuditResponse, error) {
+	as, ok := a.store.(AuditStore)
+	if !ok {
+		return nil, twirp.NewError(twirp.Unimplemented, "the books can't be audited")
+	}
+	violations, err := as.Audit(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AuditResponse{Violations: violations}, nil
+}
+
+func (a *AdminService) CreateMatchupMarkets(ctx context.Context, req *pb.CreateMatchupMarketsRequest) (*pb.CreateMatchupMarketsResponse, error) {
+	if len(req.Pairings) == 0 {
+		return nil, twirp.RequiredArgumentError("pairings")
+	}
+	for _, p := range req.Pairings {
+		if p.PlayerOne == "" || p.PlayerTwo == "" || p.PlayerOne == p.PlayerTwo {
+			return nil, twirp.InvalidArgumentError("pairings",
+				fmt.Sprintf("invalid pairing: %q vs. %q", p.PlayerOne, p.PlayerTwo))
+		}
+	}
+	if req.CloseAt != "" {
+		if _, err := time.Parse(time.RFC3339, req.CloseAt); err != nil {
+			return nil, twirp.InvalidArgumentError("close_at", "must be an RFC3339 date")
+		}
+	}
+
+	ids := []string{}
+	// If anything goes wrong, delete the markets in ids so that the round
+	// can be retried. They haven't been opened so this is allowed.
+	cleanup := func() {
+		for _, id := range ids {
+			if err := a.store.DeleteMarket(ctx, id); err != nil {
+				log.Err(err).Str("marketID", id).Msg("cleanup-matchup-market")
+			}
+		}
+	}
+
+	for _, p := range req.Pairings {
+		id, err := a.store.CreateMarket(ctx, &pb.CreateMarketRequest{
+			Description: fmt.Sprintf("%s: %s vs. %s", req.Description, p.PlayerOne, p.PlayerTwo),
+		})
+		if err != nil {
+			cleanup()
+			return nil, err
+		}
+		ids = append(ids, id)
+		secs := []*pb.AddSecuritiesRequest_Security{
+			{
+				Description: fmt.Sprintf("%s beats %s", p.PlayerOne, p.PlayerTwo),
+				Shortname:   p.PlayerOne,
+			},
+			{
+				Description: fmt.Sprintf("%s beats %s", p.PlayerTwo, p.PlayerOne),
+				Shortname:   p.PlayerTwo,
+			},
+		}
+		// Ratings only seed the prices if both players have one.
+		if p.PlayerOneRating != 0 && p.PlayerTwoRating != 0 {
+			secs[0].Rating = p.PlayerOneRating
+			secs[1].Rating = p.PlayerTwoRating
+		}
+		err = a.store.AddSecurities(ctx, id, secs)
+		if err != nil {
+			cleanup()
+			return nil, err
+		}
+		if req.CloseAt != "" {
+			err = a.store.ScheduleMarket(ctx, &pb.ScheduleMarketRequest{Id: id, CloseAt: req.CloseAt})
+			if err != nil {
+				cleanup()
+				return nil, err
+			}
+		}
+	}
+
+	// The round opens together, so check that every market can be opened
+	// before opening any of them.
+	for _, id := range ids {
+		m, err := a.store.GetMarket(ctx, id)
+		if err == nil {
+			err = checkTransition(m.Status, pb.Market_OPEN)
+		}
+		if err != nil {
+			cleanup()
+			return nil, err
+		}
+	}
+	for idx, id := range ids {
+		err := a.store.OpenMarket(ctx, id)
+		if err != nil {
+			// The markets opened so far are already trading, so leave them
+			// and say which they are; the rest are deleted.
+			opened := ids[:idx]
+			ids = ids[idx:]
+			cleanup()
+			return nil, fmt.Errorf("opening market %s: %w (markets already open: %s)",
+				id, err, strings.Join(opened, ", "))
+		}
+	}
+	return &pb.CreateMatchupMarketsResponse{Ids: ids}, nil
+}
+
+func (a *AdminService) ResolveMatchupMarkets(ctx context.Context, req *pb.ResolveMatchupMarketsRequest) (*pb.ResolveMarketResponse, error) {
+	// Check every result before resolving anything, so that a bad one is
+	// caught up front. The markets are still resolved one at a time, so a
+	// store error can leave the round partly resolved; markets that are
+	// already resolved are skipped, so the same request can be retried.
+	resolutions := []*pb.ResolveMarketRequest{}
+	ties := map[string]bool{}
+	for _, r := range req.Results {
+		m, err := a.store.GetMarket(ctx, r.MarketId)
+		if err != nil {
+			return nil, err
+		}
+		secs, err := a.store.GetSecurities(ctx, r.MarketId)
+		if err != nil {
+			return nil, err
+		}
+		if m.MarketType != pb.MarketType_EXCLUSIVE || len(secs) != 2 {
+			return nil, twirp.InvalidArgumentError("results",
+				fmt.Sprintf("market %s is not a matchup market", r.MarketId))
+		}
+		if m.Status == pb.Market_RESOLVED || m.Status == pb.Market_VOIDED {
+			continue
+		}
+		if r.PlayerOneScore == r.PlayerTwoScore {
+			// Nobody won a tied game, so its market is voided and everyone
+			// gets back what they paid.
+			ties[r.MarketId] = true
+			resolutions = append(resolutions, &pb.ResolveMarketRequest{MarketId: r.MarketId})
+			continue
+		}
+		// Securities are returned in the order they were added, which is
+		// the order of the players in the pairing.
+		resolutions = append(resolutions, &pb.ResolveMarketRequest{
+			MarketId: r.MarketId,
+			Resolutions: []*pb.ResolveMarketRequest_SecurityResolution{
+				{SecurityId: secs[0].Id, Wins: r.PlayerOneScore > r.PlayerTwoScore},
+				{SecurityId: secs[1].Id, Wins: r.PlayerTwoScore > r.PlayerOneScore},
+			},
+		})
+	}
+	for _, r := range resolutions {
+		var err error
+		if ties[r.MarketId] {
+			err = a.store.VoidMarket(ctx, r.MarketId)
+		} else {
+			err = a.store.ResolveMarket(ctx, r)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("resolving market %s: %w", r.MarketId, err)
+		}
+	}
+	return &pb.ResolveMarketResponse{}, nil
+}
+
+func (a *AdminService) SubmitGameResults(ctx context.Context, req *pb.SubmitGameResultsRequest) (*pb.SubmitGameResultsResponse, error) {
+	if req.MarketId == "" {
+		return nil, twirp.RequiredArgumentError("market_id")
+	}
+	rs, ok := a.store.(ResultsStore)
+	if !ok {
+		return nil, twirp.NewError(twirp.Unimplemented, "game results are not kept")
+	}
+	changed, err := rs.SubmitGameResults(ctx, req.MarketId, req.Results)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SubmitGameResultsResponse{Changed: int32(changed)}, nil
+}
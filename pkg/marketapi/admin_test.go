@@ -0,0 +1,341 @@
+package marketapi
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/domino14/scrabfutures/pkg/lmsr"
+	pb "github.com/domino14/scrabfutures/rpc/proto"
+	"github.com/matryer/is"
+	"github.com/twitchtv/twirp"
+)
+
+func TestMatchupMarkets(t *testing.T) {
+	initDB()
+	addFixtures("./testfixtures/basic.sql")
+	is := is.New(t)
+	ctx := context.Background()
+	s, _ := NewSqliteStore(cfg.DBPath)
+	a := NewAdminService(s)
+
+	resp, err := a.CreateMatchupMarkets(ctx, &pb.CreateMatchupMarketsRequest{
+		Description: "Nationals 2022 round 1",
+		Pairings: []*pb.Pairing{
+			{PlayerOne: "Kenji", PlayerTwo: "Noah"},
+			{PlayerOne: "César", PlayerTwo: "Josh"},
+		},
+		CloseAt: "2999-07-01T10:00:00Z",
+	})
+	is.NoErr(err)
+	is.Equal(len(resp.Ids), 2)
+
+	m, err := s.GetMarket(ctx, resp.Ids[1])
+	is.NoErr(err)
+	is.Equal(m.Description, "Nationals 2022 round 1: César vs. Josh")
+	is.True(m.IsOpen)
+	is.Equal(m.Status, pb.Market_OPEN)
+	is.Equal(m.CloseAt, "2999-07-01T10:00:00Z")
+	secs, _ := s.GetSecurities(ctx, resp.Ids[1])
+	is.Equal(len(secs), 2)
+	is.Equal(secs[0].Shortname, "César")
+	is.Equal(secs[0].Description, "César beats Josh")
+	is.Equal(secs[0].LastPrice, 50.0)
+
+	_, err = s.FulfillOrder(ctx, "cesar", secs[0].Id, resp.Ids[1], 10*lmsr.Micros, true, "")
+	is.NoErr(err)
+	_, err = s.FulfillOrder(ctx, "josh", secs[1].Id, resp.Ids[1], 10*lmsr.Micros, true, "")
+	is.NoErr(err)
+	tiedSecs, _ := s.GetSecurities(ctx, resp.Ids[0])
+	staked, err := s.FulfillOrder(ctx, "josh", tiedSecs[0].Id, resp.Ids[0], 10*lmsr.Micros, true, "")
+	is.NoErr(err)
+	cesarTokens := tokens(s, "cesar")
+	joshTokens := tokens(s, "josh")
+
+	results := &pb.ResolveMatchupMarketsRequest{
+		Results: []*pb.ResolveMatchupMarketsRequest_Result{
+			{MarketId: resp.Ids[0], PlayerOneScore: 400, PlayerTwoScore: 400},
+			{MarketId: resp.Ids[1], PlayerOneScore: 455, PlayerTwoScore: 380},
+		},
+	}
+	_, err = a.ResolveMatchupMarkets(ctx, results)
+	is.NoErr(err)
+	is.Equal(tokens(s, "cesar"), cesarTokens+1000*lmsr.Micros)
+
+	// The tied game's market is voided, and its traders are refunded.
+	m, _ = s.GetMarket(ctx, resp.Ids[0])
+	is.Equal(m.Status, pb.Market_VOIDED)
+	is.Equal(tokens(s, "josh"), joshTokens+staked)
+
+	// Sending the round again skips the markets that are already resolved.
+	_, err = a.ResolveMatchupMarkets(ctx, results)
+	is.NoErr(err)
+	is.Equal(tokens(s, "cesar"), cesarTokens+1000*lmsr.Micros)
+}
+
+func TestMatchupMarketsBadPairing(t *testing.T) {
+	initDB()
+	is := is.New(t)
+	ctx := context.Background()
+	s, _ := NewSqliteStore(cfg.DBPath)
+	a := NewAdminService(s)
+
+	_, err := a.CreateMatchupMarkets(ctx, &pb.CreateMatchupMarketsRequest{
+		Description: "Nationals 2022 round 1",
+		Pairings: []*pb.Pairing{
+			{PlayerOne: "Kenji", PlayerTwo: "Noah"},
+			{PlayerOne: "César", PlayerTwo: "César"},
+		},
+	})
+	is.True(err != nil)
+	_, err = a.CreateMatchupMarkets(ctx, &pb.CreateMatchupMarketsRequest{
+		Description: "Nationals 2022 round 1",
+		Pairings:    []*pb.Pairing{{PlayerOne: "Kenji", PlayerTwo: "Noah"}},
+		CloseAt:     "after lunch",
+	})
+	is.Equal(err.(twirp.Error).Code(), twirp.InvalidArgument)
+	markets, _ := s.GetMarkets(ctx)
+	is.Equal(len(markets), 0)
+}
+
+// openFails is a store whose OpenMarket fails after it has opened some
+// markets.
+type openFails struct {
+	Store
+	opens int
+}
+
+func (s *openFails) OpenMarket(ctx context.Context, uuid string) error {
+	if s.opens == 0 {
+		return errors.New("the database is down")
+	}
+	s.opens--
+	return s.Store.OpenMarket(ctx, uuid)
+}
+
+func TestMatchupMarketsOpenFails(t *testing.T) {
+	initDB()
+	is := is.New(t)
+	ctx := context.Background()
+	s, _ := NewSqliteStore(cfg.DBPath)
+	a := NewAdminService(&openFails{Store: s, opens: 1})
+
+	_, err := a.CreateMatchupMarkets(ctx, &pb.CreateMatchupMarketsRequest{
+		Description: "Nationals 2022 round 1",
+		Pairings: []*pb.Pairing{
+			{PlayerOne: "Kenji", PlayerTwo: "Noah"},
+			{PlayerOne: "César", PlayerTwo: "Josh"},
+		},
+	})
+	is.True(err != nil)
+	// The market that opened is named, and the one that didn't is deleted.
+	markets, _ := s.GetMarkets(ctx)
+	is.Equal(len(markets), 1)
+	is.Equal(markets[0].Status, pb.Market_OPEN)
+	is.True(strings.Contains(err.Error(), "markets already open: "+markets[0].Id))
+}
+
+func TestMatchupMarketsWithRatings(t *testing.T) {
+	initDB()
+	is := is.New(t)
+	ctx := context.Background()
+	s, _ := NewSqliteStore(cfg.DBPath)
+	a := NewAdminService(s)
+
+	resp, err := a.CreateMatchupMarkets(ctx, &pb.CreateMatchupMarketsRequest{
+		Description: "Nationals 2022 round 1",
+		Pairings: []*pb.Pairing{
+			{PlayerOne: "Kenji", PlayerTwo: "Josh", PlayerOneRating: 2200, PlayerTwoRating: 1800},
+		},
+	})
+	is.NoErr(err)
+	secs, _ := s.GetSecurities(ctx, resp.Ids[0])
+	// The seeded shares are rounded to whole micro-shares.
+	is.True(math.Abs(secs[0].LastPrice-100*10.0/11) < 1e-6)
+
+	// With only one player's rating, the market opens at even odds.
+	resp, err = a.CreateMatchupMarkets(ctx, &pb.CreateMatchupMarketsRequest{
+		Description: "Nationals 2022 round 1",
+		Pairings: []*pb.Pairing{
+			{PlayerOne: "Noah", PlayerTwo: "César", PlayerOneRating: 2000},
+		},
+	})
+	is.NoErr(err)
+	secs, _ = s.GetSecurities(ctx, resp.Ids[0])
+	is.Equal(secs[0].LastPrice, 50.0)
+	is.Equal(secs[1].LastPrice, 50.0)
+}
+
+func TestSubmitGameResults(t *testing.T) {
+	initDB()
+	addFixtures("./testfixtures/basic.sql")
+	is := is.New(t)
+	ctx := context.Background()
+	s, _ := NewSqliteStore(cfg.DBPath)
+	a := NewAdminService(s)
+	evts, unsubscribe := s.Events().Subscribe(10)
+	defer unsubscribe()
+
+	resp, err := a.SubmitGameResults(ctx, &pb.SubmitGameResultsRequest{
+		MarketId: "nationals2022",
+		Results: []*pb.GameResult{
+			{Round: 1, Player: "Noah", Opponent: "Kenji", PlayerScore: 390, OpponentScore: 455},
+			{Round: 1, Player: "Kenji", Opponent: "Noah", PlayerScore: 455, OpponentScore: 390},
+			{Round: 1, Player: "César", PlayerScore: 50},
+		},
+	})
+	is.NoErr(err)
+	// Both players reported the same game.
+	is.Equal(resp.Changed, int32(2))
+	e := <-evts
+	is.Equal(e.MarketID, "nationals2022")
+	is.Equal(e.Rounds, []int32{1})
+
+	// Resubmitting changes nothing and publishes nothing; a correction
+	// replaces the earlier score.
+	resp, err = a.SubmitGameResults(ctx, &pb.SubmitGameResultsRequest{
+		MarketId: "nationals2022",
+		Results: []*pb.GameResult{
+			{Round: 1, Player: "Kenji", Opponent: "Noah", PlayerScore: 455, OpponentScore: 390},
+			{Round: 2, Player: "Noah", Opponent: "César", PlayerScore: 400, OpponentScore: 401},
+		},
+	})
+	is.NoErr(err)
+	is.Equal(resp.Changed, int32(1))
+	is.Equal((<-evts).Rounds, []int32{2})
+	_, err = a.SubmitGameResults(ctx, &pb.SubmitGameResultsRequest{
+		MarketId: "nationals2022",
+		Results: []*pb.GameResult{
+			{Round: 2, Player: "César", Opponent: "Noah", PlayerScore: 410, OpponentScore: 400},
+		},
+	})
+	is.NoErr(err)
+	<-evts
+
+	results, err := NewMarketService(s).GetGameResults(ctx, &pb.GetGameResultsRequest{MarketId: "nationals2022"})
+	is.NoErr(err)
+	is.Equal(len(results.Results), 3)
+	is.Equal(results.Results[0].Player, "César")
+	is.Equal(results.Results[0].Opponent, "")
+	is.Equal(results.Results[1].Player, "Kenji")
+	is.Equal(results.Results[1].PlayerScore, int32(455))
+	is.Equal(results.Results[2].Player, "César")
+	is.Equal(results.Results[2].Opponent, "Noah")
+	is.Equal(results.Results[2].PlayerScore, int32(410))
+	select {
+	case e := <-evts:
+		t.Fatalf("unexpected event %v", e)
+	default:
+	}
+
+	_, err = a.SubmitGameResults(ctx, &pb.SubmitGameResultsRequest{
+		MarketId: "nationals2022",
+		Results:  []*pb.GameResult{{Round: 0, Player: "Kenji", Opponent: "Noah"}},
+	})
+	is.True(err != nil)
+}
+
+func TestGrantTokens(t *testing.T) {
+	initDB()
+	addFixtures("./testfixtures/basic.sql")
+	is := is.New(t)
+	ctx := context.Background()
+	s, _ := NewSqliteStore(cfg.DBPath)
+	a := NewAdminService(s)
+	m := NewMarketService(s)
+
+	_, err := a.GrantTokens(ctx, &pb.GrantTokensRequest{Username: "josh"})
+	is.True(err != nil)
+	_, err = a.GrantTokens(ctx, &pb.GrantTokensRequest{Username: "nobody", AmountMicros: lmsr.Micros})
+	is.True(err != nil)
+	_, err = a.GrantTokens(ctx, &pb.GrantTokensRequest{Username: "josh", AmountMicros: 500 * lmsr.Micros})
+	is.NoErr(err)
+	is.Equal(tokens(s, "josh"), int64(2500*lmsr.Micros))
+
+	_, err = m.GetLedger(ctx, &pb.GetLedgerRequest{})
+	is.True(err != nil) // nobody is logged in
+	resp, err := m.GetLedger(WithUsername(ctx, "josh"), &pb.GetLedgerRequest{})
+	is.NoErr(err)
+	is.Equal(len(resp.Entries), 2)
+	is.Equal(resp.Entries[0].Date, "2022-07-01T00:00:00Z")
+	is.Equal(resp.Entries[1].Kind, pb.LedgerEntryKind_GRANT)
+	is.Equal(resp.Entries[1].AmountMicros, int64(500*lmsr.Micros))
+	is.Equal(resp.BalanceMicros, int64(2500*lmsr.Micros))
+
+	resp, err = m.GetLedger(WithUsername(ctx, "josh"), &pb.GetLedgerRequest{EndDate: "2022-07-02T00:00:00Z"})
+	is.NoErr(err)
+	is.Equal(len(resp.Entries), 1)
+	is.Equal(resp.Entries[0].BalanceMicros, int64(2000*lmsr.Micros))
+}
+
+func TestAudit(t *testing.T) {
+	initDB()
+	addFixtures("./testfixtures/basic.sql")
+	is := is.New(t)
+	ctx := context.Background()
+	s, _ := NewSqliteStore(cfg.DBPath)
+	a := NewAdminService(s)
+
+	is.NoErr(s.OpenMarket(ctx, "nationals2022"))
+	_, err := s.FulfillOrder(ctx, "cesar", "S3uuid", "nationals2022", 10*lmsr.Micros, true, "")
+	is.NoErr(err)
+	_, err = s.FulfillOrder(ctx, "josh", "S4uuid", "nationals2022", 5*lmsr.Micros, true, "")
+	is.NoErr(err)
+	// Seeded shares are outstanding, but nobody holds them.
+	m, err := a.CreateMatchupMarkets(ctx, &pb.CreateMatchupMarketsRequest{
+		Description: "Nationals 2022 round 1",
+		Pairings:    []*pb.Pairing{{PlayerOne: "Kenji", PlayerTwo: "Josh", PlayerOneRating: 2200, PlayerTwoRating: 1800}},
+	})
+	is.NoErr(err)
+	_, err = s.FulfillOrder(ctx, "josh", "S4uuid", "nationals2022", 2*lmsr.Micros, false, "")
+	is.NoErr(err)
+	_, err = a.ResolveMatchupMarkets(ctx, &pb.ResolveMatchupMarketsRequest{
+		Results: []*pb.ResolveMatchupMarketsRequest_Result{
+			{MarketId: m.Ids[0], PlayerOneScore: 400, PlayerTwoScore: 380}},
+	})
+	is.NoErr(err)
+
+	resp, err := a.Audit(ctx, &pb.AuditRequest{})
+	is.NoErr(err)
+	is.Equal(len(resp.Violations), 0)
+
+	// Any change to the shares outstanding in an unresolved market would
+	// throw its prices off too, so break a resolved one.
+	secs, err := s.GetSecurities(ctx, m.Ids[0])
+	is.NoErr(err)
+	for _, q := range []string{
+		`UPDATE securities SET shares_outstanding = shares_outstanding + 1
+			WHERE uuid = "` + secs[0].Id + `"`,
+		`UPDATE securities SET last_price = 30 WHERE uuid = "S2uuid"`,
+		`UPDATE portfolios SET tokens = tokens + 1 WHERE user_id = 2`,
+		`UPDATE portfolio_securities SET amount = -1 WHERE security_id =
+			(SELECT id FROM securities WHERE uuid = "S3uuid")`,
+	} {
+		_, err := s.db.Exec(q)
+		is.NoErr(err)
+	}
+	resp, err = a.Audit(ctx, &pb.AuditRequest{})
+	is.NoErr(err)
+	is.Equal(len(resp.Violations), 5)
+	v := resp.Violations
+	// Cesar's holding no longer adds up either.
+	is.Equal(v[0].Invariant, pb.Invariant_SHARES_OUTSTANDING)
+	is.Equal(v[0].SecurityId, "S3uuid")
+	is.Equal(v[0].Expected, "-1")
+	is.Equal(v[0].Actual, "10000000")
+	is.Equal(v[1].Invariant, pb.Invariant_SHARES_OUTSTANDING)
+	is.Equal(v[1].SecurityId, secs[0].Id)
+	is.Equal(v[1].Expected, strconv.FormatInt(secs[0].SharesOutstandingMicros, 10))
+	is.Equal(v[2].Invariant, pb.Invariant_LAST_PRICE)
+	is.Equal(v[2].SecurityId, "S2uuid")
+	is.Equal(v[2].Actual, "30")
+	is.Equal(v[3].Invariant, pb.Invariant_TOKEN_BALANCE)
+	is.Equal(v[3].Username, "josh")
+	is.Equal(v[4].Invariant, pb.Invariant_NO_NEGATIVE_HOLDINGS)
+	is.Equal(v[4].Username, "cesar")
+	is.Equal(v[4].MarketId, "nationals2022")
+	is.Equal(v[4].Actual, "-1")
+}
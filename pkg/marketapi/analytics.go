@@ -0,0 +1,434 @@
+package marketapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/domino14/scrabfutures/pkg/events"
+	"github.com/domino14/scrabfutures/pkg/lmsr"
+	"github.com/domino14/scrabfutures/pkg/scoring"
+	pb "github.com/domino14/scrabfutures/rpc/proto"
+)
+
+// The helpers in this file keep game results, score resolved markets and
+// rank traders. Like recordEntry, they are shared by SqliteStore and
+// PostgresStore.
+
+func checkGameResults(results []*pb.GameResult) error {
+	for _, r := range results {
+		if r.Round < 1 || r.Player == "" || r.Player == r.Opponent {
+			return fmt.Errorf("invalid result: round %d, %q vs. %q", r.Round, r.Player, r.Opponent)
+		}
+	}
+	return nil
+}
+
+// saveGameResults stores results from a market's tournament, replacing any
+// earlier results for the same games. It returns the GameResults event for
+// the rounds that changed, and how many results changed anything.
+func saveGameResults(ctx context.Context, q execer, marketID string,
+	results []*pb.GameResult, date string) (events.Event, int, error) {
+
+	e := events.Event{Type: events.GameResults, MarketID: marketID, Date: date}
+	marketDBID, err := lookupID(ctx, q, "markets", "uuid", marketID)
+	if err != nil {
+		return e, 0, err
+	}
+	if err := checkGameResults(results); err != nil {
+		return e, 0, err
+	}
+	changed := 0
+	rounds := map[int32]bool{}
+	for _, r := range results {
+		r = normalizeResult(r)
+		res, err := q.ExecContext(ctx, `
+			INSERT INTO game_results(market_id, round, player, opponent,
+				player_score, opponent_score, date)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)
+			ON CONFLICT(market_id, round, player, opponent) DO UPDATE
+			SET player_score = excluded.player_score,
+				opponent_score = excluded.opponent_score,
+				date = excluded.date
+			WHERE game_results.player_score != excluded.player_score
+				OR game_results.opponent_score != excluded.opponent_score`,
+			marketDBID, r.Round, r.Player, r.Opponent, r.PlayerScore, r.OpponentScore, date)
+		if err != nil {
+			return e, 0, err
+		}
+		n, err := res.RowsAffected()
+		if err != nil {
+			return e, 0, err
+		}
+		if n > 0 {
+			changed++
+			rounds[r.Round] = true
+		}
+	}
+	e.Rounds = sortedRounds(rounds)
+	return e, changed, nil
+}
+
+func sortedRounds(rounds map[int32]bool) []int32 {
+	sorted := []int32{}
+	for round := range rounds {
+		sorted = append(sorted, round)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	return sorted
+}
+
+// normalizeResult puts the players in a game in alphabetical order, so that
+// the same game is stored once whichever player it is reported for.
+func normalizeResult(r *pb.GameResult) *pb.GameResult {
+	if r.Opponent == "" || r.Player < r.Opponent {
+		return r
+	}
+	return &pb.GameResult{
+		Round:         r.Round,
+		Player:        r.Opponent,
+		Opponent:      r.Player,
+		PlayerScore:   r.OpponentScore,
+		OpponentScore: r.PlayerScore,
+	}
+}
+
+// loadGameResults returns every result stored for a market, by round.
+func loadGameResults(ctx context.Context, q querier, marketID string) ([]*pb.GameResult, error) {
+	marketDBID, err := lookupID(ctx, q, "markets", "uuid", marketID)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := q.QueryContext(ctx, `
+		SELECT round, player, opponent, player_score, opponent_score
+		FROM game_results
+		WHERE market_id = $1
+		ORDER BY round, player`, marketDBID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	results := []*pb.GameResult{}
+	for rows.Next() {
+		r := &pb.GameResult{}
+		err := rows.Scan(&r.Round, &r.Player, &r.Opponent, &r.PlayerScore, &r.OpponentScore)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, r)
+	}
+	return results, rows.Err()
+}
+
+// scoreMarket scores a resolved market's price history against what its
+// securities paid out, and stores the scores, replacing any earlier ones.
+func scoreMarket(ctx context.Context, q execer, marketID string) ([]*pb.MarketScore, error) {
+	m, err := getMarket(ctx, q, marketID)
+	if err != nil {
+		return nil, err
+	}
+	marketDBID, err := lookupID(ctx, q, "markets", "uuid", marketID)
+	if err != nil {
+		return nil, err
+	}
+	history, err := q.QueryContext(ctx, `
+		SELECT securities.id, securities.payout, security_costs.cost,
+			security_costs.date
+		FROM securities
+		JOIN security_costs ON security_costs.security_id = securities.id
+		WHERE securities.market_id = $1
+		ORDER BY securities.id, security_costs.date, security_costs.id`, marketDBID)
+	if err != nil {
+		return nil, err
+	}
+	defer history.Close()
+	forecasts := []scoring.Forecast{}
+	lastID := int64(-1)
+	for history.Next() {
+		var id int64
+		var payout, cost float64
+		var date string
+		if err := history.Scan(&id, &payout, &cost, &date); err != nil {
+			return nil, err
+		}
+		if id != lastID {
+			forecasts = append(forecasts, scoring.Forecast{Outcome: payout / lmsr.MaxPayout})
+			lastID = id
+		}
+		f := &forecasts[len(forecasts)-1]
+		if err := addPrice(f, cost, date); err != nil {
+			return nil, err
+		}
+	}
+	if err := history.Err(); err != nil {
+		return nil, err
+	}
+	if err := history.Close(); err != nil {
+		return nil, err
+	}
+	var count int
+	err = q.QueryRowContext(ctx, `
+		SELECT COUNT(*) FROM securities WHERE market_id = $1`, marketDBID).Scan(&count)
+	if err != nil {
+		return nil, err
+	}
+	scores, err := marketScores(m, forecasts, count)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, sc := range scores {
+		_, err = q.ExecContext(ctx, `
+			INSERT INTO market_scores(market_id, checkpoint, brier, log_loss,
+				date_scored)
+			VALUES ($1, $2, $3, $4, $5)
+			ON CONFLICT(market_id, checkpoint) DO UPDATE
+			SET brier = excluded.brier, log_loss = excluded.log_loss,
+				date_scored = excluded.date_scored`,
+			marketDBID, strings.ToLower(sc.Checkpoint.String()), sc.Brier, sc.LogLoss,
+			sc.DateScored)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return scores, nil
+}
+
+// addPrice adds a price that a security had since date to its forecast.
+func addPrice(f *scoring.Forecast, price float64, date string) error {
+	t, err := time.Parse(time.RFC3339, date)
+	if err != nil {
+		return err
+	}
+	f.Prices = append(f.Prices, scoring.Point{Time: t, Price: price / lmsr.MaxPayout})
+	return nil
+}
+
+// marketScores scores the forecasts made by a resolved market's prices, one
+// for each of its securities, at every checkpoint. It is shared by every
+// store.
+func marketScores(m *pb.Market, forecasts []scoring.Forecast, securities int) ([]*pb.MarketScore, error) {
+	if m.DateResolved == "" || m.Voided {
+		return nil, errors.New("only resolved markets can be scored")
+	}
+	end, err := time.Parse(time.RFC3339, m.DateResolved)
+	if err != nil {
+		return nil, err
+	}
+	if len(forecasts) == 0 || len(forecasts) != securities {
+		return nil, errors.New("market has no price history for some securities")
+	}
+	var start time.Time
+	for _, f := range forecasts {
+		for _, p := range f.Prices {
+			if start.IsZero() || p.Time.Before(start) {
+				start = p.Time
+			}
+		}
+	}
+
+	exclusive := m.MarketType != pb.MarketType_RANKING
+	finalDay := end.Add(-24 * time.Hour)
+	if finalDay.Before(start) {
+		finalDay = start
+	}
+	checkpoints := map[pb.ScoreCheckpoint]scoring.Score{
+		pb.ScoreCheckpoint_TIME_WEIGHTED: scoring.TimeWeighted(forecasts, exclusive, start, end),
+		pb.ScoreCheckpoint_OPEN:          scoring.At(forecasts, exclusive, start),
+		pb.ScoreCheckpoint_FINAL_DAY:     scoring.At(forecasts, exclusive, finalDay),
+		pb.ScoreCheckpoint_CLOSE:         scoring.At(forecasts, exclusive, end),
+	}
+
+	scored := now()
+	scores := []*pb.MarketScore{}
+	for cp := pb.ScoreCheckpoint_TIME_WEIGHTED; cp <= pb.ScoreCheckpoint_CLOSE; cp++ {
+		sc := checkpoints[cp]
+		scores = append(scores, &pb.MarketScore{
+			MarketId:   m.Id,
+			Checkpoint: cp,
+			Brier:      sc.Brier,
+			LogLoss:    sc.LogLoss,
+			DateScored: scored,
+		})
+	}
+	return scores, nil
+}
+
+// loadMarketScores returns a market's scores, or every scored market's if
+// marketID is empty.
+func loadMarketScores(ctx context.Context, q querier, marketID string) ([]*pb.MarketScore, error) {
+	where := ""
+	args := []any{}
+	if marketID != "" {
+		where = "WHERE markets.uuid = $1"
+		args = append(args, marketID)
+	}
+	rows, err := q.QueryContext(ctx, `
+		SELECT markets.uuid, checkpoint, brier, log_loss, date_scored
+		FROM market_scores
+		JOIN markets ON market_scores.market_id = markets.id
+		`+where+`
+		ORDER BY markets.id, CASE checkpoint
+			WHEN 'time_weighted' THEN 0 WHEN 'open' THEN 1
+			WHEN 'final_day' THEN 2 ELSE 3 END`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	scores := []*pb.MarketScore{}
+	for rows.Next() {
+		sc := &pb.MarketScore{}
+		var checkpoint string
+		err := rows.Scan(&sc.MarketId, &checkpoint, &sc.Brier, &sc.LogLoss, &sc.DateScored)
+		if err != nil {
+			return nil, err
+		}
+		sc.Checkpoint = pb.ScoreCheckpoint(pb.ScoreCheckpoint_value[strings.ToUpper(checkpoint)])
+		scores = append(scores, sc)
+	}
+	return scores, rows.Err()
+}
+
+// leaderboard ranks users by portfolio value, or by P&L if the request is
+// filtered to a market or a time window. See GetLeaderboardRequest.
+func leaderboard(ctx context.Context, q querier, req *pb.GetLeaderboardRequest) (*pb.GetLeaderboardResponse, error) {
+	entries := map[string]*pb.LeaderboardEntry{}
+	rows, err := q.QueryContext(ctx, `
+		SELECT users.username, portfolios.tokens,
+			COALESCE((SELECT SUM(portfolio_securities.amount * securities.last_price)
+				FROM portfolio_securities
+				JOIN securities ON portfolio_securities.security_id = securities.id
+				JOIN markets ON securities.market_id = markets.id
+				WHERE portfolio_securities.user_id = users.id
+					AND markets.date_resolved IS NULL), 0),
+			COALESCE((SELECT amount FROM ledger
+				WHERE kind = $1 AND to_account = 'user:' || users.username
+				ORDER BY id LIMIT 1), 0)
+		FROM users
+		JOIN portfolios ON portfolios.user_id = users.id`,
+		strings.ToLower(pb.LedgerEntryKind_GRANT.String()))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		e := &pb.LeaderboardEntry{}
+		var tokens, starting int64
+		var holdings float64
+		if err := rows.Scan(&e.Username, &tokens, &holdings, &starting); err != nil {
+			return nil, err
+		}
+		e.PortfolioValue = lmsr.FromMicros(tokens) + holdings/lmsr.Micros
+		// A user starts with their first grant; later ones are top-ups.
+		e.StartingTokens = lmsr.FromMicros(starting)
+		entries[e.Username] = e
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	wheres := []string{"1 = 1"}
+	wheresVars := []any{}
+	where := func(clause string, v any) {
+		wheresVars = append(wheresVars, v)
+		wheres = append(wheres, clause+" $"+strconv.Itoa(len(wheresVars)))
+	}
+	if req.MarketId != "" {
+		where("markets.uuid =", req.MarketId)
+	}
+	if req.BeginDate != "" {
+		where("orders.date >=", req.BeginDate)
+	}
+	if req.EndDate != "" {
+		where("orders.date <=", req.EndDate)
+	}
+	rows, err = q.QueryContext(ctx, `
+		SELECT users.username,
+			SUM(CASE WHEN markets.voided THEN 0
+				WHEN markets.date_resolved IS NOT NULL
+				THEN orders.amount * securities.payout - orders.cost
+				ELSE 0 END),
+			SUM(CASE WHEN markets.date_resolved IS NULL
+				THEN orders.amount * securities.last_price - orders.cost
+				ELSE 0 END)
+		FROM orders
+		JOIN users ON orders.user_id = users.id
+		JOIN securities ON orders.security_id = securities.id
+		JOIN markets ON securities.market_id = markets.id
+		WHERE `+strings.Join(wheres, " AND ")+`
+		GROUP BY users.id`, wheresVars...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	traded := map[string]bool{}
+	for rows.Next() {
+		var username string
+		var realized, unrealized float64
+		if err := rows.Scan(&username, &realized, &unrealized); err != nil {
+			return nil, err
+		}
+		e, ok := entries[username]
+		if !ok {
+			continue
+		}
+		e.RealizedPnl = realized / lmsr.Micros
+		e.UnrealizedPnl = unrealized / lmsr.Micros
+		traded[username] = true
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return rankLeaderboard(entries, traded, req), nil
+}
+
+// rankLeaderboard ranks and pages the entries for every user, given which
+// users traded within the request's filters. It is shared by every store.
+func rankLeaderboard(entries map[string]*pb.LeaderboardEntry, traded map[string]bool,
+	req *pb.GetLeaderboardRequest) *pb.GetLeaderboardResponse {
+
+	filtered := req.MarketId != "" || req.BeginDate != "" || req.EndDate != ""
+	board := []*pb.LeaderboardEntry{}
+	for username, e := range entries {
+		if filtered && !traded[username] {
+			continue
+		}
+		if e.StartingTokens != 0 {
+			e.ReturnOnStart = (e.RealizedPnl + e.UnrealizedPnl) / e.StartingTokens
+		}
+		board = append(board, e)
+	}
+	key := func(e *pb.LeaderboardEntry) float64 {
+		if filtered {
+			return e.RealizedPnl + e.UnrealizedPnl
+		}
+		return e.PortfolioValue
+	}
+	sort.Slice(board, func(i, j int) bool {
+		if key(board[i]) != key(board[j]) {
+			return key(board[i]) > key(board[j])
+		}
+		return board[i].Username < board[j].Username
+	})
+	for idx, e := range board {
+		e.Rank = int32(idx + 1)
+	}
+
+	resp := &pb.GetLeaderboardResponse{Total: int32(len(board))}
+	start := int(req.Offset)
+	if start > len(board) {
+		start = len(board)
+	}
+	end := len(board)
+	if req.Limit > 0 && start+int(req.Limit) < end {
+		end = start + int(req.Limit)
+	}
+	resp.Entries = board[start:end]
+	return resp
+}
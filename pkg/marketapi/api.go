@@ -2,17 +2,329 @@ package marketapi
 
 import (
 	"context"
+	"fmt"
+	"math/rand"
+	"time"
 
+	"github.com/twitchtv/twirp"
+
+	"github.com/domino14/scrabfutures/pkg/candles"
+	"github.com/domino14/scrabfutures/pkg/lmsr"
+	"github.com/domino14/scrabfutures/pkg/sim"
 	pb "github.com/domino14/scrabfutures/rpc/proto"
 )
 
-type MarketService struct{}
+// DefaultSimulations is how many times a tournament is simulated to estimate
+// model probabilities, if the request doesn't say.
+const DefaultSimulations = 10000
+
+// MaxSimulations caps how much work a single request can ask for.
+const MaxSimulations = 100000
 
-func NewMarketService() *MarketService {
-	return &MarketService{}
+type MarketService struct {
+	store Store
+}
+
+func NewMarketService(store Store) *MarketService {
+	return &MarketService{store: store}
 }
 
 func (m *MarketService) GetOrderBook(ctx context.Context, req *pb.GetOrderBookRequest) (*pb.OrderBookResponse, error) {
+	if req.Limit < 0 || req.Limit > maxOrderBookLimit {
+		return nil, twirp.InvalidArgumentError("limit", fmt.Sprintf("must be between 0 and %d", maxOrderBookLimit))
+	}
+	if _, _, err := orderBookPage(req); err != nil {
+		return nil, twirp.InvalidArgumentError("page_token", "is not valid")
+	}
+	resp, err := m.store.GetOrderBook(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range resp.Orders {
+		o.Amount = lmsr.FromMicros(o.AmountMicros)
+		o.Cost = lmsr.FromMicros(o.CostMicros)
+	}
+	return resp, nil
+}
+
+func (m *MarketService) GetOpenMarkets(ctx context.Context, req *pb.GetOpenMarketsRequest) (*pb.GetOpenMarketsResponse, error) {
+	statuses := req.Statuses
+	if len(statuses) == 0 {
+		statuses = []pb.Market_Status{pb.Market_OPEN}
+	}
+	for _, st := range statuses {
+		if _, ok := pb.Market_Status_name[int32(st)]; !ok {
+			return nil, twirp.InvalidArgumentError("statuses", fmt.Sprintf("unknown status %d", st))
+		}
+	}
+	markets, err := m.store.GetMarkets(ctx, statuses...)
+	if err != nil {
+		return nil, err
+	}
+	if req.IncludeSecurities {
+		for _, market := range markets {
+			market.Securities, err = m.store.GetSecurities(ctx, market.Id)
+			if err != nil {
+				return nil, err
+			}
+			for _, sec := range market.Securities {
+				fillSecurityShares(sec)
+			}
+		}
+	}
+	return &pb.GetOpenMarketsResponse{Markets: markets}, nil
+}
+
+func (m *MarketService) BuySecurity(ctx context.Context, req *pb.SecurityRequest) (*pb.MarketActionResponse, error) {
+	return m.trade(ctx, req, true)
+}
+
+func (m *MarketService) SellSecurity(ctx context.Context, req *pb.SecurityRequest) (*pb.MarketActionResponse, error) {
+	return m.trade(ctx, req, false)
+}
+
+func (m *MarketService) trade(ctx context.Context, req *pb.SecurityRequest, buy bool) (*pb.MarketActionResponse, error) {
+	username := Username(ctx)
+	if username == "" {
+		return nil, twirp.Unauthenticated.Error("no user")
+	}
+	amount := req.AmountMicros
+	if amount == 0 {
+		amount = lmsr.ToMicros(req.Amount)
+	}
+	if amount <= 0 {
+		return nil, twirp.InvalidArgumentError("amount_micros", "must be positive")
+	}
+	cost, err := m.store.FulfillOrder(ctx, username, req.SecurityId, req.MarketId, amount, buy,
+		req.IdempotencyKey)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.MarketActionResponse{Cost: lmsr.FromMicros(cost), CostMicros: cost}, nil
+}
+
+func (m *MarketService) GetPortfolio(ctx context.Context, req *pb.GetPortfolioRequest) (*pb.GetPortfolioResponse, error) {
+	username := Username(ctx)
+	if username == "" {
+		return nil, twirp.Unauthenticated.Error("no user")
+	}
+	portfolio, err := m.store.GetPortfolio(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	portfolio.Tokens = lmsr.FromMicros(portfolio.TokensMicros)
+	for _, p := range portfolio.Positions {
+		p.Amount = lmsr.FromMicros(p.AmountMicros)
+		fillSecurityShares(p.Security)
+	}
+	return &pb.GetPortfolioResponse{Portfolio: portfolio}, nil
+}
+
+func (m *MarketService) GetLedger(ctx context.Context, req *pb.GetLedgerRequest) (*pb.GetLedgerResponse, error) {
+	username := Username(ctx)
+	if username == "" {
+		return nil, twirp.Unauthenticated.Error("no user")
+	}
+	entries, balance, err := m.store.GetLedger(ctx, username, req.BeginDate, req.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetLedgerResponse{Entries: entries, BalanceMicros: balance}, nil
+}
+
+func (m *MarketService) GetSecurities(ctx context.Context, req *pb.GetSecuritiesRequest) (*pb.GetSecuritiesResponse, error) {
+	if req.MarketId == "" {
+		return nil, twirp.RequiredArgumentError("market_id")
+	}
+	secs, err := m.store.GetSecurities(ctx, req.MarketId)
+	if err != nil {
+		return nil, err
+	}
+	for _, sec := range secs {
+		fillSecurityShares(sec)
+	}
+	return &pb.GetSecuritiesResponse{Securities: secs}, nil
+}
+
+// fillSecurityShares fills in the deprecated shares_outstanding, for clients
+// that don't read shares_outstanding_micros yet.
+func fillSecurityShares(sec *pb.Security) {
+	sec.SharesOutstanding = lmsr.FromMicros(sec.SharesOutstandingMicros)
+}
+
+func (m *MarketService) GetSecurityCosts(ctx context.Context, req *pb.GetSecurityCostsRequest) (*pb.GetSecurityCostsResponse, error) {
+	if req.SecurityId == "" {
+		return nil, twirp.RequiredArgumentError("security_id")
+	}
+	costs, err := m.store.GetSecurityCosts(ctx, req.SecurityId, req.BeginDate, req.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetSecurityCostsResponse{Costs: costs}, nil
+}
+
+func (m *MarketService) GetModelProbabilities(ctx context.Context, req *pb.GetModelProbabilitiesRequest) (*pb.GetModelProbabilitiesResponse, error) {
+	if req.Rounds <= 0 {
+		return nil, twirp.InvalidArgumentError("rounds", "must be positive")
+	}
+	simulations := int(req.Simulations)
+	if simulations == 0 {
+		simulations = DefaultSimulations
+	}
+	if simulations < 0 || simulations > MaxSimulations {
+		return nil, twirp.InvalidArgumentError("simulations",
+			fmt.Sprintf("must be between 1 and %d", MaxSimulations))
+	}
+	market, err := m.store.GetMarket(ctx, req.MarketId)
+	if err != nil {
+		return nil, err
+	}
+	if market.MarketType != pb.MarketType_EXCLUSIVE {
+		return nil, twirp.InvalidArgumentError("market_id", "only exclusive markets can be simulated")
+	}
+	secs, err := m.store.GetSecurities(ctx, req.MarketId)
+	if err != nil {
+		return nil, err
+	}
+	if len(secs) == 0 {
+		return nil, twirp.InvalidArgumentError("market_id", "market has no securities")
+	}
+	ratings := make([]float64, len(secs))
+	for idx, sec := range secs {
+		if sec.Rating == 0 {
+			return nil, twirp.InvalidArgumentError("market_id",
+				fmt.Sprintf("security %s has no rating", sec.Shortname))
+		}
+		ratings[idx] = sec.Rating
+	}
+
+	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+	probs := sim.WinProbabilities(sim.Tournament{
+		Ratings: ratings,
+		Rounds:  int(req.Rounds),
+		Pairing: sim.PairingSystem(req.PairingSystem),
+	}, simulations, rng)
+
+	resp := &pb.GetModelProbabilitiesResponse{}
+	for idx, sec := range secs {
+		resp.Probabilities = append(resp.Probabilities, &pb.GetModelProbabilitiesResponse_SecurityProbability{
+			SecurityId:       sec.Id,
+			Shortname:        sec.Shortname,
+			ModelProbability: probs[idx],
+			LastPrice:        sec.LastPrice,
+		})
+	}
+	return resp, nil
+}
+
+func (m *MarketService) GetGameResults(ctx context.Context, req *pb.GetGameResultsRequest) (*pb.GetGameResultsResponse, error) {
+	if req.MarketId == "" {
+		return nil, twirp.RequiredArgumentError("market_id")
+	}
+	rs, ok := m.store.(ResultsStore)
+	if !ok {
+		return nil, twirp.NewError(twirp.Unimplemented, "game results are not kept")
+	}
+	results, err := rs.GetGameResults(ctx, req.MarketId)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetGameResultsResponse{Results: results}, nil
+}
+
+func (m *MarketService) GetMarketScores(ctx context.Context, req *pb.GetMarketScoresRequest) (*pb.GetMarketScoresResponse, error) {
+	as, ok := m.store.(AnalyticsStore)
+	if !ok {
+		return nil, twirp.NewError(twirp.Unimplemented, "markets are not scored")
+	}
+	scores, err := as.GetMarketScores(ctx, req.MarketId)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetMarketScoresResponse{Scores: scores}, nil
+}
+
+func (m *MarketService) GetLeaderboard(ctx context.Context, req *pb.GetLeaderboardRequest) (*pb.GetLeaderboardResponse, error) {
+	if req.Limit < 0 || req.Offset < 0 {
+		return nil, twirp.InvalidArgumentError("limit", "limit and offset can't be negative")
+	}
+	as, ok := m.store.(AnalyticsStore)
+	if !ok {
+		return nil, twirp.NewError(twirp.Unimplemented, "traders are not ranked")
+	}
+	return as.GetLeaderboard(ctx, req)
+}
+
+var candleIntervals = map[pb.CandleInterval]time.Duration{
+	pb.CandleInterval_ONE_MINUTE: time.Minute,
+	pb.CandleInterval_ONE_HOUR:   time.Hour,
+	pb.CandleInterval_ONE_DAY:    24 * time.Hour,
+}
+
+func (m *MarketService) GetCandles(ctx context.Context, req *pb.GetCandlesRequest) (*pb.GetCandlesResponse, error) {
+	interval, ok := candleIntervals[req.Interval]
+	if !ok {
+		return nil, twirp.InvalidArgumentError("interval", "unknown interval")
+	}
+	var secs []*pb.Security
+	switch {
+	case req.SecurityId != "" && req.MarketId != "":
+		return nil, twirp.InvalidArgumentError("market_id", "can't be set along with security_id")
+	case req.SecurityId != "":
+		sec, err := m.store.GetSecurity(ctx, req.SecurityId)
+		if err != nil {
+			return nil, err
+		}
+		secs = []*pb.Security{sec}
+	case req.MarketId != "":
+		var err error
+		secs, err = m.store.GetSecurities(ctx, req.MarketId)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, twirp.RequiredArgumentError("security_id")
+	}
+
+	resp := &pb.GetCandlesResponse{}
+	for _, sec := range secs {
+		costs, err := m.store.GetSecurityCosts(ctx, sec.Id, req.BeginDate, req.EndDate)
+		if err != nil {
+			return nil, err
+		}
+		orders, err := m.store.GetSecurityOrders(ctx, sec.Id, req.BeginDate, req.EndDate)
+		if err != nil {
+			return nil, err
+		}
+		points := make([]candles.Point, len(costs))
+		for idx, c := range costs {
+			t, err := time.Parse(time.RFC3339, c.Date)
+			if err != nil {
+				return nil, err
+			}
+			points[idx] = candles.Point{Time: t, Price: c.Cost}
+		}
+		trades := make([]candles.Trade, len(orders))
+		for idx, o := range orders {
+			t, err := time.Parse(time.RFC3339, o.DateCreated)
+			if err != nil {
+				return nil, err
+			}
+			trades[idx] = candles.Trade{Time: t, Amount: lmsr.FromMicros(o.AmountMicros)}
+		}
 
-	return nil, nil
+		sc := &pb.GetCandlesResponse_SecurityCandles{SecurityId: sec.Id, Shortname: sec.Shortname}
+		for _, c := range candles.Build(points, trades, interval) {
+			sc.Candles = append(sc.Candles, &pb.Candle{
+				Start:  c.Start.Format(time.RFC3339),
+				Open:   c.Open,
+				High:   c.High,
+				Low:    c.Low,
+				Close:  c.Close,
+				Volume: c.Volume,
+			})
+		}
+		resp.Securities = append(resp.Securities, sc)
+	}
+	return resp, nil
 }
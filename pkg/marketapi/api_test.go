@@ -0,0 +1,381 @@
+package marketapi
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/domino14/scrabfutures/pkg/lmsr"
+	pb "github.com/domino14/scrabfutures/rpc/proto"
+	"github.com/matryer/is"
+	"github.com/twitchtv/twirp"
+)
+
+func TestGetModelProbabilities(t *testing.T) {
+	initDB()
+	is := is.New(t)
+	ctx := context.Background()
+	s, _ := NewSqliteStore(cfg.DBPath)
+	m := NewMarketService(s)
+
+	id, err := s.CreateMarket(ctx, &pb.CreateMarketRequest{Description: "Who will win Nationals?"})
+	is.NoErr(err)
+	is.NoErr(s.AddSecurities(ctx, id, []*pb.AddSecuritiesRequest_Security{
+		{Description: "Kenji wins", Shortname: "Kenji", Rating: 2100},
+		{Description: "Noah wins", Shortname: "Noah", Rating: 1900},
+		{Description: "Josh wins", Shortname: "Josh", Rating: 1700},
+	}))
+
+	resp, err := m.GetModelProbabilities(ctx, &pb.GetModelProbabilitiesRequest{
+		MarketId:      id,
+		Rounds:        6,
+		PairingSystem: pb.PairingSystem_ROUND_ROBIN,
+	})
+	is.NoErr(err)
+	is.Equal(len(resp.Probabilities), 3)
+	is.Equal(resp.Probabilities[0].Shortname, "Kenji")
+	is.True(resp.Probabilities[0].ModelProbability > resp.Probabilities[1].ModelProbability)
+	is.True(resp.Probabilities[1].ModelProbability > resp.Probabilities[2].ModelProbability)
+	// The opening price was seeded from the same ratings.
+	is.True(resp.Probabilities[0].LastPrice > resp.Probabilities[1].LastPrice)
+}
+
+func TestGetModelProbabilitiesUnrated(t *testing.T) {
+	initDB()
+	is := is.New(t)
+	ctx := context.Background()
+	s, _ := NewSqliteStore(cfg.DBPath)
+	m := NewMarketService(s)
+
+	id, err := s.CreateMarket(ctx, &pb.CreateMarketRequest{Description: "Who will win Nationals?"})
+	is.NoErr(err)
+	is.NoErr(s.AddSecurities(ctx, id, []*pb.AddSecuritiesRequest_Security{
+		{Description: "Kenji wins", Shortname: "Kenji"},
+		{Description: "Noah wins", Shortname: "Noah"},
+	}))
+	_, err = m.GetModelProbabilities(ctx, &pb.GetModelProbabilitiesRequest{MarketId: id, Rounds: 6})
+	is.True(err != nil)
+}
+
+func TestTradeThroughService(t *testing.T) {
+	initDB()
+	addFixtures("./testfixtures/basic.sql")
+	is := is.New(t)
+	ctx := context.Background()
+	s, _ := NewSqliteStore(cfg.DBPath)
+	m := NewMarketService(s)
+	is.NoErr(s.OpenMarket(ctx, "nationals2022"))
+
+	req := &pb.SecurityRequest{SecurityId: "S3uuid", MarketId: "nationals2022", AmountMicros: 10 * lmsr.Micros}
+	_, err := m.BuySecurity(ctx, req)
+	is.True(err != nil) // nobody is logged in
+
+	ctx = WithUsername(ctx, "cesar")
+	bought, err := m.BuySecurity(ctx, req)
+	is.NoErr(err)
+	is.True(bought.CostMicros > 250*lmsr.Micros)
+	is.Equal(bought.Cost, lmsr.FromMicros(bought.CostMicros))
+	// Older clients still send a floating point amount.
+	req = &pb.SecurityRequest{SecurityId: "S3uuid", MarketId: "nationals2022", Amount: 4,
+		IdempotencyKey: "sell-4"}
+	sold, err := m.SellSecurity(ctx, req)
+	is.NoErr(err)
+	is.True(sold.CostMicros < 0)
+	// Retrying the sale doesn't sell twice.
+	resold, err := m.SellSecurity(ctx, req)
+	is.NoErr(err)
+	is.Equal(resold.CostMicros, sold.CostMicros)
+
+	resp, err := m.GetPortfolio(ctx, &pb.GetPortfolioRequest{})
+	is.NoErr(err)
+	is.Equal(resp.Portfolio.TokensMicros, 2000*lmsr.Micros-bought.CostMicros-sold.CostMicros)
+	is.Equal(resp.Portfolio.Tokens, lmsr.FromMicros(resp.Portfolio.TokensMicros))
+	is.Equal(len(resp.Portfolio.Positions), 1)
+	is.Equal(resp.Portfolio.Positions[0].Security.Id, "S3uuid")
+	is.Equal(resp.Portfolio.Positions[0].AmountMicros, int64(6*lmsr.Micros))
+	is.Equal(resp.Portfolio.Positions[0].Amount, 6.0)
+
+	markets, err := m.GetOpenMarkets(ctx, &pb.GetOpenMarketsRequest{})
+	is.NoErr(err)
+	is.Equal(len(markets.Markets), 1)
+	is.Equal(markets.Markets[0].Id, "nationals2022")
+	is.Equal(len(markets.Markets[0].Securities), 0)
+	markets, err = m.GetOpenMarkets(ctx, &pb.GetOpenMarketsRequest{IncludeSecurities: true})
+	is.NoErr(err)
+	stored, err := s.GetSecurities(ctx, "nationals2022")
+	is.NoErr(err)
+	is.Equal(len(markets.Markets[0].Securities), len(stored))
+	for idx, sec := range markets.Markets[0].Securities {
+		is.Equal(sec.Id, stored[idx].Id)
+		is.Equal(sec.LastPrice, stored[idx].LastPrice)
+		is.Equal(sec.SharesOutstanding, lmsr.FromMicros(sec.SharesOutstandingMicros))
+	}
+	_, err = m.GetOpenMarkets(ctx, &pb.GetOpenMarketsRequest{Statuses: []pb.Market_Status{42}})
+	is.Equal(err.(twirp.Error).Code(), twirp.InvalidArgument)
+
+	book, err := m.GetOrderBook(ctx, &pb.GetOrderBookRequest{SecurityId: "S3uuid", Username: "cesar", Limit: 1})
+	is.NoErr(err)
+	is.Equal(len(book.Orders), 1)
+	is.Equal(book.Orders[0].Amount, -4.0)
+	is.Equal(book.Orders[0].Cost, lmsr.FromMicros(sold.CostMicros))
+	book, err = m.GetOrderBook(ctx, &pb.GetOrderBookRequest{SecurityId: "S3uuid", Username: "cesar",
+		PageToken: book.NextPageToken})
+	is.NoErr(err)
+	is.Equal(len(book.Orders), 1)
+	is.Equal(book.Orders[0].Amount, 10.0)
+	is.Equal(book.NextPageToken, "")
+	_, err = m.GetOrderBook(ctx, &pb.GetOrderBookRequest{PageToken: "bogus"})
+	is.Equal(err.(twirp.Error).Code(), twirp.InvalidArgument)
+	_, err = m.GetOrderBook(ctx, &pb.GetOrderBookRequest{Limit: -1})
+	is.Equal(err.(twirp.Error).Code(), twirp.InvalidArgument)
+
+	// The price log starts when the market opens, and has an entry for every
+	// trade.
+	costs, err := m.GetSecurityCosts(ctx, &pb.GetSecurityCostsRequest{SecurityId: "S3uuid"})
+	is.NoErr(err)
+	is.Equal(len(costs.Costs), 3)
+	is.Equal(costs.Costs[0].Cost, 25.0)
+	is.True(costs.Costs[1].Cost > costs.Costs[2].Cost)
+	secs, err := m.GetSecurities(ctx, &pb.GetSecuritiesRequest{MarketId: "nationals2022"})
+	is.NoErr(err)
+	is.Equal(secs.Securities[2].LastPrice, costs.Costs[2].Cost)
+	costs, err = m.GetSecurityCosts(ctx, &pb.GetSecurityCostsRequest{SecurityId: "S1uuid"})
+	is.NoErr(err)
+	is.Equal(len(costs.Costs), 3)
+}
+
+func TestGetLeaderboard(t *testing.T) {
+	initDB()
+	addFixtures("./testfixtures/basic.sql")
+	is := is.New(t)
+	ctx := context.Background()
+	s, _ := NewSqliteStore(cfg.DBPath)
+	m := NewMarketService(s)
+	near := func(a, b float64) bool { return math.Abs(a-b) < 1e-6 }
+
+	is.NoErr(s.OpenMarket(ctx, "nationals2022"))
+	_, err := s.FulfillOrder(ctx, "cesar", "S3uuid", "nationals2022", 10*lmsr.Micros, true, "")
+	is.NoErr(err)
+	_, err = s.FulfillOrder(ctx, "josh", "S1uuid", "nationals2022", 20*lmsr.Micros, true, "")
+	is.NoErr(err)
+
+	binary, _ := s.CreateMarket(ctx, &pb.CreateMarketRequest{
+		Description: "someone scores 700+ at nationals",
+		MarketType:  pb.MarketType_BINARY,
+	})
+	secs, _ := s.GetSecurities(ctx, binary)
+	is.NoErr(s.OpenMarket(ctx, binary))
+	cesarCost, err := s.FulfillOrder(ctx, "cesar", secs[0].Id, binary, 10*lmsr.Micros, true, "")
+	is.NoErr(err)
+	is.NoErr(s.ResolveMarket(ctx, &pb.ResolveMarketRequest{
+		MarketId: binary,
+		Resolutions: []*pb.ResolveMarketRequest_SecurityResolution{
+			{SecurityId: secs[0].Id, Wins: true}, {SecurityId: secs[1].Id, Wins: false},
+		},
+	}))
+
+	resp, err := m.GetLeaderboard(ctx, &pb.GetLeaderboardRequest{})
+	is.NoErr(err)
+	is.Equal(resp.Total, int32(2))
+	knji, _ := s.GetSecurity(ctx, "S1uuid")
+	csar, _ := s.GetSecurity(ctx, "S3uuid")
+	for _, e := range resp.Entries {
+		is.True(near(e.StartingTokens, 2000))
+		is.True(near(e.ReturnOnStart, (e.PortfolioValue-2000)/2000))
+		is.True(near(e.RealizedPnl+e.UnrealizedPnl, e.PortfolioValue-2000))
+		switch e.Username {
+		case "cesar":
+			is.True(near(e.PortfolioValue, lmsr.FromMicros(tokens(s, "cesar"))+10*csar.LastPrice))
+			is.True(near(e.RealizedPnl, 1000-lmsr.FromMicros(cesarCost)))
+		case "josh":
+			is.True(near(e.PortfolioValue, lmsr.FromMicros(tokens(s, "josh"))+20*knji.LastPrice))
+			is.Equal(e.RealizedPnl, 0.0)
+		}
+	}
+	is.Equal(resp.Entries[0].Rank, int32(1))
+	is.True(resp.Entries[0].PortfolioValue >= resp.Entries[1].PortfolioValue)
+
+	// In the nationals market, josh's shares are marked up by his own trade,
+	// and César's are marked down by it.
+	resp, err = m.GetLeaderboard(ctx, &pb.GetLeaderboardRequest{MarketId: "nationals2022"})
+	is.NoErr(err)
+	is.Equal(resp.Total, int32(2))
+	is.Equal(resp.Entries[0].Username, "josh")
+	is.Equal(resp.Entries[1].RealizedPnl, 0.0)
+
+	resp, err = m.GetLeaderboard(ctx, &pb.GetLeaderboardRequest{MarketId: binary})
+	is.NoErr(err)
+	is.Equal(resp.Total, int32(1))
+	is.Equal(resp.Entries[0].Username, "cesar")
+
+	resp, err = m.GetLeaderboard(ctx, &pb.GetLeaderboardRequest{Limit: 1, Offset: 1})
+	is.NoErr(err)
+	is.Equal(resp.Total, int32(2))
+	is.Equal(len(resp.Entries), 1)
+	is.Equal(resp.Entries[0].Rank, int32(2))
+
+	resp, err = m.GetLeaderboard(ctx, &pb.GetLeaderboardRequest{EndDate: "2022-01-01T00:00:00Z"})
+	is.NoErr(err)
+	is.Equal(resp.Total, int32(0))
+
+	// A later grant doesn't count towards what josh started with.
+	is.NoErr(s.GrantTokens(ctx, "josh", 500*lmsr.Micros))
+	resp, err = m.GetLeaderboard(ctx, &pb.GetLeaderboardRequest{MarketId: "nationals2022"})
+	is.NoErr(err)
+	is.Equal(resp.Entries[0].Username, "josh")
+	is.True(near(resp.Entries[0].StartingTokens, 2000))
+	is.True(near(resp.Entries[0].ReturnOnStart,
+		(resp.Entries[0].RealizedPnl+resp.Entries[0].UnrealizedPnl)/2000))
+}
+
+func TestPortfolioValuation(t *testing.T) {
+	initDB()
+	addFixtures("./testfixtures/basic.sql")
+	is := is.New(t)
+	ctx := WithUsername(context.Background(), "cesar")
+	s, _ := NewSqliteStore(cfg.DBPath)
+	m := NewMarketService(s)
+	near := func(a, b float64) bool { return math.Abs(a-b) < 1e-9 }
+	is.NoErr(s.OpenMarket(ctx, "nationals2022"))
+
+	first, err := s.FulfillOrder(ctx, "cesar", "S3uuid", "nationals2022", 10*lmsr.Micros, true, "")
+	is.NoErr(err)
+	second, err := s.FulfillOrder(ctx, "cesar", "S3uuid", "nationals2022", 10*lmsr.Micros, true, "")
+	is.NoErr(err)
+	_, err = s.FulfillOrder(ctx, "cesar", "S3uuid", "nationals2022", 5*lmsr.Micros, false, "")
+	is.NoErr(err)
+	_, err = s.FulfillOrder(ctx, "josh", "S1uuid", "nationals2022", 10*lmsr.Micros, true, "")
+	is.NoErr(err)
+
+	resp, err := m.GetPortfolio(ctx, &pb.GetPortfolioRequest{})
+	is.NoErr(err)
+	is.Equal(len(resp.Portfolio.Positions), 1)
+	p := resp.Portfolio.Positions[0]
+	is.Equal(p.AmountMicros, int64(15*lmsr.Micros))
+	is.True(near(p.AverageCost, lmsr.FromMicros(first+second)/20))
+	is.True(near(p.MarkValue, 15*p.Security.LastPrice))
+	is.True(near(p.UnrealizedPnl, p.MarkValue-15*p.AverageCost))
+
+	secs, _ := s.GetSecurities(ctx, "nationals2022")
+	allShares := []float64{}
+	for _, sec := range secs {
+		allShares = append(allShares, lmsr.FromMicros(sec.SharesOutstandingMicros))
+	}
+	liquidation := lmsr.TradeCostMicros(lmsr.Exclusive{B: lmsr.Liquidity}, -15*lmsr.Micros, allShares, 2)
+	is.Equal(p.LiquidationValue, -lmsr.FromMicros(liquidation))
+	is.True(p.LiquidationValue < p.MarkValue)
+
+	// Once the market resolves, the position has been paid out.
+	is.NoErr(s.ResolveMarket(ctx, &pb.ResolveMarketRequest{
+		MarketId: "nationals2022",
+		Resolutions: []*pb.ResolveMarketRequest_SecurityResolution{
+			{SecurityId: "S3uuid", Wins: true},
+		},
+	}))
+	resp, err = m.GetPortfolio(ctx, &pb.GetPortfolioRequest{})
+	is.NoErr(err)
+	is.Equal(len(resp.Portfolio.Positions), 0)
+}
+
+func TestGetCandles(t *testing.T) {
+	initDB()
+	addFixtures("./testfixtures/basic.sql")
+	is := is.New(t)
+	ctx := context.Background()
+	s, _ := NewSqliteStore(cfg.DBPath)
+	m := NewMarketService(s)
+	is.NoErr(s.OpenMarket(ctx, "nationals2022"))
+	_, err := s.FulfillOrder(ctx, "cesar", "S3uuid", "nationals2022", 10*lmsr.Micros, true, "")
+	is.NoErr(err)
+	_, err = s.FulfillOrder(ctx, "cesar", "S3uuid", "nationals2022", 4*lmsr.Micros, false, "")
+	is.NoErr(err)
+	_, err = s.FulfillOrder(ctx, "josh", "S1uuid", "nationals2022", 20*lmsr.Micros, true, "")
+	is.NoErr(err)
+
+	resp, err := m.GetCandles(ctx, &pb.GetCandlesRequest{
+		SecurityId: "S3uuid",
+		Interval:   pb.CandleInterval_ONE_DAY,
+	})
+	is.NoErr(err)
+	is.Equal(len(resp.Securities), 1)
+	is.Equal(resp.Securities[0].Shortname, "CSAR")
+	// The test could run across midnight, so don't count on one candle.
+	candles := resp.Securities[0].Candles
+	volume := func(candles []*pb.Candle) float64 {
+		v := float64(0)
+		for _, c := range candles {
+			v += c.Volume
+		}
+		return v
+	}
+	sec, _ := s.GetSecurity(ctx, "S3uuid")
+	is.Equal(candles[0].Open, 25.0)
+	is.Equal(candles[len(candles)-1].Close, sec.LastPrice)
+	high, low := candles[0].High, candles[0].Low
+	for _, c := range candles {
+		high, low = math.Max(high, c.High), math.Min(low, c.Low)
+	}
+	is.True(high > 25)
+	is.Equal(low, sec.LastPrice)
+	is.Equal(volume(candles), 14.0)
+
+	resp, err = m.GetCandles(ctx, &pb.GetCandlesRequest{
+		MarketId: "nationals2022",
+		Interval: pb.CandleInterval_ONE_HOUR,
+	})
+	is.NoErr(err)
+	is.Equal(len(resp.Securities), 4)
+	is.Equal(volume(resp.Securities[0].Candles), 20.0)
+	is.Equal(volume(resp.Securities[1].Candles), 0.0)
+
+	resp, err = m.GetCandles(ctx, &pb.GetCandlesRequest{
+		MarketId: "nationals2022",
+		EndDate:  "2022-01-01T00:00:00Z",
+	})
+	is.NoErr(err)
+	is.Equal(len(resp.Securities[0].Candles), 0)
+
+	_, err = m.GetCandles(ctx, &pb.GetCandlesRequest{})
+	is.True(err != nil)
+}
+
+func TestUsernameMiddleware(t *testing.T) {
+	is := is.New(t)
+	store := NewMemoryStore()
+	store.AddUser("cesar", 1000*lmsr.Micros)
+	service := pb.NewMarketServiceServer(NewMarketService(store))
+
+	portfolio := func(url, secret string) (*pb.Portfolio, error) {
+		client := pb.NewMarketServiceProtobufClient(url, http.DefaultClient)
+		header := http.Header{UsernameHeader: []string{"cesar"}}
+		if secret != "" {
+			header.Set(SecretHeader, secret)
+		}
+		ctx, err := twirp.WithHTTPRequestHeaders(context.Background(), header)
+		is.NoErr(err)
+		resp, err := client.GetPortfolio(ctx, &pb.GetPortfolioRequest{})
+		if err != nil {
+			return nil, err
+		}
+		return resp.Portfolio, nil
+	}
+
+	srv := httptest.NewServer(UsernameMiddleware("hunter2", service))
+	defer srv.Close()
+	for _, secret := range []string{"", "hunter3"} {
+		_, err := portfolio(srv.URL, secret)
+		is.Equal(err.(twirp.Error).Code(), twirp.Unauthenticated)
+	}
+	p, err := portfolio(srv.URL, "hunter2")
+	is.NoErr(err)
+	is.Equal(p.Username, "cesar")
+
+	// Without a secret, anyone who sets the header is believed.
+	trusting := httptest.NewServer(UsernameMiddleware("", service))
+	defer trusting.Close()
+	p, err = portfolio(trusting.URL, "")
+	is.NoErr(err)
+	is.Equal(p.Username, "cesar")
+}
@@ -0,0 +1,289 @@
+package marketapi
+
+import (
+	"context"
+	"math"
+	"strconv"
+
+	pb "github.com/domino14/scrabfutures/rpc/proto"
+)
+
+// priceTolerance is how far a security's last price can be from the market
+// maker's before it counts as wrong.
+const priceTolerance = 1e-9
+
+// Audit checks every invariant, and returns the rows that break them.
+func (s *SqliteStore) Audit(ctx context.Context) ([]*pb.InvariantViolation, error) {
+	return audit(ctx, s.db)
+}
+
+// Audit checks every invariant, and returns the rows that break them. It
+// doesn't lock anything, so it should be run when nobody is trading.
+func (s *PostgresStore) Audit(ctx context.Context) ([]*pb.InvariantViolation, error) {
+	return audit(ctx, s.db)
+}
+
+// Audit checks every invariant in the same order as the other stores, and
+// returns the securities, markets and users that break them.
+func (s *MemoryStore) Audit(ctx context.Context) ([]*pb.InvariantViolation, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	violations := []*pb.InvariantViolation{}
+	usernames := s.usernames()
+
+	for _, id := range s.securityIDs {
+		sec := s.securities[id]
+		held := s.seeds[id]
+		for _, u := range s.users {
+			held += u.holdings[id]
+		}
+		if held != sec.SharesOutstandingMicros {
+			violations = append(violations, &pb.InvariantViolation{
+				Invariant:  pb.Invariant_SHARES_OUTSTANDING,
+				MarketId:   sec.MarketId,
+				SecurityId: id,
+				Expected:   strconv.FormatInt(held, 10),
+				Actual:     strconv.FormatInt(sec.SharesOutstandingMicros, 10),
+			})
+		}
+	}
+
+	for _, id := range s.marketIDs {
+		m := s.markets[id]
+		secs := s.marketSecurities(id)
+		if m.DateResolved != "" || len(secs) == 0 {
+			continue
+		}
+		pricer, shares := s.marketShares(m, secs)
+		for idx, price := range pricer.Prices(shares) {
+			if math.Abs(secs[idx].LastPrice-price) > priceTolerance {
+				violations = append(violations, &pb.InvariantViolation{
+					Invariant:  pb.Invariant_LAST_PRICE,
+					MarketId:   id,
+					SecurityId: secs[idx].Id,
+					Expected:   strconv.FormatFloat(price, 'f', -1, 64),
+					Actual:     strconv.FormatFloat(secs[idx].LastPrice, 'f', -1, 64),
+				})
+			}
+		}
+	}
+
+	for _, username := range usernames {
+		// What the user was granted, less what their orders cost, plus what
+		// they were paid out or refunded.
+		account := userAccount(username)
+		var expected int64
+		for _, e := range s.ledger {
+			switch e.Kind {
+			case pb.LedgerEntryKind_GRANT, pb.LedgerEntryKind_PAYOUT, pb.LedgerEntryKind_REFUND:
+				if e.ToAccount == account {
+					expected += e.AmountMicros
+				}
+			}
+		}
+		for _, o := range s.orders {
+			if o.Username == username {
+				expected -= o.CostMicros
+			}
+		}
+		if tokens := s.users[username].tokens; expected != tokens {
+			violations = append(violations, &pb.InvariantViolation{
+				Invariant: pb.Invariant_TOKEN_BALANCE,
+				Username:  username,
+				Expected:  strconv.FormatInt(expected, 10),
+				Actual:    strconv.FormatInt(tokens, 10),
+			})
+		}
+	}
+
+	negative := func(marketID, securityID, username string, amount int64) {
+		violations = append(violations, &pb.InvariantViolation{
+			Invariant:  pb.Invariant_NO_NEGATIVE_HOLDINGS,
+			MarketId:   marketID,
+			SecurityId: securityID,
+			Username:   username,
+			Expected:   ">= 0",
+			Actual:     strconv.FormatInt(amount, 10),
+		})
+	}
+	for _, username := range usernames {
+		if tokens := s.users[username].tokens; tokens < 0 {
+			negative("", "", username, tokens)
+		}
+	}
+	for _, username := range usernames {
+		for _, id := range s.securityIDs {
+			if amount := s.users[username].holdings[id]; amount < 0 {
+				negative(s.securities[id].MarketId, id, username, amount)
+			}
+		}
+	}
+	return violations, nil
+}
+
+// audit is shared by SqliteStore and PostgresStore. Its violations are
+// grouped by invariant, in the order they're declared in.
+func audit(ctx context.Context, q querier) ([]*pb.InvariantViolation, error) {
+	violations := []*pb.InvariantViolation{}
+	for _, check := range []func(context.Context, querier) ([]*pb.InvariantViolation, error){
+		auditSharesOutstanding, auditLastPrices, auditTokenBalances, auditNegativeHoldings,
+	} {
+		found, err := check(ctx, q)
+		if err != nil {
+			return nil, err
+		}
+		violations = append(violations, found...)
+	}
+	return violations, nil
+}
+
+func auditSharesOutstanding(ctx context.Context, q querier) ([]*pb.InvariantViolation, error) {
+	rows, err := q.QueryContext(ctx, `
+		SELECT markets.uuid, securities.uuid, securities.seed_shares
+			+ COALESCE((SELECT SUM(amount) FROM portfolio_securities
+				WHERE security_id = securities.id), 0),
+			securities.shares_outstanding
+		FROM securities
+		JOIN markets ON securities.market_id = markets.id
+		ORDER BY securities.id`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	violations := []*pb.InvariantViolation{}
+	for rows.Next() {
+		var marketID, securityID string
+		var held, outstanding int64
+		if err := rows.Scan(&marketID, &securityID, &held, &outstanding); err != nil {
+			return nil, err
+		}
+		if held != outstanding {
+			violations = append(violations, &pb.InvariantViolation{
+				Invariant:  pb.Invariant_SHARES_OUTSTANDING,
+				MarketId:   marketID,
+				SecurityId: securityID,
+				Expected:   strconv.FormatInt(held, 10),
+				Actual:     strconv.FormatInt(outstanding, 10),
+			})
+		}
+	}
+	return violations, rows.Err()
+}
+
+func auditLastPrices(ctx context.Context, q querier) ([]*pb.InvariantViolation, error) {
+	rows, err := q.QueryContext(ctx, `
+		SELECT id, uuid FROM markets WHERE date_resolved IS NULL ORDER BY id`)
+	if err != nil {
+		return nil, err
+	}
+	type market struct {
+		id   int64
+		uuid string
+	}
+	markets := []market{}
+	for rows.Next() {
+		var m market
+		if err := rows.Scan(&m.id, &m.uuid); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		markets = append(markets, m)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	violations := []*pb.InvariantViolation{}
+	for _, m := range markets {
+		ms, err := loadMarketShares(ctx, q, m.id)
+		if err != nil {
+			return nil, err
+		}
+		if len(ms.ids) == 0 {
+			continue
+		}
+		for idx, price := range ms.pricer.Prices(ms.allShares) {
+			var last float64
+			err := q.QueryRowContext(ctx, `
+				SELECT last_price FROM securities WHERE id = $1`, ms.ids[idx]).Scan(&last)
+			if err != nil {
+				return nil, err
+			}
+			if math.Abs(last-price) > priceTolerance {
+				violations = append(violations, &pb.InvariantViolation{
+					Invariant:  pb.Invariant_LAST_PRICE,
+					MarketId:   m.uuid,
+					SecurityId: ms.uuids[idx],
+					Expected:   strconv.FormatFloat(price, 'f', -1, 64),
+					Actual:     strconv.FormatFloat(last, 'f', -1, 64),
+				})
+			}
+		}
+	}
+	return violations, nil
+}
+
+func auditTokenBalances(ctx context.Context, q querier) ([]*pb.InvariantViolation, error) {
+	rows, err := q.QueryContext(ctx, `
+		SELECT users.username,
+			COALESCE((SELECT SUM(amount) FROM ledger
+				WHERE kind = 'grant' AND to_account = 'user:' || users.username), 0)
+			- COALESCE((SELECT SUM(cost) FROM orders WHERE user_id = users.id), 0)
+			+ COALESCE((SELECT SUM(payout) FROM payouts WHERE user_id = users.id), 0),
+			portfolios.tokens
+		FROM users
+		JOIN portfolios ON portfolios.user_id = users.id
+		ORDER BY users.id`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	violations := []*pb.InvariantViolation{}
+	for rows.Next() {
+		var username string
+		var expected, tokens int64
+		if err := rows.Scan(&username, &expected, &tokens); err != nil {
+			return nil, err
+		}
+		if expected != tokens {
+			violations = append(violations, &pb.InvariantViolation{
+				Invariant: pb.Invariant_TOKEN_BALANCE,
+				Username:  username,
+				Expected:  strconv.FormatInt(expected, 10),
+				Actual:    strconv.FormatInt(tokens, 10),
+			})
+		}
+	}
+	return violations, rows.Err()
+}
+
+func auditNegativeHoldings(ctx context.Context, q querier) ([]*pb.InvariantViolation, error) {
+	rows, err := q.QueryContext(ctx, `
+		SELECT '', '', users.username, portfolios.tokens
+		FROM portfolios
+		JOIN users ON portfolios.user_id = users.id
+		WHERE portfolios.tokens < 0
+		UNION ALL
+		SELECT markets.uuid, securities.uuid, users.username, portfolio_securities.amount
+		FROM portfolio_securities
+		JOIN users ON portfolio_securities.user_id = users.id
+		JOIN securities ON portfolio_securities.security_id = securities.id
+		JOIN markets ON securities.market_id = markets.id
+		WHERE portfolio_securities.amount < 0`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	violations := []*pb.InvariantViolation{}
+	for rows.Next() {
+		v := &pb.InvariantViolation{Invariant: pb.Invariant_NO_NEGATIVE_HOLDINGS, Expected: ">= 0"}
+		var amount int64
+		if err := rows.Scan(&v.MarketId, &v.SecurityId, &v.Username, &amount); err != nil {
+			return nil, err
+		}
+		v.Actual = strconv.FormatInt(amount, 10)
+		violations = append(violations, v)
+	}
+	return violations, rows.Err()
+}
@@ -0,0 +1,54 @@
+package marketapi
+
+import (
+	"context"
+	"crypto/subtle"
+	"net/http"
+
+	"github.com/twitchtv/twirp"
+)
+
+// UsernameHeader is the HTTP header that identifies the user making a
+// MarketService request. There are no passwords yet; whoever serves the API
+// is expected to put it behind something that sets this header for real.
+const UsernameHeader = "X-Username"
+
+// SecretHeader is the HTTP header that carries the secret shared with
+// whatever sets UsernameHeader, such as the web frontend or a bot. It is how
+// the API knows the username came from someone it trusts.
+const SecretHeader = "X-Api-Secret"
+
+type ctxKey string
+
+const usernameKey ctxKey = "username"
+
+// WithUsername returns a context for requests made by the given user.
+func WithUsername(ctx context.Context, username string) context.Context {
+	return context.WithValue(ctx, usernameKey, username)
+}
+
+// Username returns the user making the request, or "" if there isn't one.
+func Username(ctx context.Context) string {
+	username, _ := ctx.Value(usernameKey).(string)
+	return username
+}
+
+// UsernameMiddleware puts the user named by UsernameHeader into the request
+// context. Requests that don't send secret in SecretHeader are rejected,
+// since anyone could claim to be anyone otherwise.
+//
+// An empty secret trusts every caller's UsernameHeader. Only serve the API
+// that way where nobody but you and your bots can reach it.
+func UsernameMiddleware(secret string, h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got := r.Header.Get(SecretHeader)
+		if secret != "" && subtle.ConstantTimeCompare([]byte(got), []byte(secret)) != 1 {
+			twirp.WriteError(w, twirp.NewError(twirp.Unauthenticated, "missing or wrong "+SecretHeader))
+			return
+		}
+		if username := r.Header.Get(UsernameHeader); username != "" {
+			r = r.WithContext(WithUsername(r.Context(), username))
+		}
+		h.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,48 @@
+package marketapi
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// The kinds of market event in the log. Markets that are voided because a
+// market they're conditional on resolved are not logged separately; the
+// resolution voids them again when it is replayed.
+const (
+	eventCreateMarket   = "create_market"
+	eventAddSecurities  = "add_securities"
+	eventDeleteSecurity = "delete_security"
+	eventOpenMarket     = "open_market"
+	eventCloseMarket    = "close_market"
+	eventHaltMarket     = "halt_market"
+	eventVoidMarket     = "void_market"
+	eventScheduleMarket = "schedule_market"
+	eventDeleteMarket   = "delete_market"
+	eventResolveMarket  = "resolve_market"
+)
+
+// logMarketEvent appends an event to the market event log. payload is the
+// request that caused it, if there was one, and ids are the uuids it created,
+// in the order they were created. The event is placed after the last order
+// made so far. Like recordEntry, it is shared by SqliteStore and
+// PostgresStore.
+func logMarketEvent(ctx context.Context, q execer, kind, marketUUID string,
+	payload proto.Message, ids []string, date string) error {
+
+	var body []byte
+	if payload != nil {
+		var err error
+		body, err = protojson.Marshal(payload)
+		if err != nil {
+			return err
+		}
+	}
+	_, err := q.ExecContext(ctx, `
+		INSERT INTO market_events(kind, market_id, payload, ids, last_order_id, date)
+		VALUES ($1, $2, $3, $4, (SELECT COALESCE(MAX(id), 0) FROM orders), $5)`,
+		kind, marketUUID, string(body), strings.Join(ids, ","), date)
+	return err
+}
@@ -0,0 +1,116 @@
+package marketapi
+
+import (
+	"context"
+	"strings"
+
+	"github.com/lithammer/shortuuid"
+
+	pb "github.com/domino14/scrabfutures/rpc/proto"
+)
+
+// houseAccount is where granted tokens come from, and where markets settle
+// whatever is left in escrow once they're done.
+const houseAccount = "house"
+
+func userAccount(username string) string {
+	return "user:" + username
+}
+
+// escrowAccount holds the tokens that traders have paid into a market until
+// it pays them back out.
+func escrowAccount(marketUUID string) string {
+	return "market:" + marketUUID
+}
+
+// recordEntry writes a ledger entry that moves amount micro-tokens from one
+// account to another. A negative amount moves them the other way, and
+// nothing is written for zero. marketID and orderID are database ids, or nil.
+// Like loadMarketShares, it is shared by SqliteStore and PostgresStore.
+func recordEntry(ctx context.Context, q execer, kind pb.LedgerEntryKind,
+	from, to string, amount int64, marketID, orderID any, date string) error {
+
+	if amount == 0 {
+		return nil
+	}
+	if amount < 0 {
+		from, to, amount = to, from, -amount
+	}
+	_, err := q.ExecContext(ctx, `
+		INSERT INTO ledger(uuid, kind, from_account, to_account, amount,
+			market_id, order_id, date)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+		shortuuid.New(), strings.ToLower(kind.String()), from, to, amount,
+		marketID, orderID, date)
+	return err
+}
+
+// settleEscrow transfers whatever is left in a market's escrow to the house,
+// or makes up the shortfall from it. The market maker's losses are the
+// house's.
+func settleEscrow(ctx context.Context, q execer, marketDBID int64, marketUUID, date string) error {
+	account := escrowAccount(marketUUID)
+	var escrow int64
+	err := q.QueryRowContext(ctx, `
+		SELECT COALESCE(SUM(CASE WHEN to_account = $1 THEN amount ELSE -amount END), 0)
+		FROM ledger
+		WHERE to_account = $1 OR from_account = $1`, account).Scan(&escrow)
+	if err != nil {
+		return err
+	}
+	return recordEntry(ctx, q, pb.LedgerEntryKind_TRANSFER, account, houseAccount,
+		escrow, marketDBID, nil, date)
+}
+
+// loadLedger returns every ledger entry for an account, oldest first.
+func loadLedger(ctx context.Context, q querier, account string) ([]*pb.LedgerEntry, error) {
+	rows, err := q.QueryContext(ctx, `
+		SELECT ledger.uuid, ledger.kind, ledger.from_account, ledger.to_account,
+			ledger.amount, COALESCE(markets.uuid, ''), COALESCE(orders.uuid, ''),
+			ledger.date
+		FROM ledger
+		LEFT JOIN markets ON ledger.market_id = markets.id
+		LEFT JOIN orders ON ledger.order_id = orders.id
+		WHERE ledger.from_account = $1 OR ledger.to_account = $1
+		ORDER BY ledger.id`, account)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	entries := []*pb.LedgerEntry{}
+	for rows.Next() {
+		e := &pb.LedgerEntry{}
+		var kind string
+		err := rows.Scan(&e.Id, &kind, &e.FromAccount, &e.ToAccount, &e.AmountMicros,
+			&e.MarketId, &e.OrderId, &e.Date)
+		if err != nil {
+			return nil, err
+		}
+		e.Kind = pb.LedgerEntryKind(pb.LedgerEntryKind_value[strings.ToUpper(kind)])
+		entries = append(entries, e)
+	}
+	return entries, rows.Err()
+}
+
+// statement fills in account's balance after each of its entries, which must
+// be oldest first, and returns the ones made between beginDate and endDate
+// along with the balance after all of them.
+func statement(entries []*pb.LedgerEntry, account string,
+	beginDate, endDate string) ([]*pb.LedgerEntry, int64) {
+
+	var balance int64
+	filtered := []*pb.LedgerEntry{}
+	for _, e := range entries {
+		if e.ToAccount == account {
+			balance += e.AmountMicros
+		}
+		if e.FromAccount == account {
+			balance -= e.AmountMicros
+		}
+		e.BalanceMicros = balance
+		if inDateRange(e.Date, beginDate, endDate) {
+			filtered = append(filtered, e)
+		}
+	}
+	return filtered, balance
+}
@@ -0,0 +1,110 @@
+package marketapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "github.com/domino14/scrabfutures/rpc/proto"
+)
+
+// The helpers in this file move markets through their statuses. Like
+// recordEntry, they are shared by SqliteStore and PostgresStore.
+
+// statusFilter returns the WHERE clause that picks out markets with any of
+// the statuses, and its arguments. No statuses means any status.
+func statusFilter(statuses []pb.Market_Status) (string, []any) {
+	if len(statuses) == 0 {
+		return "", nil
+	}
+	placeholders := make([]string, len(statuses))
+	args := make([]any, len(statuses))
+	for idx, st := range statuses {
+		placeholders[idx] = "$" + strconv.Itoa(idx+1)
+		args[idx] = st
+	}
+	return "WHERE markets.status IN (" + strings.Join(placeholders, ", ") + ")", args
+}
+
+func loadMarket(ctx context.Context, q querier, uuid string) (*pb.Market, error) {
+	return scanMarket(q.QueryRowContext(ctx, `
+		SELECT `+marketColumns+`
+		FROM `+marketTables+`
+		WHERE markets.uuid = $1`, uuid))
+}
+
+// setMarketStatus moves a market to a new status, if it's allowed to, and
+// returns the market as it was before.
+func setMarketStatus(ctx context.Context, q execer, uuid string, to pb.Market_Status) (*pb.Market, error) {
+	m, err := loadMarket(ctx, q, uuid)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkTransition(m.Status, to); err != nil {
+		return nil, err
+	}
+	res, err := q.ExecContext(ctx, `
+		UPDATE markets SET status = $1 WHERE uuid = $2 AND status = $3`, to, uuid, m.Status)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkStatusUnchanged(res); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// openMarket opens a market. Reopening a closed market drops a close_at that
+// has already passed, so that the scheduler doesn't close it again straight
+// away.
+func openMarket(ctx context.Context, q execer, uuid string) error {
+	m, err := setMarketStatus(ctx, q, uuid, pb.Market_OPEN)
+	if err != nil {
+		return err
+	}
+	if m.Status == pb.Market_CLOSED && due(m.CloseAt, time.Now()) {
+		_, err = q.ExecContext(ctx, `UPDATE markets SET close_at = NULL WHERE uuid = $1`, uuid)
+	}
+	return err
+}
+
+// scheduleMarket sets a market's schedule, and the status that goes with it.
+func scheduleMarket(ctx context.Context, q execer, req *pb.ScheduleMarketRequest) error {
+	m, err := loadMarket(ctx, q, req.Id)
+	if err != nil {
+		return err
+	}
+	status, err := checkSchedule(m, req)
+	if err != nil {
+		return err
+	}
+	if status != m.Status {
+		if err := checkTransition(m.Status, status); err != nil {
+			return err
+		}
+	}
+	res, err := q.ExecContext(ctx, `
+		UPDATE markets SET status = $1, open_at = $2, close_at = $3
+		WHERE uuid = $4 AND status = $5`, status,
+		sql.NullString{String: req.OpenAt, Valid: req.OpenAt != ""},
+		sql.NullString{String: req.CloseAt, Valid: req.CloseAt != ""},
+		req.Id, m.Status)
+	if err != nil {
+		return err
+	}
+	return checkStatusUnchanged(res)
+}
+
+// checkStatusUnchanged checks that an update conditional on a market's
+// status found the market in it.
+func checkStatusUnchanged(res sql.Result) error {
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n != 1 {
+		return errors.New("this market changed status while it was being updated")
+	}
+	return nil
+}
@@ -0,0 +1,981 @@
+package marketapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/lithammer/shortuuid"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/domino14/scrabfutures/pkg/events"
+	"github.com/domino14/scrabfutures/pkg/lmsr"
+	"github.com/domino14/scrabfutures/pkg/scoring"
+	pb "github.com/domino14/scrabfutures/rpc/proto"
+)
+
+// MemoryStore is a Store that keeps everything in memory, for testing
+// handlers and bots without a database. It follows the same rules as
+// SqliteStore, keeps game results, scores markets, ranks traders and audits
+// its books like it, and is safe for concurrent use.
+type MemoryStore struct {
+	mu     sync.Mutex
+	events *events.Hub
+
+	markets map[string]*pb.Market
+	// marketIDs and securityIDs are in the order they were created in.
+	marketIDs   []string
+	securities  map[string]*pb.Security
+	securityIDs []string
+	users       map[string]*memUser
+	orders      []*pb.Order
+	costs       []memCost
+	ledger      []*pb.LedgerEntry
+	// seeds are the micro-shares each security started off with, keyed by
+	// security UUID.
+	seeds map[string]int64
+	// results and scores are keyed by market UUID.
+	results map[string][]*pb.GameResult
+	scores  map[string][]*pb.MarketScore
+}
+
+// memUser counts micro-tokens and micro-shares, like the other stores.
+type memUser struct {
+	tokens   int64
+	holdings map[string]int64     // keyed by security UUID
+	keyed    map[string]*pb.Order // keyed by idempotency key
+}
+
+type memCost struct {
+	securityID string
+	cost       float64
+	date       string
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		events:     events.NewHub(),
+		markets:    map[string]*pb.Market{},
+		securities: map[string]*pb.Security{},
+		users:      map[string]*memUser{},
+		seeds:      map[string]int64{},
+		results:    map[string][]*pb.GameResult{},
+		scores:     map[string][]*pb.MarketScore{},
+	}
+}
+
+func (s *MemoryStore) Events() *events.Hub {
+	return s.events
+}
+
+// AddUser adds a user with the given micro-tokens. Users are created outside
+// of the Store, so this stands in for however they got there.
+func (s *MemoryStore) AddUser(username string, tokens int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.users[username] = &memUser{tokens: tokens, holdings: map[string]int64{}, keyed: map[string]*pb.Order{}}
+	s.record(pb.LedgerEntryKind_GRANT, houseAccount, userAccount(username), tokens, "", "", now())
+}
+
+// record adds a ledger entry, following the same rules as recordEntry.
+func (s *MemoryStore) record(kind pb.LedgerEntryKind, from, to string, amount int64,
+	marketID, orderID, date string) {
+
+	if amount == 0 {
+		return
+	}
+	if amount < 0 {
+		from, to, amount = to, from, -amount
+	}
+	s.ledger = append(s.ledger, &pb.LedgerEntry{
+		Id:           shortuuid.New(),
+		Kind:         kind,
+		FromAccount:  from,
+		ToAccount:    to,
+		AmountMicros: amount,
+		MarketId:     marketID,
+		OrderId:      orderID,
+		Date:         date,
+	})
+}
+
+// settleEscrow follows the same rules as the settleEscrow shared by the
+// other stores.
+func (s *MemoryStore) settleEscrow(marketID, date string) {
+	account := escrowAccount(marketID)
+	var escrow int64
+	for _, e := range s.ledger {
+		if e.ToAccount == account {
+			escrow += e.AmountMicros
+		}
+		if e.FromAccount == account {
+			escrow -= e.AmountMicros
+		}
+	}
+	s.record(pb.LedgerEntryKind_TRANSFER, account, houseAccount, escrow, marketID, "", date)
+}
+
+func (s *MemoryStore) market(id string) (*pb.Market, error) {
+	m, ok := s.markets[id]
+	if !ok {
+		return nil, fmt.Errorf("market %s not found", id)
+	}
+	return m, nil
+}
+
+func (s *MemoryStore) security(id string) (*pb.Security, error) {
+	sec, ok := s.securities[id]
+	if !ok {
+		return nil, fmt.Errorf("security %s not found", id)
+	}
+	return sec, nil
+}
+
+func (s *MemoryStore) user(username string) (*memUser, error) {
+	u, ok := s.users[username]
+	if !ok {
+		return nil, fmt.Errorf("user %s not found", username)
+	}
+	return u, nil
+}
+
+// marketSecurities returns a market's securities in the order they were
+// added.
+func (s *MemoryStore) marketSecurities(marketID string) []*pb.Security {
+	secs := []*pb.Security{}
+	for _, id := range s.securityIDs {
+		if sec := s.securities[id]; sec.MarketId == marketID {
+			secs = append(secs, sec)
+		}
+	}
+	return secs
+}
+
+// marketShares returns the shares outstanding for each of a market's
+// securities, and the pricer for them.
+func (s *MemoryStore) marketShares(m *pb.Market, secs []*pb.Security) (lmsr.Pricer, []float64) {
+	shares := make([]float64, len(secs))
+	predicates := make([]lmsr.Predicate, len(secs))
+	for idx, sec := range secs {
+		shares[idx] = lmsr.FromMicros(sec.SharesOutstandingMicros)
+		for pidx, p := range m.Players {
+			if p == sec.Player {
+				predicates[idx].Player = pidx
+			}
+		}
+		for _, pos := range sec.Positions {
+			predicates[idx].Positions = append(predicates[idx].Positions, int(pos)-1)
+		}
+	}
+	return newPricer(m.MarketType, len(m.Players), predicates), shares
+}
+
+func (s *MemoryStore) repriceMarket(m *pb.Market) {
+	secs := s.marketSecurities(m.Id)
+	pricer, shares := s.marketShares(m, secs)
+	for idx, np := range pricer.Prices(shares) {
+		secs[idx].LastPrice = np
+	}
+}
+
+func (s *MemoryStore) GetMarket(ctx context.Context, id string) (*pb.Market, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	m, err := s.market(id)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Clone(m).(*pb.Market), nil
+}
+
+func (s *MemoryStore) GetMarkets(ctx context.Context, statuses ...pb.Market_Status) ([]*pb.Market, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	markets := []*pb.Market{}
+	for _, id := range s.marketIDs {
+		if m := s.markets[id]; hasStatus(m, statuses) {
+			markets = append(markets, proto.Clone(m).(*pb.Market))
+		}
+	}
+	return markets, nil
+}
+
+func (s *MemoryStore) CreateMarket(ctx context.Context, req *pb.CreateMarketRequest) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	securities, err := newMarketSecurities(req)
+	if err != nil {
+		return "", err
+	}
+	if req.ConditionSecurityId != "" {
+		cond, err := s.security(req.ConditionSecurityId)
+		if err != nil {
+			return "", err
+		}
+		if err := validateCondition(s.markets[cond.MarketId]); err != nil {
+			return "", err
+		}
+	}
+
+	m := &pb.Market{
+		Id:                  shortuuid.New(),
+		Description:         req.Description,
+		DateCreated:         now(),
+		MarketType:          req.MarketType,
+		ConditionSecurityId: req.ConditionSecurityId,
+		Players:             append([]string(nil), req.Players...),
+	}
+	if req.MarketType == pb.MarketType_SCALAR {
+		m.LowerBound = req.LowerBound
+		m.UpperBound = req.UpperBound
+	}
+	s.markets[m.Id] = m
+	s.marketIDs = append(s.marketIDs, m.Id)
+	if len(securities) > 0 {
+		s.insertSecurities(m, securities, nil)
+	}
+	return m.Id, nil
+}
+
+func (s *MemoryStore) OpenMarket(ctx context.Context, uuid string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	m, err := s.market(uuid)
+	if err != nil {
+		return err
+	}
+	wasClosed := m.Status == pb.Market_CLOSED
+	if err := setStatus(m, pb.Market_OPEN); err != nil {
+		return err
+	}
+	// Like the other stores, don't let the scheduler close a reopened market
+	// straight away.
+	if wasClosed && due(m.CloseAt, time.Now()) {
+		m.CloseAt = ""
+	}
+	openTime := now()
+	for _, sec := range s.marketSecurities(uuid) {
+		s.costs = append(s.costs, memCost{securityID: sec.Id, cost: sec.LastPrice, date: openTime})
+	}
+	s.events.Publish(events.Event{Type: events.MarketOpened, MarketID: uuid, Date: openTime})
+	return nil
+}
+
+func (s *MemoryStore) CloseMarket(ctx context.Context, uuid string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	m, err := s.market(uuid)
+	if err != nil {
+		return err
+	}
+	if err := setStatus(m, pb.Market_CLOSED); err != nil {
+		return err
+	}
+	closeTime := now()
+	m.DateClosed = closeTime
+	s.events.Publish(events.Event{Type: events.MarketClosed, MarketID: uuid, Date: closeTime})
+	return nil
+}
+
+func (s *MemoryStore) HaltMarket(ctx context.Context, uuid string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	m, err := s.market(uuid)
+	if err != nil {
+		return err
+	}
+	if err := setStatus(m, pb.Market_HALTED); err != nil {
+		return err
+	}
+	s.events.Publish(events.Event{Type: events.MarketHalted, MarketID: uuid, Date: now()})
+	return nil
+}
+
+func (s *MemoryStore) VoidMarket(ctx context.Context, uuid string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	m, err := s.market(uuid)
+	if err != nil {
+		return err
+	}
+	if err := checkTransition(m.Status, pb.Market_VOIDED); err != nil {
+		return err
+	}
+	voidTime := now()
+	for _, id := range s.voidMarket(m, voidTime) {
+		s.events.Publish(events.Event{Type: events.MarketVoided, MarketID: id, Date: voidTime})
+	}
+	return nil
+}
+
+func (s *MemoryStore) ScheduleMarket(ctx context.Context, req *pb.ScheduleMarketRequest) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	m, err := s.market(req.Id)
+	if err != nil {
+		return err
+	}
+	status, err := checkSchedule(m, req)
+	if err != nil {
+		return err
+	}
+	if status != m.Status {
+		if err := setStatus(m, status); err != nil {
+			return err
+		}
+	}
+	m.OpenAt = req.OpenAt
+	m.CloseAt = req.CloseAt
+	return nil
+}
+
+// setStatus moves a market to a new status, if it's allowed to.
+func setStatus(m *pb.Market, to pb.Market_Status) error {
+	if err := checkTransition(m.Status, to); err != nil {
+		return err
+	}
+	m.Status = to
+	m.IsOpen = to == pb.Market_OPEN
+	return nil
+}
+
+func (s *MemoryStore) DeleteMarket(ctx context.Context, uuid string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	m, err := s.market(uuid)
+	if err != nil {
+		return err
+	}
+	if err := checkDeleteMarket(m); err != nil {
+		return err
+	}
+	if len(s.conditionalMarkets(uuid, true)) > 0 {
+		return errors.New("disallowed deletion of market that other markets are conditional on")
+	}
+	for _, sec := range s.marketSecurities(uuid) {
+		s.removeSecurity(sec.Id)
+	}
+	delete(s.markets, uuid)
+	for idx, id := range s.marketIDs {
+		if id == uuid {
+			s.marketIDs = append(s.marketIDs[:idx], s.marketIDs[idx+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+func (s *MemoryStore) AddSecurities(ctx context.Context, marketID string,
+	securities []*pb.AddSecuritiesRequest_Security) error {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	m, err := s.market(marketID)
+	if err != nil {
+		return err
+	}
+	seeds, err := validateNewSecurities(m, len(s.marketSecurities(marketID)), securities)
+	if err != nil {
+		return err
+	}
+	s.insertSecurities(m, securities, seeds)
+	e := events.Event{Type: events.SecuritiesAdded, MarketID: marketID, Date: now()}
+	for _, sec := range s.marketSecurities(marketID) {
+		e.Prices = append(e.Prices, events.SecurityPrice{SecurityID: sec.Id, Price: sec.LastPrice})
+	}
+	s.events.Publish(e)
+	return nil
+}
+
+// insertSecurities adds securities to a market and reprices all of its
+// securities. If seeds is not nil, each security starts off with that many
+// micro-shares outstanding.
+func (s *MemoryStore) insertSecurities(m *pb.Market,
+	securities []*pb.AddSecuritiesRequest_Security, seeds []int64) {
+
+	addDate := now()
+	for idx, sec := range securities {
+		added := &pb.Security{
+			Id:          shortuuid.New(),
+			Description: sec.Description,
+			Shortname:   sec.Shortname,
+			DateCreated: addDate,
+			MarketId:    m.Id,
+			Player:      sec.Player,
+			Positions:   append([]int32(nil), sec.Positions...),
+			Rating:      sec.Rating,
+		}
+		if seeds != nil {
+			added.SharesOutstandingMicros = seeds[idx]
+			s.seeds[added.Id] = seeds[idx]
+		}
+		s.securities[added.Id] = added
+		s.securityIDs = append(s.securityIDs, added.Id)
+	}
+	s.repriceMarket(m)
+}
+
+func (s *MemoryStore) removeSecurity(uuid string) {
+	delete(s.securities, uuid)
+	delete(s.seeds, uuid)
+	for idx, id := range s.securityIDs {
+		if id == uuid {
+			s.securityIDs = append(s.securityIDs[:idx], s.securityIDs[idx+1:]...)
+			return
+		}
+	}
+}
+
+func (s *MemoryStore) DeleteSecurity(ctx context.Context, marketID string,
+	securityID string) error {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	m, err := s.market(marketID)
+	if err != nil {
+		return err
+	}
+	if err := checkDeleteSecurity(m); err != nil {
+		return err
+	}
+	for _, other := range s.markets {
+		if other.ConditionSecurityId == securityID {
+			return errors.New("disallowed deletion of security that other markets are conditional on")
+		}
+	}
+	if sec, ok := s.securities[securityID]; !ok || sec.MarketId != marketID {
+		return errors.New("security not found in this market")
+	}
+	s.removeSecurity(securityID)
+	s.repriceMarket(m)
+	return nil
+}
+
+func (s *MemoryStore) GetSecurity(ctx context.Context, uuid string) (*pb.Security, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sec, err := s.security(uuid)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Clone(sec).(*pb.Security), nil
+}
+
+func (s *MemoryStore) GetSecurities(ctx context.Context, marketID string) ([]*pb.Security, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	securities := []*pb.Security{}
+	for _, sec := range s.marketSecurities(marketID) {
+		securities = append(securities, proto.Clone(sec).(*pb.Security))
+	}
+	return securities, nil
+}
+
+func (s *MemoryStore) GetSecurityCosts(ctx context.Context, securityUUID string,
+	beginDate, endDate string) ([]*pb.GetSecurityCostsResponse_SecurityCost, error) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, err := s.security(securityUUID); err != nil {
+		return nil, err
+	}
+	costs := []*pb.GetSecurityCostsResponse_SecurityCost{}
+	for _, c := range s.costs {
+		if c.securityID == securityUUID && inDateRange(c.date, beginDate, endDate) {
+			costs = append(costs, &pb.GetSecurityCostsResponse_SecurityCost{Date: c.date, Cost: c.cost})
+		}
+	}
+	sort.SliceStable(costs, func(i, j int) bool { return costs[i].Date < costs[j].Date })
+	return costs, nil
+}
+
+func (s *MemoryStore) GetSecurityOrders(ctx context.Context, securityUUID string,
+	beginDate, endDate string) ([]*pb.Order, error) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, err := s.security(securityUUID); err != nil {
+		return nil, err
+	}
+	orders := []*pb.Order{}
+	for _, o := range s.orders {
+		if o.SecurityId == securityUUID && inDateRange(o.DateCreated, beginDate, endDate) {
+			orders = append(orders, proto.Clone(o).(*pb.Order))
+		}
+	}
+	return orders, nil
+}
+
+// GetOrderBook numbers orders by their place in s.orders, starting at 1, to
+// make page tokens like the other stores'.
+func (s *MemoryStore) GetOrderBook(ctx context.Context, req *pb.GetOrderBookRequest) (*pb.OrderBookResponse, error) {
+	limit, after, err := orderBookPage(req)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	oldestFirst := req.SortOrder == pb.GetOrderBookRequest_OLDEST_FIRST
+	resp := &pb.OrderBookResponse{Orders: []*pb.Order{}}
+	for i := range s.orders {
+		idx := len(s.orders) - 1 - i
+		if oldestFirst {
+			idx = i
+		}
+		o, id := s.orders[idx], int64(idx+1)
+		switch {
+		case after > 0 && oldestFirst && id <= after,
+			after > 0 && !oldestFirst && id >= after,
+			req.MarketId != "" && s.securities[o.SecurityId].MarketId != req.MarketId,
+			req.SecurityId != "" && o.SecurityId != req.SecurityId,
+			req.Username != "" && o.Username != req.Username,
+			!inDateRange(o.DateCreated, req.SinceDate, ""),
+			req.Side == pb.GetOrderBookRequest_BUYS && o.AmountMicros < 0,
+			req.Side == pb.GetOrderBookRequest_SELLS && o.AmountMicros > 0:
+			continue
+		}
+		if len(resp.Orders) == limit {
+			resp.NextPageToken = pageToken(after)
+			break
+		}
+		resp.Orders = append(resp.Orders, proto.Clone(o).(*pb.Order))
+		after = id
+	}
+	return resp, nil
+}
+
+func (s *MemoryStore) GetPortfolio(ctx context.Context, username string) (*pb.Portfolio, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	u, err := s.user(username)
+	if err != nil {
+		return nil, err
+	}
+	portfolio := &pb.Portfolio{Username: username, TokensMicros: u.tokens}
+	for _, id := range s.securityIDs {
+		amount := u.holdings[id]
+		sec := s.securities[id]
+		m := s.markets[sec.MarketId]
+		if amount == 0 || m.DateResolved != "" {
+			continue
+		}
+		orders := []*pb.Order{}
+		for _, o := range s.orders {
+			if o.Username == username && o.SecurityId == id {
+				orders = append(orders, o)
+			}
+		}
+		position := &pb.Position{Security: proto.Clone(sec).(*pb.Security), AmountMicros: amount}
+		secs := s.marketSecurities(m.Id)
+		pricer, shares := s.marketShares(m, secs)
+		for secIdx, other := range secs {
+			if other.Id == id {
+				valuePosition(position, costBasis(orders), pricer, shares, secIdx)
+			}
+		}
+		portfolio.Positions = append(portfolio.Positions, position)
+	}
+	return portfolio, nil
+}
+
+func (s *MemoryStore) FulfillOrder(ctx context.Context, username string,
+	securityUUID, marketUUID string, amount int64, buy bool, idempotencyKey string) (int64, error) {
+
+	if amount <= 0 {
+		return 0, errors.New("amount must be positive")
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	m, err := s.market(marketUUID)
+	if err != nil {
+		return 0, err
+	}
+	u, err := s.user(username)
+	if err != nil {
+		return 0, err
+	}
+	sec, err := s.security(securityUUID)
+	if err != nil {
+		return 0, err
+	}
+	if !buy {
+		amount *= -1
+	}
+	if earlier := u.keyed[idempotencyKey]; earlier != nil {
+		return earlier.CostMicros, checkRetry(earlier, securityUUID, amount)
+	}
+	if err := checkTrading(m.Status); err != nil {
+		return 0, err
+	}
+	secs := s.marketSecurities(marketUUID)
+	myIdx := -1
+	for idx, other := range secs {
+		if other.Id == securityUUID {
+			myIdx = idx
+		}
+	}
+	if myIdx == -1 {
+		return 0, errors.New("securityUUID not found")
+	}
+
+	pricer, shares := s.marketShares(m, secs)
+	cost := lmsr.TradeCostMicros(pricer, amount, shares, myIdx)
+	if err := checkTrade(cost, amount, u.tokens, u.holdings[securityUUID]); err != nil {
+		return 0, err
+	}
+
+	orderTime := now()
+	u.tokens -= cost
+	u.holdings[securityUUID] += amount
+	orderID := shortuuid.New()
+	s.record(pb.LedgerEntryKind_TRADE, userAccount(username), escrowAccount(marketUUID),
+		cost, marketUUID, orderID, orderTime)
+	o := &pb.Order{
+		Id:                orderID,
+		Username:          username,
+		SecurityId:        securityUUID,
+		SecurityShortname: sec.Shortname,
+		AmountMicros:      amount,
+		CostMicros:        cost,
+		DateCreated:       orderTime,
+	}
+	s.orders = append(s.orders, o)
+	if idempotencyKey != "" {
+		u.keyed[idempotencyKey] = o
+	}
+	e := events.Event{Type: events.Prices, MarketID: marketUUID, Date: orderTime}
+	for idx, np := range pricer.Prices(shares) {
+		e.Prices = append(e.Prices, events.SecurityPrice{SecurityID: secs[idx].Id, Price: np})
+		s.costs = append(s.costs, memCost{securityID: secs[idx].Id, cost: np, date: orderTime})
+		secs[idx].LastPrice = np
+	}
+	sec.SharesOutstandingMicros += amount
+	s.events.Publish(e)
+	return cost, nil
+}
+
+func (s *MemoryStore) ResolveMarket(ctx context.Context, req *pb.ResolveMarketRequest) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	m, err := s.market(req.MarketId)
+	if err != nil {
+		return err
+	}
+	var parent *pb.Market
+	if m.ConditionSecurityId != "" {
+		cond, err := s.security(m.ConditionSecurityId)
+		if err != nil {
+			return err
+		}
+		parent = s.markets[cond.MarketId]
+	}
+	if err := checkResolve(m, parent); err != nil {
+		return err
+	}
+	secs := s.marketSecurities(req.MarketId)
+	payouts, err := securityPayouts(m, secs, req)
+	if err != nil {
+		return err
+	}
+
+	resolveTime := now()
+	m.Status = pb.Market_RESOLVED
+	m.IsOpen = false
+	if m.DateClosed == "" {
+		m.DateClosed = resolveTime
+	}
+	m.DateResolved = resolveTime
+	for _, sec := range secs {
+		sec.LastPrice = payouts[sec.Id]
+	}
+	for _, username := range s.usernames() {
+		u := s.users[username]
+		for _, sec := range secs {
+			if amount := u.holdings[sec.Id]; amount > 0 {
+				paid := lmsr.PayoutMicros(amount, payouts[sec.Id])
+				u.tokens += paid
+				s.record(pb.LedgerEntryKind_PAYOUT, escrowAccount(m.Id), userAccount(username),
+					paid, m.Id, "", resolveTime)
+			}
+		}
+	}
+	s.settleEscrow(m.Id, resolveTime)
+
+	// Void any markets whose condition did not come true. The others keep
+	// trading until they are resolved themselves.
+	voided := []string{}
+	for _, d := range s.conditionalMarkets(req.MarketId, false) {
+		if payouts[d.ConditionSecurityId] > 0 {
+			continue
+		}
+		voided = append(voided, s.voidMarket(d, resolveTime)...)
+	}
+
+	s.events.Publish(events.Event{Type: events.MarketResolved, MarketID: req.MarketId, Date: resolveTime})
+	for _, uuid := range voided {
+		s.events.Publish(events.Event{Type: events.MarketVoided, MarketID: uuid, Date: resolveTime})
+	}
+	// Like the other stores, a market that can't be scored is still
+	// resolved.
+	if err := s.scoreMarket(m); err != nil {
+		log.Err(err).Str("marketID", req.MarketId).Msg("score-market")
+	}
+	return nil
+}
+
+// scoreMarket scores a resolved market's price history against what its
+// securities paid out, and keeps the scores, replacing any earlier ones.
+func (s *MemoryStore) scoreMarket(m *pb.Market) error {
+	secs := s.marketSecurities(m.Id)
+	forecasts := []scoring.Forecast{}
+	for _, sec := range secs {
+		f := scoring.Forecast{Outcome: sec.LastPrice / lmsr.MaxPayout}
+		for _, c := range s.costs {
+			if c.securityID != sec.Id {
+				continue
+			}
+			if err := addPrice(&f, c.cost, c.date); err != nil {
+				return err
+			}
+		}
+		if len(f.Prices) > 0 {
+			forecasts = append(forecasts, f)
+		}
+	}
+	scores, err := marketScores(m, forecasts, len(secs))
+	if err != nil {
+		return err
+	}
+	s.scores[m.Id] = scores
+	return nil
+}
+
+// conditionalMarkets returns the markets that are conditional on one of the
+// given market's securities, in the order they were created. Resolved ones
+// are left out unless withResolved is set.
+func (s *MemoryStore) conditionalMarkets(marketID string, withResolved bool) []*pb.Market {
+	markets := []*pb.Market{}
+	for _, id := range s.marketIDs {
+		m := s.markets[id]
+		if m.ConditionSecurityId == "" || (m.DateResolved != "" && !withResolved) {
+			continue
+		}
+		if cond, ok := s.securities[m.ConditionSecurityId]; ok && cond.MarketId == marketID {
+			markets = append(markets, m)
+		}
+	}
+	return markets
+}
+
+// voidMarket closes a market without resolving it, and refunds every trader
+// the net cost of their orders in it (see refundMicros). Markets that are
+// conditional on it are voided too. It returns the UUIDs of every market it
+// voided.
+func (s *MemoryStore) voidMarket(m *pb.Market, voidTime string) []string {
+	m.Status = pb.Market_VOIDED
+	m.IsOpen = false
+	if m.DateClosed == "" {
+		m.DateClosed = voidTime
+	}
+	m.DateResolved = voidTime
+	m.Voided = true
+	// Like the other stores, refund each user's net cost for each security
+	// in one go.
+	type holding struct{ username, securityID string }
+	refunds := map[holding]int64{}
+	order := []holding{}
+	for _, o := range s.orders {
+		if sec, ok := s.securities[o.SecurityId]; ok && sec.MarketId == m.Id {
+			h := holding{o.Username, o.SecurityId}
+			if _, ok := refunds[h]; !ok {
+				order = append(order, h)
+			}
+			refunds[h] += o.CostMicros
+		}
+	}
+	for _, h := range order {
+		refund := refundMicros(refunds[h])
+		s.users[h.username].tokens += refund
+		s.record(pb.LedgerEntryKind_REFUND, escrowAccount(m.Id), userAccount(h.username),
+			refund, m.Id, "", voidTime)
+	}
+	s.settleEscrow(m.Id, voidTime)
+	voided := []string{m.Id}
+	for _, d := range s.conditionalMarkets(m.Id, false) {
+		voided = append(voided, s.voidMarket(d, voidTime)...)
+	}
+	return voided
+}
+
+// usernames returns every user's name, in order.
+func (s *MemoryStore) usernames() []string {
+	names := make([]string, 0, len(s.users))
+	for name := range s.users {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (s *MemoryStore) GrantTokens(ctx context.Context, username string, amount int64) error {
+	if amount <= 0 {
+		return errors.New("amount must be positive")
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	u, err := s.user(username)
+	if err != nil {
+		return err
+	}
+	u.tokens += amount
+	s.record(pb.LedgerEntryKind_GRANT, houseAccount, userAccount(username), amount, "", "", now())
+	return nil
+}
+
+func (s *MemoryStore) GetLedger(ctx context.Context, username string,
+	beginDate, endDate string) ([]*pb.LedgerEntry, int64, error) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, err := s.user(username); err != nil {
+		return nil, 0, err
+	}
+	account := userAccount(username)
+	entries := []*pb.LedgerEntry{}
+	for _, e := range s.ledger {
+		if e.FromAccount == account || e.ToAccount == account {
+			entries = append(entries, proto.Clone(e).(*pb.LedgerEntry))
+		}
+	}
+	entries, balance := statement(entries, account, beginDate, endDate)
+	return entries, balance, nil
+}
+
+func (s *MemoryStore) SubmitGameResults(ctx context.Context, marketID string,
+	results []*pb.GameResult) (int, error) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, err := s.market(marketID); err != nil {
+		return 0, err
+	}
+	if err := checkGameResults(results); err != nil {
+		return 0, err
+	}
+	resultTime := now()
+	changed := 0
+	rounds := map[int32]bool{}
+	for _, r := range results {
+		if s.saveGameResult(marketID, normalizeResult(r)) {
+			changed++
+			rounds[r.Round] = true
+		}
+	}
+	if changed > 0 {
+		s.events.Publish(events.Event{Type: events.GameResults, MarketID: marketID,
+			Date: resultTime, Rounds: sortedRounds(rounds)})
+	}
+	return changed, nil
+}
+
+// saveGameResult stores a result, replacing any earlier result for the same
+// game, and reports whether that changed anything.
+func (s *MemoryStore) saveGameResult(marketID string, r *pb.GameResult) bool {
+	for idx, earlier := range s.results[marketID] {
+		if earlier.Round != r.Round || earlier.Player != r.Player || earlier.Opponent != r.Opponent {
+			continue
+		}
+		if earlier.PlayerScore == r.PlayerScore && earlier.OpponentScore == r.OpponentScore {
+			return false
+		}
+		s.results[marketID][idx] = proto.Clone(r).(*pb.GameResult)
+		return true
+	}
+	s.results[marketID] = append(s.results[marketID], proto.Clone(r).(*pb.GameResult))
+	return true
+}
+
+func (s *MemoryStore) GetGameResults(ctx context.Context, marketID string) ([]*pb.GameResult, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, err := s.market(marketID); err != nil {
+		return nil, err
+	}
+	results := []*pb.GameResult{}
+	for _, r := range s.results[marketID] {
+		results = append(results, proto.Clone(r).(*pb.GameResult))
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].Round != results[j].Round {
+			return results[i].Round < results[j].Round
+		}
+		return results[i].Player < results[j].Player
+	})
+	return results, nil
+}
+
+func (s *MemoryStore) GetMarketScores(ctx context.Context, marketID string) ([]*pb.MarketScore, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	scores := []*pb.MarketScore{}
+	for _, id := range s.marketIDs {
+		if marketID != "" && id != marketID {
+			continue
+		}
+		for _, sc := range s.scores[id] {
+			scores = append(scores, proto.Clone(sc).(*pb.MarketScore))
+		}
+	}
+	return scores, nil
+}
+
+// GetLeaderboard follows the same rules as the leaderboard shared by the
+// other stores.
+func (s *MemoryStore) GetLeaderboard(ctx context.Context, req *pb.GetLeaderboardRequest) (*pb.GetLeaderboardResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	entries := map[string]*pb.LeaderboardEntry{}
+	for username, u := range s.users {
+		e := &pb.LeaderboardEntry{Username: username, PortfolioValue: lmsr.FromMicros(u.tokens)}
+		for id, amount := range u.holdings {
+			sec, ok := s.securities[id]
+			if ok && s.markets[sec.MarketId].DateResolved == "" {
+				e.PortfolioValue += float64(amount) * sec.LastPrice / lmsr.Micros
+			}
+		}
+		account := userAccount(username)
+		for _, le := range s.ledger {
+			if le.Kind == pb.LedgerEntryKind_GRANT && le.ToAccount == account {
+				e.StartingTokens = lmsr.FromMicros(le.AmountMicros)
+				break
+			}
+		}
+		entries[username] = e
+	}
+
+	traded := map[string]bool{}
+	for _, o := range s.orders {
+		sec := s.securities[o.SecurityId]
+		m := s.markets[sec.MarketId]
+		if (req.MarketId != "" && m.Id != req.MarketId) ||
+			!inDateRange(o.DateCreated, req.BeginDate, req.EndDate) {
+			continue
+		}
+		e := entries[o.Username]
+		pnl := (float64(o.AmountMicros)*sec.LastPrice - float64(o.CostMicros)) / lmsr.Micros
+		switch {
+		case m.Voided:
+		case m.DateResolved != "":
+			e.RealizedPnl += pnl
+		default:
+			e.UnrealizedPnl += pnl
+		}
+		traded[o.Username] = true
+	}
+	return rankLeaderboard(entries, traded, req), nil
+}
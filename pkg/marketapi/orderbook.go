@@ -0,0 +1,135 @@
+package marketapi
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	pb "github.com/domino14/scrabfutures/rpc/proto"
+)
+
+const (
+	defaultOrderBookLimit = 100
+	maxOrderBookLimit     = 1000
+)
+
+// orderBookPage returns how many orders a page of the order book should
+// have, and the id of the order it starts after, or 0 for the first page.
+func orderBookPage(req *pb.GetOrderBookRequest) (int, int64, error) {
+	limit := int(req.Limit)
+	if limit < 0 || limit > maxOrderBookLimit {
+		return 0, 0, fmt.Errorf("limit must be between 0 and %d", maxOrderBookLimit)
+	}
+	if limit == 0 {
+		limit = defaultOrderBookLimit
+	}
+	if req.PageToken == "" {
+		return limit, 0, nil
+	}
+	bts, err := base64.RawURLEncoding.DecodeString(req.PageToken)
+	if err != nil {
+		return 0, 0, errors.New("invalid page token")
+	}
+	after, err := strconv.ParseInt(string(bts), 10, 64)
+	if err != nil || after <= 0 {
+		return 0, 0, errors.New("invalid page token")
+	}
+	return limit, after, nil
+}
+
+// pageToken is the token for the page that starts after the order with the
+// given id.
+func pageToken(orderID int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(orderID, 10)))
+}
+
+// queryOrderBook is shared by SqliteStore and PostgresStore, which both
+// understand its $N placeholders.
+func queryOrderBook(ctx context.Context, q querier, req *pb.GetOrderBookRequest) (*pb.OrderBookResponse, error) {
+	limit, after, err := orderBookPage(req)
+	if err != nil {
+		return nil, err
+	}
+	wheres := []string{}
+	args := []any{}
+	where := func(cond string, arg any) {
+		args = append(args, arg)
+		wheres = append(wheres, strings.ReplaceAll(cond, "?", "$"+strconv.Itoa(len(args))))
+	}
+	if req.MarketId != "" {
+		where("markets.uuid = ?", req.MarketId)
+	}
+	if req.SecurityId != "" {
+		where("securities.uuid = ?", req.SecurityId)
+	}
+	if req.Username != "" {
+		where("users.username = ?", req.Username)
+	}
+	if req.SinceDate != "" {
+		where("orders.date >= ?", req.SinceDate)
+	}
+	switch req.Side {
+	case pb.GetOrderBookRequest_BUYS:
+		wheres = append(wheres, "orders.amount > 0")
+	case pb.GetOrderBookRequest_SELLS:
+		wheres = append(wheres, "orders.amount < 0")
+	}
+	order := "DESC"
+	if req.SortOrder == pb.GetOrderBookRequest_OLDEST_FIRST {
+		order = "ASC"
+		if after > 0 {
+			where("orders.id > ?", after)
+		}
+	} else if after > 0 {
+		where("orders.id < ?", after)
+	}
+	whereRendered := ""
+	if len(wheres) > 0 {
+		whereRendered = "WHERE " + strings.Join(wheres, " AND ")
+	}
+	// Ask for one more than we need, to find out if there's another page.
+	args = append(args, limit+1)
+	rows, err := q.QueryContext(ctx, fmt.Sprintf(`
+		SELECT orders.id, orders.uuid, users.username, securities.uuid,
+			securities.shortname, orders.amount, orders.cost, orders.date
+		FROM orders
+		JOIN users ON orders.user_id = users.id
+		JOIN securities ON orders.security_id = securities.id
+		JOIN markets ON securities.market_id = markets.id
+		%s
+		ORDER BY orders.id %s
+		LIMIT $%d`, whereRendered, order, len(args)), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	resp := &pb.OrderBookResponse{Orders: []*pb.Order{}}
+	var lastID int64
+	for rows.Next() {
+		if len(resp.Orders) == limit {
+			resp.NextPageToken = pageToken(lastID)
+			break
+		}
+		o := &pb.Order{}
+		err := rows.Scan(&lastID, &o.Id, &o.Username, &o.SecurityId, &o.SecurityShortname,
+			&o.AmountMicros, &o.CostMicros, &o.DateCreated)
+		if err != nil {
+			return nil, err
+		}
+		resp.Orders = append(resp.Orders, o)
+	}
+	return resp, rows.Err()
+}
+
+// GetOrderBook returns a page of the orders that match the request.
+func (s *SqliteStore) GetOrderBook(ctx context.Context, req *pb.GetOrderBookRequest) (*pb.OrderBookResponse, error) {
+	return queryOrderBook(ctx, s.db, req)
+}
+
+// GetOrderBook returns a page of the orders that match the request.
+func (s *PostgresStore) GetOrderBook(ctx context.Context, req *pb.GetOrderBookRequest) (*pb.OrderBookResponse, error) {
+	return queryOrderBook(ctx, s.db, req)
+}
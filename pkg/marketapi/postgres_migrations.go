@@ -0,0 +1,32 @@
+package marketapi
+
+import (
+	"database/sql"
+
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/database/postgres"
+	_ "github.com/lib/pq"
+	"github.com/rs/zerolog/log"
+)
+
+func EnsurePostgresMigrations(cfg *Config) {
+	pgDb, err := sql.Open("postgres", cfg.DatabaseURL)
+	if err != nil {
+		panic(err)
+	}
+	driver, err := postgres.WithInstance(pgDb, &postgres.Config{})
+	if err != nil {
+		panic(err)
+	}
+	m, err := migrate.NewWithDatabaseInstance(cfg.DBMigrationsPath, "postgres", driver)
+	if err != nil {
+		panic(err)
+	}
+	log.Info().Msg("bringing up migration")
+	if err := m.Up(); err != nil && err != migrate.ErrNoChange {
+		panic(err)
+	}
+	e1, e2 := m.Close()
+	log.Err(e1).Msg("close-source")
+	log.Err(e2).Msg("close-database")
+}
@@ -0,0 +1,478 @@
+package marketapi
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/lib/pq"
+	"github.com/lithammer/shortuuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/domino14/scrabfutures/pkg/events"
+	pb "github.com/domino14/scrabfutures/rpc/proto"
+)
+
+// PostgresStore is a Store kept in Postgres, so that more than one server
+// can share it. Where SqliteStore takes an exclusive lock on the whole
+// database to trade, PostgresStore locks the rows of the market's securities
+// with SELECT ... FOR UPDATE, so trades in different markets don't wait on
+// each other. Transactions always lock a market's securities before the
+// market itself, and both before portfolios.
+type PostgresStore struct {
+	db     *sql.DB
+	events *events.Hub
+}
+
+func NewPostgresStore(url string) (*PostgresStore, error) {
+	db, err := sql.Open("postgres", url)
+	if err != nil {
+		return nil, err
+	}
+	return &PostgresStore{db: db, events: events.NewHub()}, nil
+}
+
+// Events returns the hub that the store publishes events to once the
+// changes they describe have been committed.
+func (s *PostgresStore) Events() *events.Hub {
+	return s.events
+}
+
+// lockSecurities locks the securities of the given markets until the
+// transaction is done, so that no one else can trade in them.
+func lockSecurities(ctx context.Context, tx *sql.Tx, marketIDs ...int64) error {
+	rows, err := tx.QueryContext(ctx, `
+		SELECT id FROM securities
+		WHERE market_id = ANY($1)
+		ORDER BY id
+		FOR UPDATE`, pq.Array(marketIDs))
+	if err != nil {
+		return err
+	}
+	return rows.Close()
+}
+
+// lockMarket locks a market's securities, in the same order as trades do,
+// and then the market itself, until the transaction is done. Changes to a
+// market's status take these locks first, so that no trade can check the
+// market is open and then fill once it has closed.
+func lockMarket(ctx context.Context, tx *sql.Tx, uuid string) error {
+	rows, err := tx.QueryContext(ctx, `
+		SELECT securities.id FROM securities
+		JOIN markets ON securities.market_id = markets.id
+		WHERE markets.uuid = $1
+		ORDER BY securities.id
+		FOR UPDATE OF securities`, uuid)
+	if err != nil {
+		return err
+	}
+	if err := rows.Close(); err != nil {
+		return err
+	}
+	var id int64
+	return tx.QueryRowContext(ctx, `
+		SELECT id FROM markets WHERE uuid = $1 FOR UPDATE`, uuid).Scan(&id)
+}
+
+// lockPortfolio locks a user's portfolio until the transaction is done, so
+// that trades in different markets can't both spend the same tokens.
+func lockPortfolio(ctx context.Context, tx *sql.Tx, userID int64) error {
+	var id int64
+	return tx.QueryRowContext(ctx, `
+		SELECT user_id FROM portfolios WHERE user_id = $1 FOR UPDATE`, userID).Scan(&id)
+}
+
+func (s *PostgresStore) GetMarket(ctx context.Context, id string) (*pb.Market, error) {
+	return getMarket(ctx, s.db, id)
+}
+
+func (s *PostgresStore) GetMarkets(ctx context.Context, statuses ...pb.Market_Status) ([]*pb.Market, error) {
+	return getMarkets(ctx, s.db, statuses)
+}
+
+func (s *PostgresStore) CreateMarket(ctx context.Context, req *pb.CreateMarketRequest) (string, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return "", err
+	}
+	defer tx.Rollback()
+	id, err := createMarket(ctx, tx, req, shortuuid.New)
+	if err != nil {
+		return "", err
+	}
+	return id, tx.Commit()
+}
+
+func (s *PostgresStore) OpenMarket(ctx context.Context, uuid string) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if err := lockMarket(ctx, tx, uuid); err != nil {
+		return err
+	}
+	if err := openMarket(ctx, tx, uuid); err != nil {
+		return err
+	}
+	openTime := now()
+	if err := saveOpeningPrices(ctx, tx, uuid, openTime); err != nil {
+		return err
+	}
+	err = logMarketEvent(ctx, tx, eventOpenMarket, uuid, nil, nil, openTime)
+	if err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	s.events.Publish(events.Event{Type: events.MarketOpened, MarketID: uuid, Date: openTime})
+	return nil
+}
+
+func (s *PostgresStore) CloseMarket(ctx context.Context, uuid string) error {
+	closeTime := now()
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if err := lockMarket(ctx, tx, uuid); err != nil {
+		return err
+	}
+	if err := closeMarket(ctx, tx, uuid, closeTime); err != nil {
+		return err
+	}
+	err = logMarketEvent(ctx, tx, eventCloseMarket, uuid, nil, nil, closeTime)
+	if err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	s.events.Publish(events.Event{Type: events.MarketClosed, MarketID: uuid, Date: closeTime})
+	return nil
+}
+
+func (s *PostgresStore) HaltMarket(ctx context.Context, uuid string) error {
+	haltTime := now()
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if err := lockMarket(ctx, tx, uuid); err != nil {
+		return err
+	}
+	if _, err := setMarketStatus(ctx, tx, uuid, pb.Market_HALTED); err != nil {
+		return err
+	}
+	err = logMarketEvent(ctx, tx, eventHaltMarket, uuid, nil, nil, haltTime)
+	if err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	s.events.Publish(events.Event{Type: events.MarketHalted, MarketID: uuid, Date: haltTime})
+	return nil
+}
+
+func (s *PostgresStore) VoidMarket(ctx context.Context, uuid string) error {
+	marketID, err := lookupID(ctx, s.db, "markets", "uuid", uuid)
+	if err != nil {
+		return err
+	}
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// Lock this market and every market conditional on it before touching
+	// anyone's portfolio, in the same order as trades do.
+	toVoid, err := marketsToVoid(ctx, tx, marketID, nil)
+	if err != nil {
+		return err
+	}
+	toVoid = append([]int64{marketID}, toVoid...)
+	if err := lockSecurities(ctx, tx, toVoid...); err != nil {
+		return err
+	}
+	if _, err := setMarketStatus(ctx, tx, uuid, pb.Market_VOIDED); err != nil {
+		return err
+	}
+
+	voidTime := now()
+	voided, err := voidMarkets(ctx, tx, toVoid, voidTime)
+	if err != nil {
+		return err
+	}
+	err = logMarketEvent(ctx, tx, eventVoidMarket, uuid, nil, nil, voidTime)
+	if err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	for _, id := range voided {
+		s.events.Publish(events.Event{Type: events.MarketVoided, MarketID: id, Date: voidTime})
+	}
+	return nil
+}
+
+func (s *PostgresStore) ScheduleMarket(ctx context.Context, req *pb.ScheduleMarketRequest) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if err := lockMarket(ctx, tx, req.Id); err != nil {
+		return err
+	}
+	if err := scheduleMarket(ctx, tx, req); err != nil {
+		return err
+	}
+	err = logMarketEvent(ctx, tx, eventScheduleMarket, req.Id, req, nil, now())
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (s *PostgresStore) DeleteMarket(ctx context.Context, uuid string) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if err := lockMarket(ctx, tx, uuid); err != nil {
+		return err
+	}
+	if err := deleteMarket(ctx, tx, uuid); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (s *PostgresStore) AddSecurities(ctx context.Context, marketID string,
+	securities []*pb.AddSecuritiesRequest_Security) error {
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if err := lockMarket(ctx, tx, marketID); err != nil {
+		return err
+	}
+	if err := addSecurities(ctx, tx, marketID, securities, shortuuid.New); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	e, err := securitiesAddedEvent(ctx, s.db, marketID)
+	if err != nil {
+		return err
+	}
+	s.events.Publish(e)
+	return nil
+}
+
+func (s *PostgresStore) DeleteSecurity(ctx context.Context, marketID string,
+	securityID string) error {
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if err := lockMarket(ctx, tx, marketID); err != nil {
+		return err
+	}
+	if err := deleteSecurity(ctx, tx, marketID, securityID); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (s *PostgresStore) GetSecurity(ctx context.Context, uuid string) (*pb.Security, error) {
+	return getSecurity(ctx, s.db, uuid)
+}
+
+func (s *PostgresStore) GetSecurities(ctx context.Context, marketID string) ([]*pb.Security, error) {
+	return getSecurities(ctx, s.db, marketID)
+}
+
+func (s *PostgresStore) GetSecurityCosts(ctx context.Context, securityUUID string,
+	beginDate, endDate string) ([]*pb.GetSecurityCostsResponse_SecurityCost, error) {
+
+	return getSecurityCosts(ctx, s.db, securityUUID, beginDate, endDate)
+}
+
+func (s *PostgresStore) GetSecurityOrders(ctx context.Context, securityUUID string,
+	beginDate, endDate string) ([]*pb.Order, error) {
+
+	return getSecurityOrders(ctx, s.db, securityUUID, beginDate, endDate)
+}
+
+// GetPortfolio returns a user's tokens and every position they hold in
+// markets that haven't resolved yet, valued at current prices.
+func (s *PostgresStore) GetPortfolio(ctx context.Context, username string) (*pb.Portfolio, error) {
+	return getPortfolio(ctx, s.db, username)
+}
+
+// GrantTokens gives a user amount micro-tokens from the house.
+func (s *PostgresStore) GrantTokens(ctx context.Context, username string, amount int64) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if err := grantTokens(ctx, tx, username, amount); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// GetLedger returns a user's statement. See Store.
+func (s *PostgresStore) GetLedger(ctx context.Context, username string,
+	beginDate, endDate string) ([]*pb.LedgerEntry, int64, error) {
+
+	return getLedger(ctx, s.db, username, beginDate, endDate)
+}
+
+// SubmitGameResults stores results from a market's tournament, replacing any
+// earlier results for the same games, and returns how many of them changed
+// anything. A GameResults event is published for the rounds that changed.
+func (s *PostgresStore) SubmitGameResults(ctx context.Context, marketID string,
+	results []*pb.GameResult) (int, error) {
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+	e, changed, err := saveGameResults(ctx, tx, marketID, results, now())
+	if err != nil {
+		return 0, err
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	if changed > 0 {
+		s.events.Publish(e)
+	}
+	return changed, nil
+}
+
+// GetGameResults returns every result stored for a market, by round.
+func (s *PostgresStore) GetGameResults(ctx context.Context, marketID string) ([]*pb.GameResult, error) {
+	return loadGameResults(ctx, s.db, marketID)
+}
+
+// GetLeaderboard ranks users by portfolio value, or by P&L if the request
+// is filtered to a market or a time window. See GetLeaderboardRequest.
+func (s *PostgresStore) GetLeaderboard(ctx context.Context, req *pb.GetLeaderboardRequest) (*pb.GetLeaderboardResponse, error) {
+	return leaderboard(ctx, s.db, req)
+}
+
+// ScoreMarket scores a resolved market's price history against what its
+// securities paid out, and stores the scores, replacing any earlier ones.
+func (s *PostgresStore) ScoreMarket(ctx context.Context, marketID string) ([]*pb.MarketScore, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	scores, err := scoreMarket(ctx, tx, marketID)
+	if err != nil {
+		return nil, err
+	}
+	return scores, tx.Commit()
+}
+
+// GetMarketScores returns a market's scores, or every scored market's if
+// marketID is empty.
+func (s *PostgresStore) GetMarketScores(ctx context.Context, marketID string) ([]*pb.MarketScore, error) {
+	return loadMarketScores(ctx, s.db, marketID)
+}
+
+func (s *PostgresStore) FulfillOrder(ctx context.Context, username string,
+	securityUUID, marketUUID string, amount int64, buy bool, idempotencyKey string) (int64, error) {
+
+	t, err := newTrade(ctx, s.db, username, securityUUID, marketUUID, amount, buy, idempotencyKey)
+	if err != nil {
+		return 0, err
+	}
+	t.uuid = shortuuid.New()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	// Resolving or voiding a market locks its securities too, so its status
+	// can't change until we're done. Retries with the same idempotency key
+	// wait on these locks, so they can't both miss the other's order.
+	if err := lockSecurities(ctx, tx, t.marketID); err != nil {
+		return 0, err
+	}
+	if err := lockPortfolio(ctx, tx, t.userID); err != nil {
+		return 0, err
+	}
+	cost, e, err := fulfillOrder(ctx, tx, t)
+	if err != nil || e == nil {
+		return cost, err
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	s.events.Publish(*e)
+	return cost, nil
+}
+
+// ResolveMarket closes a market, records what each of its securities pays
+// out, and settles every position held in it, voiding the markets that were
+// conditional on a security that didn't pay out.
+func (s *PostgresStore) ResolveMarket(ctx context.Context, req *pb.ResolveMarketRequest) error {
+	r, err := newResolution(ctx, s.db, req)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// Lock this market and every market it voids before touching anyone's
+	// portfolio, in the same order as trades do.
+	toVoid, err := marketsToVoid(ctx, tx, r.marketID, r.payouts)
+	if err != nil {
+		return err
+	}
+	if err := lockSecurities(ctx, tx, append([]int64{r.marketID}, toVoid...)...); err != nil {
+		return err
+	}
+	resolveTime := now()
+	voided, err := resolveMarket(ctx, tx, r, toVoid, resolveTime)
+	if err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	s.events.Publish(events.Event{Type: events.MarketResolved, MarketID: req.MarketId, Date: resolveTime})
+	for _, uuid := range voided {
+		s.events.Publish(events.Event{Type: events.MarketVoided, MarketID: uuid, Date: resolveTime})
+	}
+	// A market that can't be scored, for example because it was never
+	// traded, is still resolved.
+	if _, err := s.ScoreMarket(ctx, req.MarketId); err != nil {
+		log.Err(err).Str("marketID", req.MarketId).Msg("score-market")
+	}
+	return nil
+}
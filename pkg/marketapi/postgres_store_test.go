@@ -0,0 +1,119 @@
+package marketapi
+
+import (
+	"bytes"
+	"database/sql"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	embeddedpostgres "github.com/fergusstrange/embedded-postgres"
+)
+
+var pgCfg = Config{
+	DBMigrationsPath: os.Getenv("DB_MIGRATIONS_PATH") + "/postgres",
+}
+
+// embeddedPG is the Postgres started for this test run, if one was needed.
+var embeddedPG struct {
+	once sync.Once
+	db   *embeddedpostgres.EmbeddedPostgres
+	dir  string
+	url  string
+	err  error
+}
+
+func TestMain(m *testing.M) {
+	code := m.Run()
+	if embeddedPG.db != nil {
+		embeddedPG.db.Stop()
+	}
+	if embeddedPG.dir != "" {
+		os.RemoveAll(embeddedPG.dir)
+	}
+	os.Exit(code)
+}
+
+// postgresURL returns the database to test the Postgres store against: the
+// one at TEST_POSTGRES_URL if that's set, or else an embedded Postgres that
+// is started the first time it's needed. Its binaries are downloaded once
+// and cached. The Postgres store is skipped if TEST_POSTGRES=off, or if the
+// embedded Postgres can't be started, e.g. without network access.
+func postgresURL(t *testing.T) string {
+	if os.Getenv("TEST_POSTGRES") == "off" {
+		t.Skip("TEST_POSTGRES is off")
+	}
+	if url := os.Getenv("TEST_POSTGRES_URL"); url != "" {
+		return url
+	}
+	embeddedPG.once.Do(startEmbeddedPostgres)
+	if embeddedPG.err != nil {
+		t.Skipf("couldn't start an embedded postgres (set TEST_POSTGRES_URL to "+
+			"test against another database): %v", embeddedPG.err)
+	}
+	return embeddedPG.url
+}
+
+func startEmbeddedPostgres() {
+	port, err := freePort()
+	if err != nil {
+		embeddedPG.err = err
+		return
+	}
+	dir, err := ioutil.TempDir("", "scrabfutures-pg")
+	if err != nil {
+		embeddedPG.err = err
+		return
+	}
+	embeddedPG.dir = dir
+	var logs bytes.Buffer
+	config := embeddedpostgres.DefaultConfig().
+		Port(port).
+		RuntimePath(filepath.Join(dir, "runtime")).
+		DataPath(filepath.Join(dir, "data")).
+		Logger(&logs)
+	db := embeddedpostgres.NewDatabase(config)
+	if err := db.Start(); err != nil {
+		embeddedPG.err = fmt.Errorf("%w\n%s", err, logs.String())
+		return
+	}
+	embeddedPG.db = db
+	embeddedPG.url = config.GetConnectionURL() + "?sslmode=disable"
+}
+
+func freePort() (uint32, error) {
+	l, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		return 0, err
+	}
+	defer l.Close()
+	return uint32(l.Addr().(*net.TCPAddr).Port), nil
+}
+
+// initPostgres empties the database at url, migrates it, and adds the users
+// from the basic fixture.
+func initPostgres(url string) {
+	db, err := sql.Open("postgres", url)
+	if err != nil {
+		panic(err)
+	}
+	defer db.Close()
+	_, err = db.Exec(`DROP SCHEMA public CASCADE; CREATE SCHEMA public;`)
+	if err != nil {
+		panic(err)
+	}
+	pgCfg.DatabaseURL = url
+	EnsurePostgresMigrations(&pgCfg)
+	bts, err := ioutil.ReadFile("./testfixtures/postgres_users.sql")
+	if err != nil {
+		panic(err)
+	}
+	_, err = db.Exec(string(bts))
+	if err != nil {
+		panic(err)
+	}
+}
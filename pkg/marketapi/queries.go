@@ -0,0 +1,991 @@
+package marketapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/domino14/scrabfutures/pkg/events"
+	"github.com/domino14/scrabfutures/pkg/lmsr"
+	pb "github.com/domino14/scrabfutures/rpc/proto"
+)
+
+// The helpers in this file do the reading and writing behind the Store
+// methods. Like recordEntry, they are shared by SqliteStore and
+// PostgresStore, which both understand their $N placeholders, and are
+// called within whatever transaction and locks each store takes.
+
+// lookupID returns the database id of the row in tableName whose
+// otheridName is otherid.
+func lookupID(ctx context.Context, q querier, tableName, otheridName, otherid string) (int64, error) {
+	var dbid int64
+	query := fmt.Sprintf("SELECT id FROM %s WHERE %s = $1", tableName, otheridName)
+	err := q.QueryRowContext(ctx, query, otherid).Scan(&dbid)
+	if err != nil {
+		return 0, err
+	}
+	return dbid, nil
+}
+
+func getMarket(ctx context.Context, q querier, id string) (*pb.Market, error) {
+	market, err := loadMarket(ctx, q, id)
+	if err != nil {
+		return nil, err
+	}
+	err = addMarketPlayers(ctx, q, market)
+	if err != nil {
+		return nil, err
+	}
+	return market, nil
+}
+
+// addMarketPlayers fills in the field of players for ranking markets.
+func addMarketPlayers(ctx context.Context, q querier, market *pb.Market) error {
+	if market.MarketType != pb.MarketType_RANKING {
+		return nil
+	}
+	rows, err := q.QueryContext(ctx, `
+		SELECT name
+		FROM market_players
+		JOIN markets ON market_players.market_id = markets.id
+		WHERE markets.uuid = $1
+		ORDER BY idx`, market.Id)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var name string
+		err = rows.Scan(&name)
+		if err != nil {
+			return err
+		}
+		market.Players = append(market.Players, name)
+	}
+	return rows.Err()
+}
+
+func getMarkets(ctx context.Context, q querier, statuses []pb.Market_Status) ([]*pb.Market, error) {
+	where, args := statusFilter(statuses)
+	rows, err := q.QueryContext(ctx, `
+		SELECT `+marketColumns+`
+		FROM `+marketTables+`
+		`+where+`
+		ORDER BY markets.id`, args...)
+	if err != nil {
+		return nil, err
+	}
+	markets := []*pb.Market{}
+	defer rows.Close()
+	for rows.Next() {
+		market, err := scanMarket(rows)
+		if err != nil {
+			return nil, err
+		}
+		markets = append(markets, market)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	for _, market := range markets {
+		err = addMarketPlayers(ctx, q, market)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return markets, nil
+}
+
+// createMarket creates a new, closed market, along with the securities that
+// binary and scalar markets come with, and returns its uuid. newID makes the
+// uuids.
+func createMarket(ctx context.Context, q execer, req *pb.CreateMarketRequest,
+	newID func() string) (string, error) {
+
+	var lowerBound, upperBound sql.NullFloat64
+	var conditionID sql.NullInt64
+
+	securities, err := newMarketSecurities(req)
+	if err != nil {
+		return "", err
+	}
+	if req.MarketType == pb.MarketType_SCALAR {
+		lowerBound = sql.NullFloat64{Float64: req.LowerBound, Valid: true}
+		upperBound = sql.NullFloat64{Float64: req.UpperBound, Valid: true}
+	}
+
+	if req.ConditionSecurityId != "" {
+		cond, err := getSecurity(ctx, q, req.ConditionSecurityId)
+		if err != nil {
+			return "", err
+		}
+		parent, err := getMarket(ctx, q, cond.MarketId)
+		if err != nil {
+			return "", err
+		}
+		if err := validateCondition(parent); err != nil {
+			return "", err
+		}
+		dbid, err := lookupID(ctx, q, "securities", "uuid", req.ConditionSecurityId)
+		if err != nil {
+			return "", err
+		}
+		conditionID = sql.NullInt64{Int64: dbid, Valid: true}
+	}
+
+	id := newID()
+	var mdbid int64
+	err = q.QueryRowContext(ctx, `
+		INSERT INTO markets(uuid, description, date_created, market_type,
+			lower_bound, upper_bound, condition_security_id)
+		VALUES($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id
+	`, id, req.Description, now(), req.MarketType, lowerBound, upperBound,
+		conditionID).Scan(&mdbid)
+	if err != nil {
+		return "", err
+	}
+
+	for idx, p := range req.Players {
+		_, err = q.ExecContext(ctx, `
+			INSERT INTO market_players(market_id, idx, name)
+			VALUES($1, $2, $3)`, mdbid, idx, p)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	ids := []string{id}
+	if len(securities) > 0 {
+		secIDs, err := insertSecurities(ctx, q, mdbid, securities, nil, newID)
+		if err != nil {
+			return "", err
+		}
+		ids = append(ids, secIDs...)
+	}
+	err = logMarketEvent(ctx, q, eventCreateMarket, id, req, ids, now())
+	if err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// saveOpeningPrices logs the prices a market opens at, so that every
+// security's price history starts when trading does.
+func saveOpeningPrices(ctx context.Context, q execer, uuid, date string) error {
+	_, err := q.ExecContext(ctx, `
+		INSERT INTO security_costs(security_id, cost, date)
+		SELECT securities.id, securities.last_price, CAST($1 AS TEXT)
+		FROM securities
+		JOIN markets ON securities.market_id = markets.id
+		WHERE markets.uuid = $2`, date, uuid)
+	return err
+}
+
+func closeMarket(ctx context.Context, q execer, uuid, date string) error {
+	if _, err := setMarketStatus(ctx, q, uuid, pb.Market_CLOSED); err != nil {
+		return err
+	}
+	_, err := q.ExecContext(ctx, `
+		UPDATE markets SET date_closed = $1 WHERE uuid = $2`, date, uuid)
+	return err
+}
+
+func deleteMarket(ctx context.Context, q execer, uuid string) error {
+	m, err := loadMarket(ctx, q, uuid)
+	if err != nil {
+		return err
+	}
+	if err := checkDeleteMarket(m); err != nil {
+		return err
+	}
+	var dependents int
+	err = q.QueryRowContext(ctx, `
+		SELECT COUNT(*) FROM markets
+		JOIN securities ON markets.condition_security_id = securities.id
+		JOIN markets parents ON securities.market_id = parents.id
+		WHERE parents.uuid = $1`, uuid).Scan(&dependents)
+	if err != nil {
+		return err
+	}
+	if dependents > 0 {
+		return errors.New("disallowed deletion of market that other markets are conditional on")
+	}
+	_, err = q.ExecContext(ctx, `DELETE FROM markets WHERE uuid = $1`, uuid)
+	if err != nil {
+		return err
+	}
+	return logMarketEvent(ctx, q, eventDeleteMarket, uuid, nil, nil, now())
+}
+
+// addSecurities adds one or more securities to a market that hasn't opened
+// yet. newID makes their uuids.
+func addSecurities(ctx context.Context, q execer, marketID string,
+	securities []*pb.AddSecuritiesRequest_Security, newID func() string) error {
+
+	m, err := getMarket(ctx, q, marketID)
+	if err != nil {
+		return err
+	}
+	existing, err := getSecurities(ctx, q, marketID)
+	if err != nil {
+		return err
+	}
+	seeds, err := validateNewSecurities(m, len(existing), securities)
+	if err != nil {
+		return err
+	}
+	mdbid, err := lookupID(ctx, q, "markets", "uuid", marketID)
+	if err != nil {
+		return err
+	}
+	ids, err := insertSecurities(ctx, q, mdbid, securities, seeds, newID)
+	if err != nil {
+		return err
+	}
+	return logMarketEvent(ctx, q, eventAddSecurities, marketID,
+		&pb.AddSecuritiesRequest{MarketId: marketID, Securities: securities}, ids, now())
+}
+
+// securitiesAddedEvent is the event to publish once securities have been
+// added to a market, with every one of its securities' new prices.
+func securitiesAddedEvent(ctx context.Context, q querier, marketID string) (events.Event, error) {
+	e := events.Event{Type: events.SecuritiesAdded, MarketID: marketID, Date: now()}
+	all, err := getSecurities(ctx, q, marketID)
+	if err != nil {
+		return e, err
+	}
+	for _, sec := range all {
+		e.Prices = append(e.Prices, events.SecurityPrice{SecurityID: sec.Id, Price: sec.LastPrice})
+	}
+	return e, nil
+}
+
+// insertSecurities inserts the given securities into a market, reprices all
+// of the market's securities, and returns the new securities' uuids. If seeds
+// is not nil, each security starts off with that many micro-shares
+// outstanding.
+func insertSecurities(ctx context.Context, q execer, marketDBID int64,
+	securities []*pb.AddSecuritiesRequest_Security, seeds []int64,
+	newID func() string) ([]string, error) {
+
+	addDate := now()
+	ids := []string{}
+
+	for idx, sec := range securities {
+		var positions sql.NullString
+		if len(sec.Positions) > 0 {
+			positions = sql.NullString{String: formatPositions(sec.Positions), Valid: true}
+		}
+		var rating sql.NullFloat64
+		if sec.Rating != 0 {
+			rating = sql.NullFloat64{Float64: sec.Rating, Valid: true}
+		}
+		var seed int64
+		if seeds != nil {
+			seed = seeds[idx]
+		}
+		uuid := newID()
+		_, err := q.ExecContext(ctx, `
+			INSERT INTO securities(uuid, description, shortname, date_created,
+				market_id, shares_outstanding, player_idx, positions,
+				seed_shares, rating)
+			VALUES ($1, $2, $3, $4, $5, $6,
+				(SELECT idx FROM market_players WHERE market_id = $5 AND name = $7), $8,
+				$6, $9)
+		`, uuid, sec.Description, sec.Shortname, addDate, marketDBID, seed,
+			sec.Player, positions, rating)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, uuid)
+	}
+
+	// Now edit all the prices...
+	return ids, editAllSecurityPrices(ctx, q, marketDBID)
+}
+
+// deleteSecurity deletes a security from a market that hasn't opened yet.
+func deleteSecurity(ctx context.Context, q execer, marketID, securityID string) error {
+	m, err := getMarket(ctx, q, marketID)
+	if err != nil {
+		return err
+	}
+	if err := checkDeleteSecurity(m); err != nil {
+		return err
+	}
+	var dependents int
+	err = q.QueryRowContext(ctx, `
+		SELECT COUNT(*) FROM markets
+		JOIN securities ON markets.condition_security_id = securities.id
+		WHERE securities.uuid = $1`, securityID).Scan(&dependents)
+	if err != nil {
+		return err
+	}
+	if dependents > 0 {
+		return errors.New("disallowed deletion of security that other markets are conditional on")
+	}
+
+	mdbid, err := lookupID(ctx, q, "markets", "uuid", marketID)
+	if err != nil {
+		return err
+	}
+	res, err := q.ExecContext(ctx, `
+		DELETE FROM securities WHERE uuid = $1 AND market_id = $2`, securityID, mdbid)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return errors.New("security not found in this market")
+	}
+
+	// Now edit all the prices...
+	err = editAllSecurityPrices(ctx, q, mdbid)
+	if err != nil {
+		return err
+	}
+	return logMarketEvent(ctx, q, eventDeleteSecurity, marketID,
+		&pb.DeleteSecurityRequest{MarketId: marketID, Id: securityID}, nil, now())
+}
+
+func editAllSecurityPrices(ctx context.Context, q execer, marketDBID int64) error {
+	ms, err := loadMarketShares(ctx, q, marketDBID)
+	if err != nil {
+		return err
+	}
+
+	// calculate new price for all shares in this market.
+	for idx, np := range ms.pricer.Prices(ms.allShares) {
+		_, err = q.ExecContext(ctx, `
+			UPDATE securities SET last_price = $1 WHERE id = $2`, np, ms.ids[idx])
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func getSecurity(ctx context.Context, q querier, uuid string) (*pb.Security, error) {
+	security := &pb.Security{}
+	var positions string
+	err := q.QueryRowContext(ctx, `
+		SELECT securities.description, shortname, securities.date_created,
+			markets.uuid, shares_outstanding, last_price,
+			COALESCE(market_players.name, ''), COALESCE(positions, ''),
+			COALESCE(rating, 0)
+		FROM securities
+		JOIN markets
+		ON securities.market_id = markets.id
+		LEFT JOIN market_players
+		ON market_players.market_id = securities.market_id
+			AND market_players.idx = securities.player_idx
+		WHERE securities.uuid = $1`, uuid).Scan(
+		&security.Description, &security.Shortname, &security.DateCreated,
+		&security.MarketId, &security.SharesOutstandingMicros, &security.LastPrice,
+		&security.Player, &positions, &security.Rating)
+	if err != nil {
+		return nil, err
+	}
+	security.Id = uuid
+	security.Positions = parsePositions(positions)
+	return security, nil
+}
+
+func getSecurities(ctx context.Context, q querier, marketID string) ([]*pb.Security, error) {
+	rows, err := q.QueryContext(ctx, `
+		SELECT securities.uuid, securities.description, securities.shortname,
+			securities.date_created, shares_outstanding,
+			last_price, COALESCE(market_players.name, ''),
+			COALESCE(positions, ''), COALESCE(rating, 0)
+		FROM securities
+		JOIN markets ON securities.market_id = markets.id
+		LEFT JOIN market_players
+		ON market_players.market_id = securities.market_id
+			AND market_players.idx = securities.player_idx
+		WHERE markets.uuid = $1
+		ORDER BY securities.id
+		`, marketID)
+	if err != nil {
+		return nil, err
+	}
+
+	securities := []*pb.Security{}
+	defer rows.Close()
+	for rows.Next() {
+		security := &pb.Security{}
+		var positions string
+		err = rows.Scan(&security.Id, &security.Description, &security.Shortname,
+			&security.DateCreated, &security.SharesOutstandingMicros, &security.LastPrice,
+			&security.Player, &positions, &security.Rating)
+		if err != nil {
+			return nil, err
+		}
+		security.Positions = parsePositions(positions)
+		securities = append(securities, security)
+	}
+	return securities, rows.Err()
+}
+
+// dateBounds adds the optional RFC3339 bounds on column to a query's where
+// clauses.
+func dateBounds(wheres []string, vars []any, column, beginDate, endDate string) ([]string, []any) {
+	if beginDate != "" {
+		vars = append(vars, beginDate)
+		wheres = append(wheres, fmt.Sprintf("%s >= $%d", column, len(vars)))
+	}
+	if endDate != "" {
+		vars = append(vars, endDate)
+		wheres = append(wheres, fmt.Sprintf("%s <= $%d", column, len(vars)))
+	}
+	return wheres, vars
+}
+
+func getSecurityCosts(ctx context.Context, q querier, securityUUID string,
+	beginDate, endDate string) ([]*pb.GetSecurityCostsResponse_SecurityCost, error) {
+
+	securityID, err := lookupID(ctx, q, "securities", "uuid", securityUUID)
+	if err != nil {
+		return nil, err
+	}
+	wheres, wheresVars := dateBounds([]string{"security_id = $1"}, []any{securityID},
+		"date", beginDate, endDate)
+	rows, err := q.QueryContext(ctx, fmt.Sprintf(`
+		SELECT date, cost
+		FROM security_costs
+		WHERE %s
+		ORDER BY date, id`, strings.Join(wheres, " AND ")), wheresVars...)
+	if err != nil {
+		return nil, err
+	}
+	costs := []*pb.GetSecurityCostsResponse_SecurityCost{}
+	defer rows.Close()
+	for rows.Next() {
+		c := &pb.GetSecurityCostsResponse_SecurityCost{}
+		if err := rows.Scan(&c.Date, &c.Cost); err != nil {
+			return nil, err
+		}
+		costs = append(costs, c)
+	}
+	return costs, rows.Err()
+}
+
+func getSecurityOrders(ctx context.Context, q querier, securityUUID string,
+	beginDate, endDate string) ([]*pb.Order, error) {
+
+	securityID, err := lookupID(ctx, q, "securities", "uuid", securityUUID)
+	if err != nil {
+		return nil, err
+	}
+	wheres, wheresVars := dateBounds([]string{"orders.security_id = $1"}, []any{securityID},
+		"orders.date", beginDate, endDate)
+	rows, err := q.QueryContext(ctx, fmt.Sprintf(`
+		SELECT orders.uuid, users.username, securities.uuid, securities.shortname,
+			orders.amount, orders.cost, orders.date
+		FROM orders
+		JOIN users ON orders.user_id = users.id
+		JOIN securities ON orders.security_id = securities.id
+		WHERE %s
+		ORDER BY orders.id`, strings.Join(wheres, " AND ")), wheresVars...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	orders := []*pb.Order{}
+	for rows.Next() {
+		o := &pb.Order{}
+		err := rows.Scan(&o.Id, &o.Username, &o.SecurityId, &o.SecurityShortname,
+			&o.AmountMicros, &o.CostMicros, &o.DateCreated)
+		if err != nil {
+			return nil, err
+		}
+		orders = append(orders, o)
+	}
+	return orders, rows.Err()
+}
+
+// getPortfolio returns a user's tokens and every position they hold in
+// markets that haven't resolved yet, valued at current prices. Positions in
+// resolved markets have already been paid out.
+func getPortfolio(ctx context.Context, q querier, username string) (*pb.Portfolio, error) {
+	userID, err := lookupID(ctx, q, "users", "username", username)
+	if err != nil {
+		return nil, err
+	}
+	portfolio := &pb.Portfolio{Username: username}
+	err = q.QueryRowContext(ctx, `
+		SELECT tokens FROM portfolios WHERE user_id = $1`, userID).Scan(&portfolio.TokensMicros)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := q.QueryContext(ctx, `
+		SELECT securities.id, securities.uuid, securities.market_id,
+			portfolio_securities.amount
+		FROM portfolio_securities
+		JOIN securities ON portfolio_securities.security_id = securities.id
+		JOIN markets ON securities.market_id = markets.id
+		WHERE user_id = $1 AND portfolio_securities.amount != 0
+			AND markets.date_resolved IS NULL
+		ORDER BY securities.id`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	type held struct {
+		securityID int64
+		uuid       string
+		marketID   int64
+	}
+	helds := []held{}
+	for rows.Next() {
+		var h held
+		position := &pb.Position{}
+		if err := rows.Scan(&h.securityID, &h.uuid, &h.marketID, &position.AmountMicros); err != nil {
+			return nil, err
+		}
+		helds = append(helds, h)
+		portfolio.Positions = append(portfolio.Positions, position)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	markets := map[int64]*marketShares{}
+	for idx, position := range portfolio.Positions {
+		h := helds[idx]
+		position.Security, err = getSecurity(ctx, q, h.uuid)
+		if err != nil {
+			return nil, err
+		}
+		basis, err := loadCostBasis(ctx, q, userID, h.securityID)
+		if err != nil {
+			return nil, err
+		}
+		ms, ok := markets[h.marketID]
+		if !ok {
+			ms, err = loadMarketShares(ctx, q, h.marketID)
+			if err != nil {
+				return nil, err
+			}
+			markets[h.marketID] = ms
+		}
+		for secIdx, id := range ms.ids {
+			if id == h.securityID {
+				valuePosition(position, basis, ms.pricer, ms.allShares, secIdx)
+			}
+		}
+	}
+	return portfolio, nil
+}
+
+// loadCostBasis loads the user's orders for a security and works out their
+// cost basis.
+func loadCostBasis(ctx context.Context, q querier, userID, securityID int64) (float64, error) {
+	rows, err := q.QueryContext(ctx, `
+		SELECT amount, cost FROM orders
+		WHERE user_id = $1 AND security_id = $2
+		ORDER BY id`, userID, securityID)
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+	orders := []*pb.Order{}
+	for rows.Next() {
+		o := &pb.Order{}
+		if err := rows.Scan(&o.AmountMicros, &o.CostMicros); err != nil {
+			return 0, err
+		}
+		orders = append(orders, o)
+	}
+	return costBasis(orders), rows.Err()
+}
+
+// grantTokens gives a user amount micro-tokens from the house.
+func grantTokens(ctx context.Context, q execer, username string, amount int64) error {
+	if amount <= 0 {
+		return errors.New("amount must be positive")
+	}
+	userID, err := lookupID(ctx, q, "users", "username", username)
+	if err != nil {
+		return err
+	}
+	_, err = q.ExecContext(ctx, `
+		UPDATE portfolios SET tokens = tokens + $1 WHERE user_id = $2`, amount, userID)
+	if err != nil {
+		return err
+	}
+	return recordEntry(ctx, q, pb.LedgerEntryKind_GRANT, houseAccount, userAccount(username),
+		amount, nil, nil, now())
+}
+
+// getLedger returns a user's statement. See Store.
+func getLedger(ctx context.Context, q querier, username string,
+	beginDate, endDate string) ([]*pb.LedgerEntry, int64, error) {
+
+	if _, err := lookupID(ctx, q, "users", "username", username); err != nil {
+		return nil, 0, err
+	}
+	entries, err := loadLedger(ctx, q, userAccount(username))
+	if err != nil {
+		return nil, 0, err
+	}
+	entries, balance := statement(entries, userAccount(username), beginDate, endDate)
+	return entries, balance, nil
+}
+
+// newTrade looks up the ids for an order, before it is priced. Sales have
+// negative amounts.
+func newTrade(ctx context.Context, q querier, username, securityUUID, marketUUID string,
+	amount int64, buy bool, idempotencyKey string) (trade, error) {
+
+	t := trade{
+		username: username, securityUUID: securityUUID, marketUUID: marketUUID,
+		amount: amount, idempotencyKey: idempotencyKey,
+	}
+	if amount <= 0 {
+		return t, errors.New("amount must be positive")
+	}
+	if !buy {
+		t.amount *= -1
+	}
+	var err error
+	t.marketID, err = lookupID(ctx, q, "markets", "uuid", marketUUID)
+	if err != nil {
+		return t, err
+	}
+	t.userID, err = lookupID(ctx, q, "users", "username", username)
+	if err != nil {
+		return t, err
+	}
+	t.securityID, err = lookupID(ctx, q, "securities", "uuid", securityUUID)
+	if err != nil {
+		return t, err
+	}
+	return t, nil
+}
+
+// fulfillOrder prices a trade and makes it, if the market is trading and the
+// user can afford it, and returns what it cost along with the prices event
+// to publish once the transaction commits. A retry with the same idempotency
+// key gets the first order's cost and no event. The caller must hold
+// whatever locks keep the market's shares, and the user's portfolio, from
+// changing until the transaction is done.
+func fulfillOrder(ctx context.Context, q execer, t trade) (int64, *events.Event, error) {
+	// A retry gets the first order's result, even if the market has closed
+	// since.
+	if t.idempotencyKey != "" {
+		earlier, err := keyedOrder(ctx, q, t.userID, t.idempotencyKey)
+		if err != nil {
+			return 0, nil, err
+		}
+		if earlier != nil {
+			return earlier.CostMicros, nil, checkRetry(earlier, t.securityUUID, t.amount)
+		}
+	}
+	var status pb.Market_Status
+	err := q.QueryRowContext(ctx, `
+		SELECT status FROM markets WHERE id = $1`, t.marketID).Scan(&status)
+	if err != nil {
+		return 0, nil, err
+	}
+	if err := checkTrading(status); err != nil {
+		return 0, nil, err
+	}
+
+	ms, err := loadMarketShares(ctx, q, t.marketID)
+	if err != nil {
+		return 0, nil, err
+	}
+	myIdx, err := ms.index(t.securityID)
+	if err != nil {
+		return 0, nil, err
+	}
+	t.date = now()
+	t.cost = lmsr.TradeCostMicros(ms.pricer, t.amount, ms.allShares, myIdx)
+	ms.micros[myIdx] += t.amount
+
+	err = q.QueryRowContext(ctx, `
+		SELECT tokens FROM portfolios WHERE user_id = $1`, t.userID).Scan(&t.tokens)
+	if err != nil {
+		return 0, nil, err
+	}
+	err = q.QueryRowContext(ctx, `
+		SELECT amount FROM portfolio_securities
+		WHERE user_id = $1 AND security_id = $2`, t.userID, t.securityID).Scan(&t.held)
+	if err != nil && err != sql.ErrNoRows {
+		return 0, nil, err
+	}
+	if err := checkTrade(t.cost, t.amount, t.tokens, t.held); err != nil {
+		return 0, nil, err
+	}
+
+	if err := saveTrade(ctx, q, t); err != nil {
+		return 0, nil, err
+	}
+	e, err := savePrices(ctx, q, t.marketUUID, ms, t.date)
+	if err != nil {
+		return 0, nil, err
+	}
+	return t.cost, &e, nil
+}
+
+// resolution is a market that has been checked and can be resolved, along
+// with what each of its securities pays out.
+type resolution struct {
+	req      *pb.ResolveMarketRequest
+	marketID int64
+	payouts  map[string]float64
+}
+
+// newResolution checks that a market can be resolved as requested, and works
+// out its payouts.
+func newResolution(ctx context.Context, q querier, req *pb.ResolveMarketRequest) (*resolution, error) {
+	m, err := getMarket(ctx, q, req.MarketId)
+	if err != nil {
+		return nil, err
+	}
+	var parent *pb.Market
+	if m.ConditionSecurityId != "" {
+		cond, err := getSecurity(ctx, q, m.ConditionSecurityId)
+		if err != nil {
+			return nil, err
+		}
+		parent, err = getMarket(ctx, q, cond.MarketId)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := checkResolve(m, parent); err != nil {
+		return nil, err
+	}
+	securities, err := getSecurities(ctx, q, req.MarketId)
+	if err != nil {
+		return nil, err
+	}
+	payouts, err := securityPayouts(m, securities, req)
+	if err != nil {
+		return nil, err
+	}
+	marketID, err := lookupID(ctx, q, "markets", "uuid", req.MarketId)
+	if err != nil {
+		return nil, err
+	}
+	return &resolution{req: req, marketID: marketID, payouts: payouts}, nil
+}
+
+// resolveMarket closes a market, records what each of its securities pays
+// out, settles every position held in it, and voids toVoid, the markets that
+// marketsToVoid says resolving it voids. It returns the uuids of the voided
+// markets.
+func resolveMarket(ctx context.Context, q execer, r *resolution, toVoid []int64,
+	resolveTime string) ([]string, error) {
+
+	res, err := q.ExecContext(ctx, `
+		UPDATE markets
+		SET status = $1, date_closed = COALESCE(date_closed, $2), date_resolved = $2
+		WHERE id = $3 AND date_resolved IS NULL`, pb.Market_RESOLVED, resolveTime, r.marketID)
+	if err != nil {
+		return nil, err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return nil, err
+	} else if n != 1 {
+		// Someone else resolved this market while we weren't looking.
+		return nil, errors.New("this market has already been resolved")
+	}
+
+	for uuid, payout := range r.payouts {
+		_, err = q.ExecContext(ctx, `
+			UPDATE securities SET payout = $1, last_price = $1 WHERE uuid = $2`,
+			payout, uuid)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	type position struct {
+		userID     int64
+		username   string
+		securityID int64
+		uuid       string
+		amount     int64
+	}
+	rows, err := q.QueryContext(ctx, `
+		SELECT user_id, users.username, security_id, securities.uuid, amount
+		FROM portfolio_securities
+		JOIN users ON portfolio_securities.user_id = users.id
+		JOIN securities ON portfolio_securities.security_id = securities.id
+		WHERE securities.market_id = $1 AND amount > 0
+		ORDER BY user_id, security_id`, r.marketID)
+	if err != nil {
+		return nil, err
+	}
+	positions := []position{}
+	defer rows.Close()
+	for rows.Next() {
+		var p position
+		err = rows.Scan(&p.userID, &p.username, &p.securityID, &p.uuid, &p.amount)
+		if err != nil {
+			return nil, err
+		}
+		positions = append(positions, p)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	for _, p := range positions {
+		paid := lmsr.PayoutMicros(p.amount, r.payouts[p.uuid])
+		_, err = q.ExecContext(ctx, `
+			UPDATE portfolios SET tokens = tokens + $1 WHERE user_id = $2`, paid, p.userID)
+		if err != nil {
+			return nil, err
+		}
+		_, err = q.ExecContext(ctx, `
+			INSERT INTO payouts(user_id, security_id, amount, payout, date)
+			VALUES($1, $2, $3, $4, $5)`, p.userID, p.securityID, p.amount, paid, resolveTime)
+		if err != nil {
+			return nil, err
+		}
+		err = recordEntry(ctx, q, pb.LedgerEntryKind_PAYOUT, escrowAccount(r.req.MarketId),
+			userAccount(p.username), paid, r.marketID, nil, resolveTime)
+		if err != nil {
+			return nil, err
+		}
+	}
+	err = settleEscrow(ctx, q, r.marketID, r.req.MarketId, resolveTime)
+	if err != nil {
+		return nil, err
+	}
+	err = logMarketEvent(ctx, q, eventResolveMarket, r.req.MarketId, r.req, nil, resolveTime)
+	if err != nil {
+		return nil, err
+	}
+	return voidMarkets(ctx, q, toVoid, resolveTime)
+}
+
+// marketsToVoid returns the unresolved markets that resolving a market with
+// the given payouts voids: those conditional on one of its securities that
+// doesn't pay out, and every market conditional on those, in turn. Voiding a
+// market pays nothing out, so with no payouts it returns every market that
+// voiding it voids.
+func marketsToVoid(ctx context.Context, q querier, marketID int64,
+	payouts map[string]float64) ([]int64, error) {
+
+	dependents, err := conditionalMarkets(ctx, q, marketID)
+	if err != nil {
+		return nil, err
+	}
+	toVoid := []int64{}
+	for _, d := range dependents {
+		if payouts[d.conditionUUID] > 0 {
+			continue
+		}
+		toVoid = append(toVoid, d.marketID)
+	}
+	for idx := 0; idx < len(toVoid); idx++ {
+		more, err := conditionalMarkets(ctx, q, toVoid[idx])
+		if err != nil {
+			return nil, err
+		}
+		for _, d := range more {
+			toVoid = append(toVoid, d.marketID)
+		}
+	}
+	return toVoid, nil
+}
+
+// voidMarkets voids each of the markets, and returns their uuids.
+func voidMarkets(ctx context.Context, q execer, marketIDs []int64, voidTime string) ([]string, error) {
+	voided := []string{}
+	for _, id := range marketIDs {
+		uuid, err := voidMarket(ctx, q, id, voidTime)
+		if err != nil {
+			return nil, err
+		}
+		voided = append(voided, uuid)
+	}
+	return voided, nil
+}
+
+// voidMarket closes a market without resolving it, and refunds every trader
+// the net cost of their orders in it (see refundMicros). It returns the
+// market's uuid. The markets conditional on it are left to the caller; see
+// marketsToVoid.
+func voidMarket(ctx context.Context, q execer, marketID int64, voidTime string) (string, error) {
+	var uuid string
+	err := q.QueryRowContext(ctx, `
+		UPDATE markets
+		SET status = $1, date_closed = COALESCE(date_closed, $2), date_resolved = $2,
+			voided = $3
+		WHERE id = $4
+		RETURNING uuid`, pb.Market_VOIDED, voidTime, true, marketID).Scan(&uuid)
+	if err != nil {
+		return "", err
+	}
+
+	type refund struct {
+		userID     int64
+		username   string
+		securityID int64
+		amount     int64
+		tokens     int64
+	}
+	rows, err := q.QueryContext(ctx, `
+		SELECT orders.user_id, users.username, orders.security_id,
+			COALESCE(MAX(portfolio_securities.amount), 0), SUM(orders.cost)
+		FROM orders
+		JOIN users ON orders.user_id = users.id
+		JOIN securities ON orders.security_id = securities.id
+		LEFT JOIN portfolio_securities
+		ON portfolio_securities.user_id = orders.user_id
+			AND portfolio_securities.security_id = orders.security_id
+		WHERE securities.market_id = $1
+		GROUP BY orders.user_id, users.username, orders.security_id
+		ORDER BY orders.user_id, orders.security_id`, marketID)
+	if err != nil {
+		return "", err
+	}
+	refunds := []refund{}
+	defer rows.Close()
+	for rows.Next() {
+		var r refund
+		err = rows.Scan(&r.userID, &r.username, &r.securityID, &r.amount, &r.tokens)
+		if err != nil {
+			return "", err
+		}
+		r.tokens = refundMicros(r.tokens)
+		refunds = append(refunds, r)
+	}
+	if err = rows.Err(); err != nil {
+		return "", err
+	}
+	rows.Close()
+
+	for _, r := range refunds {
+		_, err = q.ExecContext(ctx, `
+			UPDATE portfolios SET tokens = tokens + $1 WHERE user_id = $2`, r.tokens, r.userID)
+		if err != nil {
+			return "", err
+		}
+		_, err = q.ExecContext(ctx, `
+			INSERT INTO payouts(user_id, security_id, amount, payout, date)
+			VALUES($1, $2, $3, $4, $5)`, r.userID, r.securityID, r.amount, r.tokens, voidTime)
+		if err != nil {
+			return "", err
+		}
+		err = recordEntry(ctx, q, pb.LedgerEntryKind_REFUND, escrowAccount(uuid),
+			userAccount(r.username), r.tokens, marketID, nil, voidTime)
+		if err != nil {
+			return "", err
+		}
+	}
+	return uuid, settleEscrow(ctx, q, marketID, uuid, voidTime)
+}
@@ -0,0 +1,463 @@
+package marketapi
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/lithammer/shortuuid"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	pb "github.com/domino14/scrabfutures/rpc/proto"
+)
+
+// ReplayDiff is a value that came out differently when the event log was
+// replayed. Missing rows are reported with an empty Column.
+type ReplayDiff struct {
+	Table    string
+	Key      string
+	Column   string
+	Live     string
+	Replayed string
+}
+
+type loggedEvent struct {
+	id          int64
+	kind        string
+	marketID    string
+	payload     string
+	ids         []string
+	lastOrderID int64
+}
+
+type loggedOrder struct {
+	id         int64
+	uuid       string
+	username   string
+	securityID string
+	marketID   string
+	amount     int64
+	cost       int64
+	key        string
+}
+
+// Replay rebuilds the store's state in fresh, which should be newly migrated
+// and empty, by replaying the market event log and the orders in the order
+// they happened. It returns everything that came out differently: the
+// securities' shares outstanding and last prices, each user's tokens and
+// holdings, and what each order cost.
+//
+// Every user starts off with all the tokens they were ever granted, which can
+// only let through orders that were let through anyway. Markets created
+// before the event log began can't be replayed, so they're reported and left
+// out.
+func (s *SqliteStore) Replay(ctx context.Context, fresh *SqliteStore) ([]*ReplayDiff, error) {
+	if err := s.replayUsers(ctx, fresh); err != nil {
+		return nil, err
+	}
+
+	logged := map[string]bool{}
+	evts, err := s.loggedEvents(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range evts {
+		if e.kind == eventCreateMarket {
+			logged[e.marketID] = true
+		}
+	}
+	diffs := []*ReplayDiff{}
+	rows, err := s.db.QueryContext(ctx, `SELECT uuid FROM markets ORDER BY id`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var uuid string
+		if err := rows.Scan(&uuid); err != nil {
+			return nil, err
+		}
+		if !logged[uuid] {
+			diffs = append(diffs, &ReplayDiff{Table: "markets", Key: uuid,
+				Live: "exists", Replayed: "not in the event log"})
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	orders, err := s.loggedOrders(ctx, logged)
+	if err != nil {
+		return nil, err
+	}
+	next := 0
+	replayOrders := func(lastOrderID int64) error {
+		for ; next < len(orders) && orders[next].id <= lastOrderID; next++ {
+			o := orders[next]
+			amount := o.amount
+			if amount < 0 {
+				amount = -amount
+			}
+			fresh.newID = replayIDs([]string{o.uuid})
+			cost, err := fresh.FulfillOrder(ctx, o.username, o.securityID, o.marketID,
+				amount, o.amount > 0, o.key)
+			if err != nil {
+				return fmt.Errorf("replaying order %s: %w", o.uuid, err)
+			}
+			if cost != o.cost {
+				diffs = append(diffs, &ReplayDiff{Table: "orders", Key: o.uuid, Column: "cost",
+					Live: strconv.FormatInt(o.cost, 10), Replayed: strconv.FormatInt(cost, 10)})
+			}
+		}
+		return nil
+	}
+	for _, e := range evts {
+		if err := replayOrders(e.lastOrderID); err != nil {
+			return nil, err
+		}
+		if !logged[e.marketID] {
+			continue
+		}
+		fresh.newID = replayIDs(e.ids)
+		if err := replayEvent(ctx, fresh, e); err != nil {
+			return nil, fmt.Errorf("replaying event %d (%s): %w", e.id, e.kind, err)
+		}
+	}
+	if err := replayOrders(math.MaxInt64); err != nil {
+		return nil, err
+	}
+	fresh.newID = shortuuid.New
+
+	live, err := loadReplayState(ctx, s.db, logged)
+	if err != nil {
+		return nil, err
+	}
+	replayed, err := loadReplayState(ctx, fresh.db, nil)
+	if err != nil {
+		return nil, err
+	}
+	return append(diffs, live.diff(replayed)...), nil
+}
+
+// replayUsers copies the users into fresh, with no tokens.
+func (s *SqliteStore) replayUsers(ctx context.Context, fresh *SqliteStore) error {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT id, username, email, password FROM users ORDER BY id`)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var id int64
+		var username string
+		var email, password sql.NullString
+		if err := rows.Scan(&id, &username, &email, &password); err != nil {
+			return err
+		}
+		_, err = fresh.db.ExecContext(ctx, `
+			INSERT INTO users(id, username, email, password) VALUES (?, ?, ?, ?)`,
+			id, username, email, password)
+		if err != nil {
+			return err
+		}
+		_, err = fresh.db.ExecContext(ctx, `
+			INSERT INTO portfolios(user_id, tokens) VALUES (?, 0)`, id)
+		if err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	grants, err := s.db.QueryContext(ctx, `
+		SELECT to_account, amount FROM ledger WHERE kind = 'grant' ORDER BY id`)
+	if err != nil {
+		return err
+	}
+	defer grants.Close()
+	for grants.Next() {
+		var account string
+		var amount int64
+		if err := grants.Scan(&account, &amount); err != nil {
+			return err
+		}
+		err = fresh.GrantTokens(ctx, strings.TrimPrefix(account, "user:"), amount)
+		if err != nil {
+			return err
+		}
+	}
+	return grants.Err()
+}
+
+func (s *SqliteStore) loggedEvents(ctx context.Context) ([]*loggedEvent, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT id, kind, market_id, payload, ids, last_order_id
+		FROM market_events
+		ORDER BY id`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	evts := []*loggedEvent{}
+	for rows.Next() {
+		e := &loggedEvent{}
+		var ids string
+		err := rows.Scan(&e.id, &e.kind, &e.marketID, &e.payload, &ids, &e.lastOrderID)
+		if err != nil {
+			return nil, err
+		}
+		if ids != "" {
+			e.ids = strings.Split(ids, ",")
+		}
+		evts = append(evts, e)
+	}
+	return evts, rows.Err()
+}
+
+// loggedOrders returns the orders in the logged markets, oldest first.
+func (s *SqliteStore) loggedOrders(ctx context.Context, logged map[string]bool) ([]*loggedOrder, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT orders.id, orders.uuid, users.username, securities.uuid, markets.uuid,
+			orders.amount, orders.cost, COALESCE(orders.idempotency_key, '')
+		FROM orders
+		JOIN users ON orders.user_id = users.id
+		JOIN securities ON orders.security_id = securities.id
+		JOIN markets ON securities.market_id = markets.id
+		ORDER BY orders.id`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	orders := []*loggedOrder{}
+	for rows.Next() {
+		o := &loggedOrder{}
+		err := rows.Scan(&o.id, &o.uuid, &o.username, &o.securityID, &o.marketID,
+			&o.amount, &o.cost, &o.key)
+		if err != nil {
+			return nil, err
+		}
+		if logged[o.marketID] {
+			orders = append(orders, o)
+		}
+	}
+	return orders, rows.Err()
+}
+
+// replayIDs returns a newID that hands out ids, in order, and then makes up
+// new ones.
+func replayIDs(ids []string) func() string {
+	return func() string {
+		if len(ids) == 0 {
+			return shortuuid.New()
+		}
+		id := ids[0]
+		ids = ids[1:]
+		return id
+	}
+}
+
+func replayEvent(ctx context.Context, s Store, e *loggedEvent) error {
+	switch e.kind {
+	case eventCreateMarket:
+		req := &pb.CreateMarketRequest{}
+		if err := protojson.Unmarshal([]byte(e.payload), req); err != nil {
+			return err
+		}
+		id, err := s.CreateMarket(ctx, req)
+		if err != nil {
+			return err
+		}
+		if id != e.marketID {
+			return fmt.Errorf("created market %s instead of %s", id, e.marketID)
+		}
+		return nil
+	case eventAddSecurities:
+		req := &pb.AddSecuritiesRequest{}
+		if err := protojson.Unmarshal([]byte(e.payload), req); err != nil {
+			return err
+		}
+		return s.AddSecurities(ctx, req.MarketId, req.Securities)
+	case eventDeleteSecurity:
+		req := &pb.DeleteSecurityRequest{}
+		if err := protojson.Unmarshal([]byte(e.payload), req); err != nil {
+			return err
+		}
+		return s.DeleteSecurity(ctx, req.MarketId, req.Id)
+	case eventOpenMarket:
+		return s.OpenMarket(ctx, e.marketID)
+	case eventCloseMarket:
+		return s.CloseMarket(ctx, e.marketID)
+	case eventHaltMarket:
+		return s.HaltMarket(ctx, e.marketID)
+	case eventVoidMarket:
+		return s.VoidMarket(ctx, e.marketID)
+	case eventScheduleMarket:
+		req := &pb.ScheduleMarketRequest{}
+		if err := protojson.Unmarshal([]byte(e.payload), req); err != nil {
+			return err
+		}
+		return s.ScheduleMarket(ctx, req)
+	case eventDeleteMarket:
+		return s.DeleteMarket(ctx, e.marketID)
+	case eventResolveMarket:
+		req := &pb.ResolveMarketRequest{}
+		if err := protojson.Unmarshal([]byte(e.payload), req); err != nil {
+			return err
+		}
+		return s.ResolveMarket(ctx, req)
+	}
+	return fmt.Errorf("unknown event kind %q", e.kind)
+}
+
+type replaySecurity struct {
+	shares    int64
+	lastPrice float64
+}
+
+// replayState is what a replay rebuilds. holdings are keyed by username and
+// security uuid, separated by a slash.
+type replayState struct {
+	securities map[string]replaySecurity
+	tokens     map[string]int64
+	holdings   map[string]int64
+}
+
+// loadReplayState loads the state of the markets in logged, or of every
+// market if logged is nil.
+func loadReplayState(ctx context.Context, db *sql.DB, logged map[string]bool) (*replayState, error) {
+	st := &replayState{
+		securities: map[string]replaySecurity{},
+		tokens:     map[string]int64{},
+		holdings:   map[string]int64{},
+	}
+	inLog := func(marketID string) bool {
+		return logged == nil || logged[marketID]
+	}
+	rows, err := db.QueryContext(ctx, `
+		SELECT securities.uuid, markets.uuid, shares_outstanding, last_price
+		FROM securities
+		JOIN markets ON securities.market_id = markets.id`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var uuid, marketID string
+		var sec replaySecurity
+		if err := rows.Scan(&uuid, &marketID, &sec.shares, &sec.lastPrice); err != nil {
+			return nil, err
+		}
+		if inLog(marketID) {
+			st.securities[uuid] = sec
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	rows, err = db.QueryContext(ctx, `
+		SELECT users.username, portfolios.tokens
+		FROM portfolios
+		JOIN users ON portfolios.user_id = users.id`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var username string
+		var tokens int64
+		if err := rows.Scan(&username, &tokens); err != nil {
+			return nil, err
+		}
+		st.tokens[username] = tokens
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	rows, err = db.QueryContext(ctx, `
+		SELECT users.username, securities.uuid, markets.uuid, portfolio_securities.amount
+		FROM portfolio_securities
+		JOIN users ON portfolio_securities.user_id = users.id
+		JOIN securities ON portfolio_securities.security_id = securities.id
+		JOIN markets ON securities.market_id = markets.id
+		WHERE portfolio_securities.amount != 0`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var username, securityID, marketID string
+		var amount int64
+		if err := rows.Scan(&username, &securityID, &marketID, &amount); err != nil {
+			return nil, err
+		}
+		if inLog(marketID) {
+			st.holdings[username+"/"+securityID] = amount
+		}
+	}
+	return st, rows.Err()
+}
+
+// diff returns where replayed differs from st, sorted by table and key.
+func (st *replayState) diff(replayed *replayState) []*ReplayDiff {
+	diffs := []*ReplayDiff{}
+	for _, uuid := range sortedKeys(st.securities, replayed.securities) {
+		live, inLive := st.securities[uuid]
+		re, inReplayed := replayed.securities[uuid]
+		switch {
+		case !inLive:
+			diffs = append(diffs, &ReplayDiff{Table: "securities", Key: uuid,
+				Live: "missing", Replayed: "exists"})
+		case !inReplayed:
+			diffs = append(diffs, &ReplayDiff{Table: "securities", Key: uuid,
+				Live: "exists", Replayed: "missing"})
+		default:
+			if live.shares != re.shares {
+				diffs = append(diffs, &ReplayDiff{Table: "securities", Key: uuid,
+					Column: "shares_outstanding", Live: strconv.FormatInt(live.shares, 10),
+					Replayed: strconv.FormatInt(re.shares, 10)})
+			}
+			if live.lastPrice != re.lastPrice {
+				diffs = append(diffs, &ReplayDiff{Table: "securities", Key: uuid,
+					Column:   "last_price",
+					Live:     strconv.FormatFloat(live.lastPrice, 'f', -1, 64),
+					Replayed: strconv.FormatFloat(re.lastPrice, 'f', -1, 64)})
+			}
+		}
+	}
+	for _, username := range sortedKeys(st.tokens, replayed.tokens) {
+		if st.tokens[username] != replayed.tokens[username] {
+			diffs = append(diffs, &ReplayDiff{Table: "portfolios", Key: username,
+				Column: "tokens", Live: strconv.FormatInt(st.tokens[username], 10),
+				Replayed: strconv.FormatInt(replayed.tokens[username], 10)})
+		}
+	}
+	for _, key := range sortedKeys(st.holdings, replayed.holdings) {
+		if st.holdings[key] != replayed.holdings[key] {
+			diffs = append(diffs, &ReplayDiff{Table: "portfolio_securities", Key: key,
+				Column: "amount", Live: strconv.FormatInt(st.holdings[key], 10),
+				Replayed: strconv.FormatInt(replayed.holdings[key], 10)})
+		}
+	}
+	return diffs
+}
+
+func sortedKeys[V any](a, b map[string]V) []string {
+	keys := []string{}
+	for k := range a {
+		keys = append(keys, k)
+	}
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
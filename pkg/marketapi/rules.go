@@ -0,0 +1,463 @@
+package marketapi
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/domino14/scrabfutures/pkg/lmsr"
+	"github.com/domino14/scrabfutures/pkg/rating"
+	pb "github.com/domino14/scrabfutures/rpc/proto"
+)
+
+// The rules in this file are shared by every Store, so that they all agree
+// on what is allowed.
+
+// newMarketSecurities validates a request to create a market and returns the
+// securities the market is created with.
+func newMarketSecurities(req *pb.CreateMarketRequest) ([]*pb.AddSecuritiesRequest_Security, error) {
+	var securities []*pb.AddSecuritiesRequest_Security
+	switch req.MarketType {
+	case pb.MarketType_BINARY:
+		securities = binarySecurities
+	case pb.MarketType_SCALAR:
+		if req.UpperBound <= req.LowerBound {
+			return nil, errors.New("upper bound must be greater than lower bound")
+		}
+		securities = scalarSecurities
+	case pb.MarketType_RANKING:
+		if len(req.Players) < 2 || len(req.Players) > lmsr.MaxRankingPlayers {
+			return nil, fmt.Errorf("ranking markets must have between 2 and %d players",
+				lmsr.MaxRankingPlayers)
+		}
+		seen := map[string]bool{}
+		for _, p := range req.Players {
+			if p == "" || seen[p] {
+				return nil, fmt.Errorf("invalid or duplicate player: %q", p)
+			}
+			seen[p] = true
+		}
+	}
+	if req.MarketType != pb.MarketType_RANKING && len(req.Players) > 0 {
+		return nil, errors.New("only ranking markets have players")
+	}
+	return securities, nil
+}
+
+// validateCondition checks that a new market can be conditional on one of
+// parent's securities.
+func validateCondition(parent *pb.Market) error {
+	if parent.DateResolved != "" {
+		return errors.New("cannot condition on a market that has already been resolved")
+	}
+	if parent.MarketType == pb.MarketType_SCALAR {
+		return errors.New("cannot condition on a scalar market")
+	}
+	return nil
+}
+
+// validateNewSecurities checks that securities can be added to a market that
+// already has existing securities, and returns the micro-shares they should
+// be seeded with, if any.
+func validateNewSecurities(m *pb.Market, existing int,
+	securities []*pb.AddSecuritiesRequest_Security) ([]int64, error) {
+
+	if !inSetup(m) {
+		return nil, errors.New("disallowed adding of securities to market that was once open")
+	}
+	if m.MarketType != pb.MarketType_EXCLUSIVE && m.MarketType != pb.MarketType_RANKING {
+		return nil, fmt.Errorf("%s markets cannot have securities added", marketTypeName(m))
+	}
+	for _, sec := range securities {
+		if err := validatePredicate(m, sec); err != nil {
+			return nil, err
+		}
+	}
+	seeds, err := seedShares(securities)
+	if err != nil {
+		return nil, err
+	}
+	if seeds != nil && (existing > 0 || m.MarketType != pb.MarketType_EXCLUSIVE) {
+		return nil, errors.New("opening prices can only be seeded for the first securities in an exclusive market")
+	}
+	return seeds, nil
+}
+
+// validatePredicate checks that a security being added to a market has a
+// valid predicate if, and only if, it is a ranking market.
+func validatePredicate(m *pb.Market, sec *pb.AddSecuritiesRequest_Security) error {
+	if m.MarketType != pb.MarketType_RANKING {
+		if sec.Player != "" || len(sec.Positions) > 0 {
+			return errors.New("only securities in ranking markets have predicates")
+		}
+		return nil
+	}
+	found := false
+	for _, p := range m.Players {
+		if p == sec.Player {
+			found = true
+		}
+	}
+	if !found {
+		return fmt.Errorf("player %q is not in this market", sec.Player)
+	}
+	if len(sec.Positions) == 0 {
+		return errors.New("predicates must have at least one position")
+	}
+	seen := map[int32]bool{}
+	for _, pos := range sec.Positions {
+		if pos < 1 || int(pos) > len(m.Players) || seen[pos] {
+			return fmt.Errorf("invalid or duplicate position: %d", pos)
+		}
+		seen[pos] = true
+	}
+	return nil
+}
+
+// seedShares works out how many micro-shares each of the given securities
+// should start off with, so that their opening prices match their initial
+// probabilities or ratings. It returns nil if they don't have any.
+func seedShares(securities []*pb.AddSecuritiesRequest_Security) ([]int64, error) {
+	probs := make([]float64, len(securities))
+	ratings := make([]float64, len(securities))
+	withProbs, withRatings := 0, 0
+	for idx, sec := range securities {
+		if sec.InitialProbability != 0 {
+			withProbs++
+		}
+		if sec.Rating != 0 {
+			withRatings++
+		}
+		probs[idx] = sec.InitialProbability
+		ratings[idx] = sec.Rating
+	}
+
+	switch {
+	case withProbs == 0 && withRatings == 0:
+		return nil, nil
+	case withProbs > 0 && withRatings > 0:
+		return nil, errors.New("securities must have initial probabilities or ratings, not both")
+	case withRatings == len(securities):
+		probs = rating.FieldProbabilities(ratings)
+	case withProbs == len(securities):
+		sum := float64(0)
+		for _, p := range probs {
+			if p <= 0 || p >= 1 {
+				return nil, fmt.Errorf("invalid initial probability: %v", p)
+			}
+			sum += p
+		}
+		if math.Abs(sum-1) > 1e-6 {
+			return nil, errors.New("initial probabilities must add up to 1")
+		}
+	default:
+		return nil, errors.New("either all securities or none of them must have initial probabilities or ratings")
+	}
+	shares := lmsr.SharesForProbabilities(lmsr.Liquidity, probs)
+	seeds := make([]int64, len(shares))
+	for idx, sh := range shares {
+		seeds[idx] = lmsr.ToMicros(sh)
+	}
+	return seeds, nil
+}
+
+func checkDeleteMarket(m *pb.Market) error {
+	if !inSetup(m) {
+		// if this market was ever opened, then we cannot delete it.
+		return errors.New("disallowed deletion of market that was once open")
+	}
+	return nil
+}
+
+func checkDeleteSecurity(m *pb.Market) error {
+	if !inSetup(m) {
+		return errors.New("disallowed deletion of securities from market that was once open")
+	}
+	if m.MarketType != pb.MarketType_EXCLUSIVE && m.MarketType != pb.MarketType_RANKING {
+		return fmt.Errorf("%s markets cannot have securities deleted", marketTypeName(m))
+	}
+	return nil
+}
+
+// securityPayouts works out how many tokens one share of each of the market's
+// securities pays out, keyed by security UUID.
+func securityPayouts(m *pb.Market, securities []*pb.Security,
+	req *pb.ResolveMarketRequest) (map[string]float64, error) {
+
+	payouts := map[string]float64{}
+	if m.MarketType == pb.MarketType_RANKING {
+		if len(req.Resolutions) > 0 {
+			return nil, errors.New("ranking markets are resolved with standings")
+		}
+		finish := map[string]int32{}
+		for idx, p := range req.Standings {
+			finish[p] = int32(idx) + 1
+		}
+		if len(finish) != len(m.Players) || len(req.Standings) != len(m.Players) {
+			return nil, errors.New("standings must list every player exactly once")
+		}
+		for _, p := range m.Players {
+			if _, ok := finish[p]; !ok {
+				return nil, fmt.Errorf("player %s is missing from the standings", p)
+			}
+		}
+		for _, sec := range securities {
+			payouts[sec.Id] = 0
+			for _, pos := range sec.Positions {
+				if finish[sec.Player] == pos {
+					payouts[sec.Id] = lmsr.MaxPayout
+				}
+			}
+		}
+		return payouts, nil
+	}
+	if m.MarketType == pb.MarketType_SCALAR {
+		if len(req.Resolutions) > 0 {
+			return nil, errors.New("scalar markets are resolved with a value")
+		}
+		if math.IsNaN(req.Value) || math.IsInf(req.Value, 0) {
+			return nil, errors.New("scalar markets must resolve to a finite value")
+		}
+		long, short := lmsr.ScalarPayouts(m.LowerBound, m.UpperBound, req.Value)
+		for _, sec := range securities {
+			switch sec.Shortname {
+			case "LONG":
+				payouts[sec.Id] = long
+			case "SHORT":
+				payouts[sec.Id] = short
+			}
+		}
+		return payouts, nil
+	}
+
+	for _, sec := range securities {
+		payouts[sec.Id] = 0
+	}
+	winners := map[string]bool{}
+	for _, r := range req.Resolutions {
+		if _, ok := payouts[r.SecurityId]; !ok {
+			return nil, fmt.Errorf("security %s is not in this market", r.SecurityId)
+		}
+		if r.Wins {
+			winners[r.SecurityId] = true
+		}
+	}
+
+	// The securities are mutually exclusive, so paying out on more than one
+	// of them could cost the house more than the LMSR's loss bound.
+	switch {
+	case len(winners) == 1:
+	case m.MarketType == pb.MarketType_BINARY:
+		return nil, errors.New("exactly one of YES or NO must win")
+	default:
+		return nil, errors.New("exactly one security must win")
+	}
+	for uuid := range winners {
+		payouts[uuid] = lmsr.MaxPayout
+	}
+	return payouts, nil
+}
+
+// checkResolve checks that a market can be resolved. parent is the market it
+// is conditional on, or nil.
+func checkResolve(m *pb.Market, parent *pb.Market) error {
+	if m.Status == pb.Market_RESOLVED || m.Status == pb.Market_VOIDED {
+		return errors.New("this market has already been resolved")
+	}
+	if inSetup(m) {
+		return errors.New("cannot resolve a market that was never opened")
+	}
+	if parent != nil && parent.DateResolved == "" {
+		return errors.New("cannot resolve a conditional market before the market it is conditional on")
+	}
+	return nil
+}
+
+// checkTrade checks that a user with heldTokens micro-tokens and
+// heldSecurities micro-shares can trade amount micro-shares (negative to
+// sell) for cost micro-tokens. A small enough sale can round to no tokens at
+// all, so it goes by the sign of the amount rather than the cost.
+func checkTrade(cost, amount, heldTokens, heldSecurities int64) error {
+	if amount > 0 {
+		if cost < 0 {
+			return errors.New("unexpected cost - negative")
+		}
+		if heldTokens < cost {
+			return errors.New("not enough tokens for this transaction")
+		}
+	} else if amount < 0 {
+		if cost > 0 {
+			return errors.New("unexpected cost - positive")
+		}
+		if heldSecurities < -amount {
+			return errors.New("cannot sell more securities than we own")
+		}
+	}
+	return nil
+}
+
+// checkRetry checks that an order sent again with an earlier order's
+// idempotency key is for the same trade. amount is negative to sell.
+func checkRetry(earlier *pb.Order, securityUUID string, amount int64) error {
+	if earlier.SecurityId != securityUUID || earlier.AmountMicros != amount {
+		return errors.New("idempotency key was already used for a different order")
+	}
+	return nil
+}
+
+// newPricer returns the pricer for a market's securities. predicates are only
+// used by ranking markets.
+func newPricer(marketType pb.MarketType, players int, predicates []lmsr.Predicate) lmsr.Pricer {
+	if marketType == pb.MarketType_RANKING {
+		return lmsr.Ranking{B: lmsr.Liquidity, Players: players, Predicates: predicates}
+	}
+	return lmsr.Exclusive{B: lmsr.Liquidity}
+}
+
+// costBasis is what the user paid for the shares of a security they still
+// hold, given their orders for it, oldest first. Sales take shares out at the
+// average cost of the shares held at the time, so they don't change the
+// average cost of the rest.
+func costBasis(orders []*pb.Order) float64 {
+	var held int64
+	var basis float64
+	for _, o := range orders {
+		if o.AmountMicros > 0 {
+			basis += lmsr.FromMicros(o.CostMicros)
+		} else if held > 0 {
+			basis *= float64(held+o.AmountMicros) / float64(held)
+		}
+		held += o.AmountMicros
+	}
+	return basis
+}
+
+// valuePosition fills in the valuation of a position with the given cost
+// basis. The security is at index secIdx of the market's shares.
+func valuePosition(position *pb.Position, basis float64, pricer lmsr.Pricer,
+	allShares []float64, secIdx int) {
+
+	amount := lmsr.FromMicros(position.AmountMicros)
+	position.AverageCost = basis / amount
+	position.MarkValue = amount * position.Security.LastPrice
+	position.UnrealizedPnl = position.MarkValue - basis
+	// TradeCost adds the shares it trades, so price the sale on a copy. It's
+	// rounded the same way a real sale would be.
+	shares := append([]float64{}, allShares...)
+	position.LiquidationValue = -lmsr.FromMicros(
+		lmsr.TradeCostMicros(pricer, -position.AmountMicros, shares, secIdx))
+}
+
+// inDateRange reports whether date is between the optional RFC3339 bounds,
+// inclusive.
+func inDateRange(date, beginDate, endDate string) bool {
+	return (beginDate == "" || date >= beginDate) && (endDate == "" || date <= endDate)
+}
+
+// marketTransitions are the statuses that a market in each status can move
+// to. Resolved and voided markets are done.
+var marketTransitions = map[pb.Market_Status][]pb.Market_Status{
+	pb.Market_DRAFT:     {pb.Market_SCHEDULED, pb.Market_OPEN, pb.Market_VOIDED},
+	pb.Market_SCHEDULED: {pb.Market_DRAFT, pb.Market_OPEN, pb.Market_VOIDED},
+	pb.Market_OPEN:      {pb.Market_HALTED, pb.Market_CLOSED, pb.Market_RESOLVED, pb.Market_VOIDED},
+	pb.Market_HALTED:    {pb.Market_OPEN, pb.Market_CLOSED, pb.Market_RESOLVED, pb.Market_VOIDED},
+	pb.Market_CLOSED:    {pb.Market_OPEN, pb.Market_RESOLVED, pb.Market_VOIDED},
+}
+
+func statusName(status pb.Market_Status) string {
+	return strings.ToLower(status.String())
+}
+
+// checkTransition checks that a market can move from one status to another.
+func checkTransition(from, to pb.Market_Status) error {
+	for _, st := range marketTransitions[from] {
+		if st == to {
+			return nil
+		}
+	}
+	return fmt.Errorf("a %s market cannot be made %s", statusName(from), statusName(to))
+}
+
+// inSetup reports whether a market hasn't opened yet, so that its securities
+// can still be changed and it can still be deleted.
+func inSetup(m *pb.Market) bool {
+	return m.Status == pb.Market_DRAFT || m.Status == pb.Market_SCHEDULED
+}
+
+// checkTrading checks that a market in the given status can be traded in.
+func checkTrading(status pb.Market_Status) error {
+	switch status {
+	case pb.Market_OPEN:
+		return nil
+	case pb.Market_HALTED:
+		return errors.New("trading in this market is halted")
+	}
+	return errors.New("this market is closed")
+}
+
+// checkSchedule checks that a market can be given the schedule in req, and
+// returns the status it should then be in.
+func checkSchedule(m *pb.Market, req *pb.ScheduleMarketRequest) (pb.Market_Status, error) {
+	var openAt, closeAt time.Time
+	var err error
+	if req.OpenAt != "" {
+		if openAt, err = time.Parse(time.RFC3339, req.OpenAt); err != nil {
+			return 0, errors.New("open_at must be an RFC3339 date")
+		}
+	}
+	if req.CloseAt != "" {
+		if closeAt, err = time.Parse(time.RFC3339, req.CloseAt); err != nil {
+			return 0, errors.New("close_at must be an RFC3339 date")
+		}
+	}
+	if req.OpenAt != "" && req.CloseAt != "" && !openAt.Before(closeAt) {
+		return 0, errors.New("a market must open before it closes")
+	}
+	switch m.Status {
+	case pb.Market_DRAFT, pb.Market_SCHEDULED:
+		if req.OpenAt == "" {
+			return pb.Market_DRAFT, nil
+		}
+		return pb.Market_SCHEDULED, nil
+	case pb.Market_OPEN, pb.Market_HALTED:
+		if req.OpenAt != "" {
+			return 0, errors.New("this market has already opened")
+		}
+		return m.Status, nil
+	}
+	return 0, fmt.Errorf("a %s market cannot be scheduled", statusName(m.Status))
+}
+
+// refundMicros is what a trader is refunded for a security in a voided
+// market whose orders cost them netCost micro-tokens in all. Traders who paid
+// for their shares get it back. A trader who sold for more than they paid
+// keeps the profit, and the house covers it: a refund never takes tokens
+// away, since that could leave the trader with fewer than none.
+func refundMicros(netCost int64) int64 {
+	if netCost < 0 {
+		return 0
+	}
+	return netCost
+}
+
+// due reports whether an optional RFC3339 date has come by now.
+func due(date string, now time.Time) bool {
+	t, err := time.Parse(time.RFC3339, date)
+	return err == nil && !t.After(now)
+}
+
+// hasStatus reports whether a market has one of the statuses. No statuses
+// means any status.
+func hasStatus(m *pb.Market, statuses []pb.Market_Status) bool {
+	if len(statuses) == 0 {
+		return true
+	}
+	for _, st := range statuses {
+		if st == m.Status {
+			return true
+		}
+	}
+	return false
+}
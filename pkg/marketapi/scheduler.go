@@ -0,0 +1,81 @@
+package marketapi
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	pb "github.com/domino14/scrabfutures/rpc/proto"
+)
+
+// DefaultSchedulerInterval is how often the server checks for markets to
+// open or close, if it isn't told otherwise.
+const DefaultSchedulerInterval = 10 * time.Second
+
+// A Scheduler opens and closes markets when their schedules say to. Set a
+// matchup market's close_at to when its round starts, say, and trading stops
+// when the round does.
+type Scheduler struct {
+	store    Store
+	interval time.Duration
+}
+
+func NewScheduler(store Store, interval time.Duration) *Scheduler {
+	return &Scheduler{store: store, interval: interval}
+}
+
+// Run checks the schedule straight away and then every interval, until ctx
+// is done.
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		if err := s.Tick(ctx, time.Now()); err != nil {
+			log.Err(err).Msg("scheduler-tick")
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Tick opens the scheduled markets whose open_at has come by now, and closes
+// the open and halted markets whose close_at has. A market whose open_at and
+// close_at have both passed is opened and closed again. Tick carries on past
+// markets it can't open or close, and returns the first error.
+func (s *Scheduler) Tick(ctx context.Context, now time.Time) error {
+	markets, err := s.store.GetMarkets(ctx, pb.Market_SCHEDULED, pb.Market_OPEN, pb.Market_HALTED)
+	if err != nil {
+		return err
+	}
+	var firstErr error
+	fail := func(err error, m *pb.Market, action string) {
+		log.Err(err).Str("marketID", m.Id).Str("action", action).Msg("scheduler-failed")
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+	for _, m := range markets {
+		if m.Status == pb.Market_SCHEDULED {
+			if !due(m.OpenAt, now) {
+				continue
+			}
+			if err := s.store.OpenMarket(ctx, m.Id); err != nil {
+				fail(err, m, "open")
+				continue
+			}
+			log.Info().Str("marketID", m.Id).Msg("scheduler-opened")
+		}
+		if due(m.CloseAt, now) {
+			if err := s.store.CloseMarket(ctx, m.Id); err != nil {
+				fail(err, m, "close")
+				continue
+			}
+			log.Info().Str("marketID", m.Id).Msg("scheduler-closed")
+		}
+	}
+	return firstErr
+}
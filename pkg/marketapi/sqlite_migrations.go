@@ -12,6 +12,10 @@ import (
 type Config struct {
 	DBMigrationsPath string
 	DBPath           string
+	// DatabaseURL is the Postgres database to use instead of the SQLite one
+	// at DBPath, if it is set. DBMigrationsPath should then be the postgres
+	// migrations.
+	DatabaseURL string
 }
 
 func EnsureMigrations(cfg *Config) {
@@ -4,7 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
-	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -12,12 +12,31 @@ import (
 	_ "github.com/mattn/go-sqlite3"
 	"github.com/rs/zerolog/log"
 
+	"github.com/domino14/scrabfutures/pkg/events"
 	"github.com/domino14/scrabfutures/pkg/lmsr"
 	pb "github.com/domino14/scrabfutures/rpc/proto"
 )
 
+// binarySecurities are the securities that every binary market is created
+// with.
+var binarySecurities = []*pb.AddSecuritiesRequest_Security{
+	{Description: "Yes", Shortname: "YES"},
+	{Description: "No", Shortname: "NO"},
+}
+
+// scalarSecurities are the securities that every scalar market is created
+// with.
+var scalarSecurities = []*pb.AddSecuritiesRequest_Security{
+	{Description: "Long", Shortname: "LONG"},
+	{Description: "Short", Shortname: "SHORT"},
+}
+
 type SqliteStore struct {
-	db *sql.DB
+	db     *sql.DB
+	events *events.Hub
+	// newID makes the uuids of new markets, securities and orders. Replay
+	// swaps it out to make the same ones again.
+	newID func() string
 }
 
 func now() string {
@@ -29,521 +48,681 @@ func NewSqliteStore(dbName string) (*SqliteStore, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &SqliteStore{db: db}, nil
+	return &SqliteStore{db: db, events: events.NewHub(), newID: shortuuid.New}, nil
 }
 
-func (s *SqliteStore) dbid(ctx context.Context, tableName, otheridName, otherid string) (int64, error) {
-	var dbid int64
-
-	query := fmt.Sprintf("SELECT id FROM %s WHERE %s = ?", tableName, otheridName)
+// Events returns the hub that the store publishes events to once the
+// changes they describe have been committed.
+func (s *SqliteStore) Events() *events.Hub {
+	return s.events
+}
 
-	err := s.db.QueryRowContext(ctx, query, otherid).Scan(&dbid)
-	if err != nil {
-		return 0, err
-	}
-	return dbid, nil
+func marketTypeName(m *pb.Market) string {
+	return strings.ToLower(m.MarketType.String())
 }
 
-func (s *SqliteStore) GetOrderBook(ctx context.Context, marketID string, securityID string,
-	username string, sinceDate time.Time, limit int) ([]*pb.Order, error) {
+// marketColumns are the columns that scanMarket expects to scan, in order.
+// They must be selected from marketTables.
+const marketColumns = `markets.uuid, markets.description, markets.date_created,
+	markets.status, markets.date_closed, markets.market_type,
+	markets.date_resolved, COALESCE(markets.lower_bound, 0),
+	COALESCE(markets.upper_bound, 0), COALESCE(conditions.uuid, ''),
+	markets.voided, COALESCE(markets.open_at, ''), COALESCE(markets.close_at, '')`
 
-	wheres := []string{}
-	wheresVars := []any{}
+const marketTables = `markets
+	LEFT JOIN securities conditions
+	ON markets.condition_security_id = conditions.id`
 
-	if username != "" {
-		dbid, err := s.dbid(ctx, "users", "username", username)
-		if err != nil {
-			return nil, err
-		}
-		wheres = append(wheres, `user_id = ?`)
-		wheresVars = append(wheresVars, dbid)
-	}
-
-	if marketID != "" {
-		dbid, err := s.dbid(ctx, "markets", "uuid", marketID)
-		if err != nil {
-			return nil, err
-		}
-		wheres = append(wheres, `market_id = ?`)
-		wheresVars = append(wheresVars, dbid)
-	}
-
-	if securityID != "" {
-		dbid, err := s.dbid(ctx, "securities", "uuid", securityID)
-		if err != nil {
-			return nil, err
-		}
-		wheres = append(wheres, `security_id = ?`)
-		wheresVars = append(wheresVars, dbid)
-	}
-
-	wheres = append(wheres, `sinceDate >= ?`)
-	wheresVars = append(wheresVars, sinceDate)
-
-	whereRendered := strings.Join(wheres, " AND ")
-	limitRendered := ""
-	if limit > 0 {
-		limitRendered = fmt.Sprintf("LIMIT %d", limit)
-	}
-	fullQuery := fmt.Sprintf(`
-		SELECT uuid, securities.uuid, securities.shortname,
-		amount, cost, date_created
-		FROM orders
-		JOIN securities
-		ON orders.security_id = securities.id
-		%s
-		%s	
-	`, whereRendered, limitRendered)
-	log.Debug().Str("fullQuery", fullQuery).Str("storeMethod", "GetOrderBook").Msg("executing-query")
-	rows, err := s.db.QueryContext(ctx, fullQuery, wheresVars...)
-	if err != nil {
-		return nil, err
-	}
-	orders := []*pb.Order{}
-	defer rows.Close()
-	for rows.Next() {
-		order := &pb.Order{}
-		err = rows.Scan(&order.Id, &order.SecurityId, &order.SecurityShortname, &order.Amount, &order.Cost, &order.DateCreated)
-		if err != nil {
-			return nil, err
-		}
-		orders = append(orders, order)
-	}
-	return orders, nil
+type scanner interface {
+	Scan(dest ...any) error
 }
 
-func (s *SqliteStore) GetMarket(ctx context.Context, id string) (*pb.Market, error) {
+func scanMarket(row scanner) (*pb.Market, error) {
 	market := &pb.Market{}
-	var dateClosed sql.NullString
-	err := s.db.QueryRowContext(ctx, `
-		SELECT description, date_created, is_open, date_closed
-		FROM markets
-		WHERE uuid = ?`, id).Scan(
-		&market.Description, &market.DateCreated, &market.IsOpen, &dateClosed)
+	var dateClosed, dateResolved sql.NullString
+	err := row.Scan(&market.Id, &market.Description, &market.DateCreated,
+		&market.Status, &dateClosed, &market.MarketType, &dateResolved,
+		&market.LowerBound, &market.UpperBound, &market.ConditionSecurityId,
+		&market.Voided, &market.OpenAt, &market.CloseAt)
 	if err != nil {
 		return nil, err
 	}
-	market.Id = id
+	market.IsOpen = market.Status == pb.Market_OPEN
+	// These can be empty, that's ok.
 	market.DateClosed = dateClosed.String
+	market.DateResolved = dateResolved.String
 	return market, nil
 }
 
-func (s *SqliteStore) GetOpenMarkets(ctx context.Context) ([]*pb.Market, error) {
+func (s *SqliteStore) GetMarket(ctx context.Context, id string) (*pb.Market, error) {
+	return getMarket(ctx, s.db, id)
+}
 
-	rows, err := s.db.QueryContext(ctx, `
-		SELECT uuid, description, date_created, date_closed 
-		FROM markets
-		WHERE is_open = 1`)
+func (s *SqliteStore) GetMarkets(ctx context.Context, statuses ...pb.Market_Status) ([]*pb.Market, error) {
+	return getMarkets(ctx, s.db, statuses)
+}
 
+// CreateMarket creates a new, closed market. Binary and scalar markets are
+// created along with their securities; exclusive markets need to have their
+// securities added with AddSecurities.
+func (s *SqliteStore) CreateMarket(ctx context.Context, req *pb.CreateMarketRequest) (string, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
-		return nil, err
-	}
-
-	markets := []*pb.Market{}
-	defer rows.Close()
-
-	for rows.Next() {
-		market := &pb.Market{}
-		var dateClosed sql.NullString
-		err = rows.Scan(&market.Id, &market.Description,
-			&market.DateCreated, &dateClosed)
-		if err != nil {
-			return nil, err
-		}
-		market.DateClosed = dateClosed.String // can be empty, that's ok.
-		market.IsOpen = true
-		markets = append(markets, market)
+		return "", err
 	}
-	return markets, nil
-}
-
-func (s *SqliteStore) CreateMarket(ctx context.Context, description string) (string, error) {
-	id := shortuuid.New()
-	_, err := s.db.ExecContext(ctx, `
-		INSERT INTO markets(uuid, description, date_created, is_open)
-		values(?, ?, ?, ?)
-	`, id, description, now(), 0)
+	defer tx.Rollback()
+	id, err := createMarket(ctx, tx, req, s.newID)
 	if err != nil {
 		return "", err
 	}
-	return id, nil
+	return id, tx.Commit()
 }
 
 func (s *SqliteStore) OpenMarket(ctx context.Context, uuid string) error {
-	_, err := s.db.ExecContext(ctx, `
-		UPDATE markets SET is_open = 1 WHERE uuid = ?
-	`, uuid)
-	return err
-}
-
-func (s *SqliteStore) CloseMarket(ctx context.Context, uuid string) error {
-	_, err := s.db.ExecContext(ctx, `
-		UPDATE markets SET is_open = 0, date_closed = ? WHERE uuid = ?
-	`, now(), uuid)
-	return err
-}
-
-func (s *SqliteStore) DeleteMarket(ctx context.Context, uuid string) error {
-	m, err := s.GetMarket(ctx, uuid)
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if err := openMarket(ctx, tx, uuid); err != nil {
+		return err
+	}
+	openTime := now()
+	if err := saveOpeningPrices(ctx, tx, uuid, openTime); err != nil {
+		return err
+	}
+	err = logMarketEvent(ctx, tx, eventOpenMarket, uuid, nil, nil, openTime)
 	if err != nil {
 		return err
 	}
-	if m.IsOpen || m.DateClosed != "" {
-		// if this market was ever opened, then we cannot delete it.
-		return errors.New("disallowed deletion of market that was once open")
+	if err := tx.Commit(); err != nil {
+		return err
 	}
-	_, err = s.db.ExecContext(ctx, `DELETE FROM markets WHERE uuid = ?`, uuid)
-	return err
+	s.events.Publish(events.Event{Type: events.MarketOpened, MarketID: uuid, Date: openTime})
+	return nil
 }
 
-// AddSecurities adds one or more securities to a market. Securities cannot
-// be added once a market is opened.
-func (s *SqliteStore) AddSecurities(ctx context.Context, marketID string,
-	securities []*pb.AddSecuritiesRequest_Security) error {
-
-	m, err := s.GetMarket(ctx, marketID)
+func (s *SqliteStore) CloseMarket(ctx context.Context, uuid string) error {
+	closeTime := now()
+	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
 		return err
 	}
-	if m.IsOpen || m.DateClosed != "" {
-		return errors.New("disallowed adding of securities to market that was once open")
+	defer tx.Rollback()
+	if err := closeMarket(ctx, tx, uuid, closeTime); err != nil {
+		return err
 	}
-
-	mdbid, err := s.dbid(ctx, "markets", "uuid", marketID)
+	err = logMarketEvent(ctx, tx, eventCloseMarket, uuid, nil, nil, closeTime)
 	if err != nil {
 		return err
 	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	s.events.Publish(events.Event{Type: events.MarketClosed, MarketID: uuid, Date: closeTime})
+	return nil
+}
 
+func (s *SqliteStore) HaltMarket(ctx context.Context, uuid string) error {
+	haltTime := now()
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
 		return err
 	}
 	defer tx.Rollback()
+	if _, err := setMarketStatus(ctx, tx, uuid, pb.Market_HALTED); err != nil {
+		return err
+	}
+	err = logMarketEvent(ctx, tx, eventHaltMarket, uuid, nil, nil, haltTime)
+	if err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	s.events.Publish(events.Event{Type: events.MarketHalted, MarketID: uuid, Date: haltTime})
+	return nil
+}
 
-	addDate := now()
+func (s *SqliteStore) VoidMarket(ctx context.Context, uuid string) error {
+	conn, err := s.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
 
-	for _, sec := range securities {
-		_, err = tx.ExecContext(ctx, `
-			INSERT INTO securities(uuid, description, shortname, date_created,
-				market_id, shares_outstanding)
-			VALUES (?, ?, ?, ?, ?, ?)
-		`, shortuuid.New(), sec.Description, sec.Shortname, addDate, mdbid, 0.0)
-		if err != nil {
-			return err
-		}
+	_, err = conn.ExecContext(ctx, "BEGIN EXCLUSIVE TRANSACTION;")
+	if err != nil {
+		return err
 	}
+	defer conn.ExecContext(ctx, "ROLLBACK;")
 
-	// Now edit all the prices...
-	err = s.editAllSecurityPrices(ctx, tx, mdbid)
+	marketID, err := lookupID(ctx, conn, "markets", "uuid", uuid)
+	if err != nil {
+		return err
+	}
+	toVoid, err := marketsToVoid(ctx, conn, marketID, nil)
+	if err != nil {
+		return err
+	}
+	voidTime := now()
+	if _, err := setMarketStatus(ctx, conn, uuid, pb.Market_VOIDED); err != nil {
+		return err
+	}
+	voided, err := voidMarkets(ctx, conn, append([]int64{marketID}, toVoid...), voidTime)
+	if err != nil {
+		return err
+	}
+	err = logMarketEvent(ctx, conn, eventVoidMarket, uuid, nil, nil, voidTime)
 	if err != nil {
 		return err
 	}
-	err = tx.Commit()
+	_, err = conn.ExecContext(ctx, "COMMIT;")
 	if err != nil {
 		return err
 	}
+	for _, id := range voided {
+		s.events.Publish(events.Event{Type: events.MarketVoided, MarketID: id, Date: voidTime})
+	}
 	return nil
 }
 
-// DeleteSecurity deletes a security from a market. Securities cannot
-// be deleted once a market is opened.
-func (s *SqliteStore) DeleteSecurity(ctx context.Context, marketID string,
-	securityID string) error {
-
-	m, err := s.GetMarket(ctx, marketID)
+func (s *SqliteStore) ScheduleMarket(ctx context.Context, req *pb.ScheduleMarketRequest) error {
+	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
 		return err
 	}
-	if m.IsOpen || m.DateClosed != "" {
-		return errors.New("disallowed deletion of securities from market that was once open")
+	defer tx.Rollback()
+	if err := scheduleMarket(ctx, tx, req); err != nil {
+		return err
 	}
-
-	mdbid, err := s.dbid(ctx, "markets", "uuid", marketID)
+	err = logMarketEvent(ctx, tx, eventScheduleMarket, req.Id, req, nil, now())
 	if err != nil {
 		return err
 	}
+	return tx.Commit()
+}
 
+func (s *SqliteStore) DeleteMarket(ctx context.Context, uuid string) error {
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
 		return err
 	}
 	defer tx.Rollback()
-
-	_, err = tx.ExecContext(ctx, `DELETE FROM securities WHERE uuid = ?`, securityID)
-	if err != nil {
+	if err := deleteMarket(ctx, tx, uuid); err != nil {
 		return err
 	}
+	return tx.Commit()
+}
+
+// AddSecurities adds one or more securities to a market. Securities cannot
+// be added once a market is opened.
+func (s *SqliteStore) AddSecurities(ctx context.Context, marketID string,
+	securities []*pb.AddSecuritiesRequest_Security) error {
 
-	// Now edit all the prices...
-	err = s.editAllSecurityPrices(ctx, tx, mdbid)
+	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
 		return err
 	}
-	err = tx.Commit()
+	defer tx.Rollback()
+	if err := addSecurities(ctx, tx, marketID, securities, s.newID); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	e, err := securitiesAddedEvent(ctx, s.db, marketID)
 	if err != nil {
 		return err
 	}
+	s.events.Publish(e)
 	return nil
 }
 
-func (s *SqliteStore) editAllSecurityPrices(ctx context.Context, tx *sql.Tx, marketDBID int64) error {
-	rows, err := tx.QueryContext(ctx, `
-		SELECT uuid, shares_outstanding
-		FROM securities
-		WHERE market_id = ? 
-		`, marketDBID)
+// DeleteSecurity deletes a security from a market. Securities cannot
+// be deleted once a market is opened.
+func (s *SqliteStore) DeleteSecurity(ctx context.Context, marketID string,
+	securityID string) error {
+
+	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
 		return err
 	}
-
-	allShares := []float64{}
-	allShareUUIDs := []string{}
-	defer rows.Close()
-	for rows.Next() {
-		var shares float64
-		var uuid string
-		err = rows.Scan(&uuid, &shares)
-		if err != nil {
-			return err
-		}
-		allShares = append(allShares, shares)
-		allShareUUIDs = append(allShareUUIDs, uuid)
+	defer tx.Rollback()
+	if err := deleteSecurity(ctx, tx, marketID, securityID); err != nil {
+		return err
 	}
+	return tx.Commit()
+}
 
-	// calculate new price for all shares in this market.
-	for idx := range allShares {
-		np := lmsr.Price(lmsr.Liquidity, allShares, idx)
-		_, err = tx.ExecContext(ctx, `
-			UPDATE securities 
-			SET last_price = ?
-			WHERE uuid = ?`, np, allShareUUIDs[idx])
-		if err != nil {
-			return err
-		}
-	}
-	return nil
+type querier interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
 }
 
-func (s *SqliteStore) GetSecurity(ctx context.Context, uuid string) (*pb.Security, error) {
-	security := &pb.Security{}
-	err := s.db.QueryRowContext(ctx, `
-		SELECT securities.description, shortname, securities.date_created, 
-			markets.uuid, shares_outstanding,last_price
-		FROM securities
-		JOIN markets 
-		ON securities.market_id = markets.id
-		WHERE securities.uuid = ?`, uuid).Scan(
-		&security.Description, &security.Shortname, &security.DateCreated,
-		&security.MarketId, &security.SharesOutstanding, &security.LastPrice)
+// execer is a querier that can also write, such as a transaction.
+type execer interface {
+	querier
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+// marketShares is a snapshot of the shares outstanding for every security in
+// a market, along with the pricer for them. micros counts each security's
+// micro-shares, and allShares is the same in shares, for the pricer.
+type marketShares struct {
+	pricer    lmsr.Pricer
+	micros    []int64
+	allShares []float64
+	ids       []int64
+	uuids     []string
+}
+
+// loadMarketShares is shared by SqliteStore and PostgresStore, which both
+// understand its $1 placeholders.
+func loadMarketShares(ctx context.Context, q querier, marketDBID int64) (*marketShares, error) {
+	var marketType pb.MarketType
+	var players int
+	err := q.QueryRowContext(ctx, `
+		SELECT market_type,
+			(SELECT COUNT(*) FROM market_players WHERE market_id = markets.id)
+		FROM markets
+		WHERE id = $1`, marketDBID).Scan(&marketType, &players)
 	if err != nil {
 		return nil, err
 	}
-	security.Id = uuid
-	return security, nil
-}
 
-func (s *SqliteStore) GetSecurities(ctx context.Context, marketID string) ([]*pb.Security, error) {
-	rows, err := s.db.QueryContext(ctx, `
-		SELECT securities.uuid, securities.description, securities.shortname, 
-			securities.date_created, shares_outstanding,
-			last_price
+	rows, err := q.QueryContext(ctx, `
+		SELECT id, uuid, shares_outstanding, COALESCE(player_idx, 0),
+			COALESCE(positions, '')
 		FROM securities
-		JOIN markets ON securities.market_id = markets.id
-		WHERE markets.uuid = ?
-		`, marketID)
+		WHERE market_id = $1
+		ORDER BY id
+		`, marketDBID)
 	if err != nil {
 		return nil, err
 	}
 
-	securities := []*pb.Security{}
+	ms := &marketShares{}
+	predicates := []lmsr.Predicate{}
 	defer rows.Close()
 	for rows.Next() {
-		security := &pb.Security{}
-		err = rows.Scan(&security.Id, &security.Description, &security.Shortname,
-			&security.DateCreated, &security.SharesOutstanding, &security.LastPrice)
+		var shares, id int64
+		var uuid, positions string
+		var player int
+		err = rows.Scan(&id, &uuid, &shares, &player, &positions)
 		if err != nil {
 			return nil, err
 		}
-		securities = append(securities, security)
+		ms.micros = append(ms.micros, shares)
+		ms.allShares = append(ms.allShares, lmsr.FromMicros(shares))
+		ms.ids = append(ms.ids, id)
+		ms.uuids = append(ms.uuids, uuid)
+
+		p := lmsr.Predicate{Player: player}
+		for _, pos := range parsePositions(positions) {
+			p.Positions = append(p.Positions, int(pos)-1)
+		}
+		predicates = append(predicates, p)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
 	}
-	return securities, nil
+
+	ms.pricer = newPricer(marketType, players, predicates)
+	return ms, nil
 }
 
-func (s *SqliteStore) FulfillOrder(ctx context.Context, username string,
-	securityUUID, marketUUID string, amount float64, buy bool) error {
-	// this function is too long. simplify.
-	if amount <= 0 {
-		return errors.New("amount must be positive")
+// keyedOrder returns the user's order with the given idempotency key, or nil
+// if there isn't one. It is shared by SqliteStore and PostgresStore.
+func keyedOrder(ctx context.Context, q querier, userID int64, key string) (*pb.Order, error) {
+	o := &pb.Order{}
+	err := q.QueryRowContext(ctx, `
+		SELECT orders.uuid, securities.uuid, orders.amount, orders.cost
+		FROM orders
+		JOIN securities ON orders.security_id = securities.id
+		WHERE orders.user_id = $1 AND orders.idempotency_key = $2`,
+		userID, key).Scan(&o.Id, &o.SecurityId, &o.AmountMicros, &o.CostMicros)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// index returns the position of the security with the given id in the
+// market's snapshot.
+func (ms *marketShares) index(securityID int64) (int, error) {
+	for idx, id := range ms.ids {
+		if id == securityID {
+			return idx, nil
+		}
 	}
-	marketID, err := s.dbid(ctx, "markets", "uuid", marketUUID)
+	return -1, errors.New("securityUUID not found")
+}
+
+// trade is an order that has been priced and checked against what the user
+// holds, and is ready to be written.
+type trade struct {
+	uuid           string
+	userID         int64
+	username       string
+	securityID     int64
+	securityUUID   string
+	marketID       int64
+	marketUUID     string
+	amount         int64
+	cost           int64
+	idempotencyKey string
+	date           string
+	// tokens and held are the user's tokens and shares of the security
+	// before the trade.
+	tokens int64
+	held   int64
+}
+
+// saveTrade moves the trade's tokens and shares into the user's portfolio,
+// and records the order and its ledger entry. It is shared by SqliteStore
+// and PostgresStore.
+func saveTrade(ctx context.Context, q execer, t trade) error {
+	_, err := q.ExecContext(ctx, `
+		UPDATE portfolios SET tokens = $1 WHERE user_id = $2`, t.tokens-t.cost, t.userID)
 	if err != nil {
 		return err
 	}
-	userID, err := s.dbid(ctx, "users", "username", username)
+	_, err = q.ExecContext(ctx, `
+		INSERT INTO portfolio_securities(user_id, security_id, amount)
+		VALUES($1, $2, $3)
+		ON CONFLICT (user_id, security_id) DO UPDATE SET amount = EXCLUDED.amount`,
+		t.userID, t.securityID, t.held+t.amount)
 	if err != nil {
 		return err
 	}
-	securityID, err := s.dbid(ctx, "securities", "uuid", securityUUID)
+	var orderID int64
+	err = q.QueryRowContext(ctx, `
+		INSERT INTO orders (uuid, user_id, security_id, amount, cost, date,
+			idempotency_key)
+		VALUES($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id`,
+		t.uuid, t.userID, t.securityID, t.amount, t.cost, t.date,
+		sql.NullString{String: t.idempotencyKey, Valid: t.idempotencyKey != ""}).Scan(&orderID)
 	if err != nil {
 		return err
 	}
+	return recordEntry(ctx, q, pb.LedgerEntryKind_TRADE, userAccount(t.username),
+		escrowAccount(t.marketUUID), t.cost, t.marketID, orderID, t.date)
+}
+
+// savePrices writes the shares outstanding in the market's snapshot, and the
+// prices they make, to every security in it. It returns the prices event to
+// publish once the transaction commits, and is shared by SqliteStore and
+// PostgresStore.
+func savePrices(ctx context.Context, q execer, marketUUID string, ms *marketShares,
+	date string) (events.Event, error) {
+
+	e := events.Event{Type: events.Prices, MarketID: marketUUID, Date: date}
+	for idx, np := range ms.pricer.Prices(ms.allShares) {
+		e.Prices = append(e.Prices, events.SecurityPrice{SecurityID: ms.uuids[idx], Price: np})
+		_, err := q.ExecContext(ctx, `
+			INSERT INTO security_costs(security_id, cost, date)
+			VALUES($1, $2, $3)`, ms.ids[idx], np, date)
+		if err != nil {
+			return e, err
+		}
+		_, err = q.ExecContext(ctx, `
+			UPDATE securities
+			SET shares_outstanding = $1, last_price = $2
+			WHERE id = $3`, ms.micros[idx], np, ms.ids[idx])
+		if err != nil {
+			return e, err
+		}
+	}
+	return e, nil
+}
+
+func (s *SqliteStore) GetSecurity(ctx context.Context, uuid string) (*pb.Security, error) {
+	return getSecurity(ctx, s.db, uuid)
+}
+
+func (s *SqliteStore) GetSecurities(ctx context.Context, marketID string) ([]*pb.Security, error) {
+	return getSecurities(ctx, s.db, marketID)
+}
+
+// GetSecurityCosts returns the price history of a security, oldest first.
+// beginDate and endDate are optional RFC3339 bounds, inclusive.
+func (s *SqliteStore) GetSecurityCosts(ctx context.Context, securityUUID string,
+	beginDate, endDate string) ([]*pb.GetSecurityCostsResponse_SecurityCost, error) {
+
+	return getSecurityCosts(ctx, s.db, securityUUID, beginDate, endDate)
+}
+
+// GetSecurityOrders returns every order for a security, oldest first.
+// beginDate and endDate are optional RFC3339 bounds, inclusive.
+func (s *SqliteStore) GetSecurityOrders(ctx context.Context, securityUUID string,
+	beginDate, endDate string) ([]*pb.Order, error) {
+
+	return getSecurityOrders(ctx, s.db, securityUUID, beginDate, endDate)
+}
+
+// GetPortfolio returns a user's tokens and every position they hold in
+// markets that haven't resolved yet, valued at current prices. Positions in
+// resolved markets have already been paid out.
+func (s *SqliteStore) GetPortfolio(ctx context.Context, username string) (*pb.Portfolio, error) {
+	return getPortfolio(ctx, s.db, username)
+}
 
-	m, err := s.GetMarket(ctx, marketUUID)
+// GrantTokens gives a user amount micro-tokens from the house.
+func (s *SqliteStore) GrantTokens(ctx context.Context, username string, amount int64) error {
+	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
 		return err
 	}
-	if !m.IsOpen {
-		return errors.New("this market is closed")
+	defer tx.Rollback()
+	if err := grantTokens(ctx, tx, username, amount); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// GetLedger returns a user's statement. See Store.
+func (s *SqliteStore) GetLedger(ctx context.Context, username string,
+	beginDate, endDate string) ([]*pb.LedgerEntry, int64, error) {
+
+	return getLedger(ctx, s.db, username, beginDate, endDate)
+}
+
+// SubmitGameResults stores results from a market's tournament, replacing any
+// earlier results for the same games, and returns how many of them changed
+// anything. A GameResults event is published for the rounds that changed.
+func (s *SqliteStore) SubmitGameResults(ctx context.Context, marketID string,
+	results []*pb.GameResult) (int, error) {
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+	e, changed, err := saveGameResults(ctx, tx, marketID, results, now())
+	if err != nil {
+		return 0, err
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	if changed > 0 {
+		s.events.Publish(e)
+	}
+	return changed, nil
+}
+
+// GetGameResults returns every result stored for a market, by round.
+func (s *SqliteStore) GetGameResults(ctx context.Context, marketID string) ([]*pb.GameResult, error) {
+	return loadGameResults(ctx, s.db, marketID)
+}
+
+// GetLeaderboard ranks users by portfolio value, or by P&L if the request
+// is filtered to a market or a time window. See GetLeaderboardRequest.
+func (s *SqliteStore) GetLeaderboard(ctx context.Context, req *pb.GetLeaderboardRequest) (*pb.GetLeaderboardResponse, error) {
+	return leaderboard(ctx, s.db, req)
+}
+
+// FulfillOrder buys (or sells) amount micro-shares of a security for the
+// user, and returns what it cost them. A retry with the same idempotency key
+// returns the first order's cost without trading again.
+func (s *SqliteStore) FulfillOrder(ctx context.Context, username string,
+	securityUUID, marketUUID string, amount int64, buy bool, idempotencyKey string) (int64, error) {
+
+	t, err := newTrade(ctx, s.db, username, securityUUID, marketUUID, amount, buy, idempotencyKey)
+	if err != nil {
+		return 0, err
 	}
+	t.uuid = s.newID()
 
 	conn, err := s.db.Conn(ctx)
 	if err != nil {
-		return err
+		return 0, err
 	}
 	defer conn.Close()
 
-	conn.ExecContext(ctx, "BEGIN EXCLUSIVE TRANSACTION;")
+	_, err = conn.ExecContext(ctx, "BEGIN EXCLUSIVE TRANSACTION;")
+	if err != nil {
+		return 0, err
+	}
 	defer conn.ExecContext(ctx, "ROLLBACK;")
 
-	orderTime := now()
+	cost, e, err := fulfillOrder(ctx, conn, t)
+	if err != nil || e == nil {
+		return cost, err
+	}
+	_, err = conn.ExecContext(ctx, "COMMIT;")
+	if err != nil {
+		return 0, err
+	}
+	s.events.Publish(*e)
+	return cost, nil
+}
 
-	rows, err := conn.QueryContext(ctx, `
-		SELECT uuid, shares_outstanding
-		FROM securities
-		WHERE market_id = ? 
-		`, marketID)
+// ResolveMarket closes a market, records what each of its securities pays
+// out, and settles every position held in it. See ResolveMarketRequest for
+// how the resolutions are interpreted.
+func (s *SqliteStore) ResolveMarket(ctx context.Context, req *pb.ResolveMarketRequest) error {
+	r, err := newResolution(ctx, s.db, req)
 	if err != nil {
 		return err
 	}
 
-	allShares := []float64{}
-	allShareUUIDs := []string{}
-	myIdx := -1
-	rc := 0
-	defer rows.Close()
-	for rows.Next() {
-		var shares float64
-		var uuid string
-		err = rows.Scan(&uuid, &shares)
-		if err != nil {
-			return err
-		}
-		if uuid == securityUUID {
-			myIdx = rc
-		}
-		allShares = append(allShares, shares)
-		allShareUUIDs = append(allShareUUIDs, uuid)
-		rc += 1
-	}
-	if myIdx == -1 {
-		// We never found the security index.
-		return errors.New("securityUUID not found")
-	}
-	if !buy {
-		amount *= -1
-	}
-
-	cost := lmsr.TradeCost(lmsr.Liquidity, amount, allShares, myIdx)
-	var heldTokens float64
-	err = conn.QueryRowContext(ctx, `
-		SELECT tokens FROM portfolios WHERE user_id = ?`,
-		userID).Scan(&heldTokens)
-	if err != nil {
-		return err
-	}
-	var heldSecurities float64
-	alreadyOwned := true
-	if err := conn.QueryRowContext(ctx, `
-		SELECT amount FROM portfolio_securities 
-		WHERE user_id = ? AND security_id = ?`,
-		userID, securityID).Scan(&heldSecurities); err != nil {
-		if err == sql.ErrNoRows {
-			// this is ok, and not an error. ignore for now; we
-			// simply don't own this security yet.
-			alreadyOwned = false
-		} else {
-			return err
-		}
+	conn, err := s.db.Conn(ctx)
+	if err != nil {
+		return err
 	}
+	defer conn.Close()
 
-	if cost > 0 {
-		if amount < 0 {
-			return errors.New("unexpected amount - negative")
-		}
-		if heldTokens < cost {
-			return errors.New("not enough tokens for this transaction")
-		}
-
-	} else if cost < 0 {
-		if amount > 0 {
-			return errors.New("unexpected amount - positive")
-		}
-		if heldSecurities < -amount {
-			return errors.New("cannot sell more securities than we own")
-		}
-
+	_, err = conn.ExecContext(ctx, "BEGIN EXCLUSIVE TRANSACTION;")
+	if err != nil {
+		return err
 	}
+	defer conn.ExecContext(ctx, "ROLLBACK;")
 
-	// update tokens
-	_, err = conn.ExecContext(ctx, `
-		UPDATE portfolios 
-		SET tokens = ?
-		WHERE user_id = ?`, heldTokens-cost, userID)
+	// Void any markets whose condition did not come true. The others keep
+	// trading until they are resolved themselves.
+	toVoid, err := marketsToVoid(ctx, conn, r.marketID, r.payouts)
 	if err != nil {
 		return err
 	}
-	// update held securities
-	if alreadyOwned {
-		_, err = conn.ExecContext(ctx, `
-		UPDATE portfolio_securities 
-		SET amount = ? 
-		WHERE user_id = ? AND security_id = ?`,
-			heldSecurities+amount, userID, securityID)
-		if err != nil {
-			return err
-		}
-	} else {
-		_, err = conn.ExecContext(ctx, `
-		INSERT INTO portfolio_securities(amount, user_id, security_id)
-		VALUES(?, ?, ?)
-	`, amount, userID, securityID)
-		if err != nil {
-			return err
-		}
+	resolveTime := now()
+	voided, err := resolveMarket(ctx, conn, r, toVoid, resolveTime)
+	if err != nil {
+		return err
 	}
-
-	// add order to order book
-	_, err = conn.ExecContext(ctx, `
-		INSERT INTO orders (uuid, user_id, security_id, amount, cost, date)
-		VALUES(?, ?, ?, ?, ?, ?)`,
-		shortuuid.New(), userID, securityID, amount, cost, orderTime)
+	_, err = conn.ExecContext(ctx, "COMMIT;")
 	if err != nil {
 		return err
 	}
-	// calculate new price for all shares in this market.
-	for idx := range allShares {
-		np := lmsr.Price(lmsr.Liquidity, allShares, idx)
-		// update security price log
-		_, err = conn.ExecContext(ctx, `
-			INSERT INTO security_costs(security_id, cost, date)
-			VALUES(?, ?, ?)
-			`, allShareUUIDs[idx], np, orderTime)
-		if err != nil {
-			return err
-		}
+	s.events.Publish(events.Event{Type: events.MarketResolved, MarketID: req.MarketId, Date: resolveTime})
+	for _, uuid := range voided {
+		s.events.Publish(events.Event{Type: events.MarketVoided, MarketID: uuid, Date: resolveTime})
+	}
+	// A market that can't be scored, for example because it was never
+	// traded, is still resolved.
+	if _, err := s.ScoreMarket(ctx, req.MarketId); err != nil {
+		log.Err(err).Str("marketID", req.MarketId).Msg("score-market")
+	}
+	return nil
+}
+
+type conditionalMarket struct {
+	marketID      int64
+	conditionUUID string
+}
+
+// ScoreMarket scores a resolved market's price history against what its
+// securities paid out, and stores the scores, replacing any earlier ones.
+func (s *SqliteStore) ScoreMarket(ctx context.Context, marketID string) ([]*pb.MarketScore, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	scores, err := scoreMarket(ctx, tx, marketID)
+	if err != nil {
+		return nil, err
+	}
+	return scores, tx.Commit()
+}
 
-		_, err = conn.ExecContext(ctx, `
-			UPDATE securities 
-			SET shares_outstanding = ?, last_price = ?
-			WHERE uuid = ?`, allShares[idx], np, allShareUUIDs[idx])
+// GetMarketScores returns a market's scores, or every scored market's if
+// marketID is empty.
+func (s *SqliteStore) GetMarketScores(ctx context.Context, marketID string) ([]*pb.MarketScore, error) {
+	return loadMarketScores(ctx, s.db, marketID)
+}
+
+// conditionalMarkets returns the unresolved markets that are conditional on
+// one of the given market's securities. Like loadMarketShares, it is shared
+// by SqliteStore and PostgresStore.
+func conditionalMarkets(ctx context.Context, q querier, marketID int64) ([]conditionalMarket, error) {
+	rows, err := q.QueryContext(ctx, `
+		SELECT markets.id, securities.uuid
+		FROM markets
+		JOIN securities ON markets.condition_security_id = securities.id
+		WHERE securities.market_id = $1 AND markets.date_resolved IS NULL
+		ORDER BY markets.id`, marketID)
+	if err != nil {
+		return nil, err
+	}
+	markets := []conditionalMarket{}
+	defer rows.Close()
+	for rows.Next() {
+		var m conditionalMarket
+		err = rows.Scan(&m.marketID, &m.conditionUUID)
 		if err != nil {
-			return err
+			return nil, err
 		}
-
+		markets = append(markets, m)
 	}
+	return markets, rows.Err()
+}
 
-	// and commit the transaction. phew.
-	_, err = conn.ExecContext(ctx, "COMMIT;")
+func formatPositions(positions []int32) string {
+	strs := make([]string, len(positions))
+	for idx, pos := range positions {
+		strs[idx] = strconv.Itoa(int(pos))
+	}
+	return strings.Join(strs, ",")
+}
 
-	return err
+func parsePositions(positions string) []int32 {
+	if positions == "" {
+		return nil
+	}
+	parsed := []int32{}
+	for _, str := range strings.Split(positions, ",") {
+		pos, err := strconv.Atoi(str)
+		if err != nil {
+			// We only ever store what formatPositions writes.
+			continue
+		}
+		parsed = append(parsed, int32(pos))
+	}
+	return parsed
 }
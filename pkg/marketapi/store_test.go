@@ -4,10 +4,12 @@ import (
 	"context"
 	"database/sql"
 	"io/ioutil"
+	"math"
 	"os"
-	"sync"
 	"testing"
+	"time"
 
+	"github.com/domino14/scrabfutures/pkg/lmsr"
 	pb "github.com/domino14/scrabfutures/rpc/proto"
 	"github.com/matryer/is"
 )
@@ -41,102 +43,27 @@ func addFixtures(fixtureFile string) {
 	}
 }
 
-func TestFulfillOrder(t *testing.T) {
-	initDB()
-	addFixtures("./testfixtures/basic.sql")
-	is := is.New(t)
-	ctx := context.Background()
-	s, err := NewSqliteStore(cfg.DBPath)
-	is.NoErr(err)
-	err = s.OpenMarket(ctx, "nationals2022")
-	is.NoErr(err)
-	err = s.FulfillOrder(ctx, "cesar", "S3uuid", "nationals2022", 50, true)
-	is.NoErr(err)
-	sec, err := s.GetSecurity(ctx, "S3uuid")
-	is.NoErr(err)
-	is.Equal(sec, &pb.Security{
-		Id: "S3uuid", Description: "César wins nationals",
-		Shortname: "CSAR", DateCreated: "2022-07-08T14:00:01Z",
-		MarketId: "nationals2022", SharesOutstanding: 50,
-		LastPrice: 35.46612443924434,
-	})
-}
-
-func TestFulfillOrderNotEnoughForSale(t *testing.T) {
-	initDB()
-	addFixtures("./testfixtures/basic.sql")
-	is := is.New(t)
-	ctx := context.Background()
-	s, _ := NewSqliteStore(cfg.DBPath)
-	s.OpenMarket(ctx, "nationals2022")
-	err := s.FulfillOrder(ctx, "cesar", "S3uuid", "nationals2022", 50, true)
-	is.NoErr(err)
-	// try to sell 60 shares that we don't have (we just bought 50)
-	err = s.FulfillOrder(ctx, "cesar", "S3uuid", "nationals2022", 60, false)
-	is.Equal(err.Error(), "cannot sell more securities than we own")
-}
-
-func TestFulfillOrderTooExpensive(t *testing.T) {
-	initDB()
-	addFixtures("./testfixtures/basic.sql")
-	is := is.New(t)
-	ctx := context.Background()
-	s, _ := NewSqliteStore(cfg.DBPath)
-	s.OpenMarket(ctx, "nationals2022")
-	err := s.FulfillOrder(ctx, "cesar", "S3uuid", "nationals2022", 100, true)
-	is.Equal(err.Error(), "not enough tokens for this transaction")
-}
-
-func TestFulfillSimultaneousOrders(t *testing.T) {
-	initDB()
-	addFixtures("./testfixtures/basic.sql")
-	is := is.New(t)
-	ctx := context.Background()
-	s, _ := NewSqliteStore(cfg.DBPath)
-	s.OpenMarket(ctx, "nationals2022")
-	var wg sync.WaitGroup
-
-	// Order one item simultaneously from 50 different threads. The lock should do
-	// the right thing.
-	for i := 0; i < 50; i++ {
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-			err := s.FulfillOrder(ctx, "cesar", "S3uuid", "nationals2022", 1, true)
-			is.NoErr(err)
-		}()
-	}
-	wg.Wait()
-	sec, err := s.GetSecurity(ctx, "S3uuid")
-	is.NoErr(err)
-	is.Equal(sec, &pb.Security{
-		Id: "S3uuid", Description: "César wins nationals",
-		Shortname: "CSAR", DateCreated: "2022-07-08T14:00:01Z",
-		MarketId: "nationals2022", SharesOutstanding: 50,
-		LastPrice: 35.46612443924434,
-	})
-}
-
 func TestCreateMarket(t *testing.T) {
 	initDB()
 	is := is.New(t)
 	ctx := context.Background()
 	s, _ := NewSqliteStore(cfg.DBPath)
-	uuid, err := s.CreateMarket(ctx, "a foo market")
+	uuid, err := s.CreateMarket(ctx, &pb.CreateMarketRequest{Description: "a foo market"})
 	is.NoErr(err)
-	markets, err := s.GetOpenMarkets(ctx)
+	markets, err := s.GetMarkets(ctx, pb.Market_OPEN)
 	is.NoErr(err)
 	is.Equal(len(markets), 0)
 	err = s.OpenMarket(ctx, uuid)
 	is.NoErr(err)
 
-	markets, err = s.GetOpenMarkets(ctx)
+	markets, err = s.GetMarkets(ctx, pb.Market_OPEN)
 	is.NoErr(err)
 	is.Equal(len(markets), 1)
 	is.Equal(markets[0], &pb.Market{
 		Id:          uuid,
 		Description: "a foo market",
 		IsOpen:      true,
+		Status:      pb.Market_OPEN,
 		DateCreated: markets[0].DateCreated,
 	})
 }
@@ -172,7 +99,7 @@ func TestAddSecurities(t *testing.T) {
 	is := is.New(t)
 	ctx := context.Background()
 	s, _ := NewSqliteStore(cfg.DBPath)
-	uuid, _ := s.CreateMarket(ctx, "a foo market")
+	uuid, _ := s.CreateMarket(ctx, &pb.CreateMarketRequest{Description: "a foo market"})
 
 	err := s.AddSecurities(ctx, uuid, []*pb.AddSecuritiesRequest_Security{
 		{Description: "someone wins nationals", Shortname: "SOMEONE"},
@@ -205,3 +132,403 @@ func TestDeleteSecurity(t *testing.T) {
 	is.Equal(secs[1].LastPrice, 100.0/3)
 	is.Equal(secs[2].LastPrice, 100.0/3)
 }
+
+// tokens returns how many micro-tokens a user has.
+func tokens(s *SqliteStore, username string) int64 {
+	var t int64
+	err := s.db.QueryRow(`
+		SELECT tokens FROM portfolios
+		JOIN users ON portfolios.user_id = users.id
+		WHERE username = ?`, username).Scan(&t)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func TestCreateBinaryMarket(t *testing.T) {
+	initDB()
+	is := is.New(t)
+	ctx := context.Background()
+	s, _ := NewSqliteStore(cfg.DBPath)
+	uuid, err := s.CreateMarket(ctx, &pb.CreateMarketRequest{
+		Description: "someone scores 700+ at nationals",
+		MarketType:  pb.MarketType_BINARY,
+	})
+	is.NoErr(err)
+	m, err := s.GetMarket(ctx, uuid)
+	is.NoErr(err)
+	is.Equal(m.MarketType, pb.MarketType_BINARY)
+
+	secs, err := s.GetSecurities(ctx, uuid)
+	is.NoErr(err)
+	is.Equal(len(secs), 2)
+	is.Equal(secs[0].Shortname, "YES")
+	is.Equal(secs[1].Shortname, "NO")
+	is.Equal(secs[0].LastPrice, 50.0)
+	is.Equal(secs[1].LastPrice, 50.0)
+
+	err = s.AddSecurities(ctx, uuid, []*pb.AddSecuritiesRequest_Security{
+		{Description: "maybe", Shortname: "MAYBE"},
+	})
+	is.Equal(err.Error(), "binary markets cannot have securities added")
+	err = s.DeleteSecurity(ctx, uuid, secs[0].Id)
+	is.Equal(err.Error(), "binary markets cannot have securities deleted")
+}
+
+func TestCreateScalarMarketBadBounds(t *testing.T) {
+	initDB()
+	is := is.New(t)
+	ctx := context.Background()
+	s, _ := NewSqliteStore(cfg.DBPath)
+	_, err := s.CreateMarket(ctx, &pb.CreateMarketRequest{
+		Description: "high game at nationals",
+		MarketType:  pb.MarketType_SCALAR,
+		LowerBound:  800,
+		UpperBound:  400,
+	})
+	is.Equal(err.Error(), "upper bound must be greater than lower bound")
+}
+
+func TestDeleteConditionSecurityDisallowed(t *testing.T) {
+	initDB()
+	addFixtures("./testfixtures/basic.sql")
+	is := is.New(t)
+	ctx := context.Background()
+	s, _ := NewSqliteStore(cfg.DBPath)
+	_, err := s.CreateMarket(ctx, &pb.CreateMarketRequest{
+		Description:         "If Kenji wins nationals, will he score 700+?",
+		MarketType:          pb.MarketType_BINARY,
+		ConditionSecurityId: "S1uuid",
+	})
+	is.NoErr(err)
+	err = s.DeleteSecurity(ctx, "nationals2022", "S1uuid")
+	is.Equal(err.Error(), "disallowed deletion of security that other markets are conditional on")
+	err = s.DeleteMarket(ctx, "nationals2022")
+	is.Equal(err.Error(), "disallowed deletion of market that other markets are conditional on")
+}
+
+func createRankingMarket(t *testing.T, s *SqliteStore) (string, []*pb.Security) {
+	is := is.New(t)
+	ctx := context.Background()
+	uuid, err := s.CreateMarket(ctx, &pb.CreateMarketRequest{
+		Description: "Nationals 2022 standings",
+		MarketType:  pb.MarketType_RANKING,
+		Players:     []string{"Kenji", "Noah", "César", "Josh"},
+	})
+	is.NoErr(err)
+	err = s.AddSecurities(ctx, uuid, []*pb.AddSecuritiesRequest_Security{
+		{Description: "Kenji wins", Shortname: "KNJI1", Player: "Kenji", Positions: []int32{1}},
+		{Description: "Noah wins", Shortname: "NOAH1", Player: "Noah", Positions: []int32{1}},
+		{Description: "César finishes top 2", Shortname: "CSAR2", Player: "César", Positions: []int32{1, 2}},
+		{Description: "Josh finishes last", Shortname: "JOSH4", Player: "Josh", Positions: []int32{4}},
+	})
+	is.NoErr(err)
+	secs, err := s.GetSecurities(ctx, uuid)
+	is.NoErr(err)
+	return uuid, secs
+}
+
+func TestRankingMarket(t *testing.T) {
+	initDB()
+	addFixtures("./testfixtures/basic.sql")
+	is := is.New(t)
+	ctx := context.Background()
+	s, _ := NewSqliteStore(cfg.DBPath)
+	uuid, secs := createRankingMarket(t, s)
+
+	m, _ := s.GetMarket(ctx, uuid)
+	is.Equal(m.Players, []string{"Kenji", "Noah", "César", "Josh"})
+	is.Equal(len(secs), 4)
+	is.Equal(secs[2].Player, "César")
+	is.Equal(secs[2].Positions, []int32{1, 2})
+	is.True(math.Abs(secs[0].LastPrice-25) < 1e-9)
+	is.True(math.Abs(secs[2].LastPrice-50) < 1e-9)
+
+	is.NoErr(s.OpenMarket(ctx, uuid))
+	_, err := s.FulfillOrder(ctx, "cesar", secs[2].Id, uuid, 10*lmsr.Micros, true, "")
+	is.NoErr(err)
+	_, err = s.FulfillOrder(ctx, "josh", secs[3].Id, uuid, 10*lmsr.Micros, true, "")
+	is.NoErr(err)
+
+	secs, _ = s.GetSecurities(ctx, uuid)
+	is.True(secs[2].LastPrice > 50)
+	// César being more likely to finish in the top two makes everyone
+	// else less likely to win.
+	is.True(secs[0].LastPrice < 25)
+	is.True(secs[1].LastPrice < 25)
+	cesarTokens := tokens(s, "cesar")
+	joshTokens := tokens(s, "josh")
+
+	err = s.ResolveMarket(ctx, &pb.ResolveMarketRequest{
+		MarketId:  uuid,
+		Standings: []string{"Noah", "César", "Kenji"},
+	})
+	is.Equal(err.Error(), "standings must list every player exactly once")
+
+	err = s.ResolveMarket(ctx, &pb.ResolveMarketRequest{
+		MarketId:  uuid,
+		Standings: []string{"Noah", "César", "Josh", "Kenji"},
+	})
+	is.NoErr(err)
+	is.Equal(tokens(s, "cesar"), cesarTokens+1000*lmsr.Micros)
+	is.Equal(tokens(s, "josh"), joshTokens)
+}
+
+func TestRankingMarketBadPredicates(t *testing.T) {
+	initDB()
+	is := is.New(t)
+	ctx := context.Background()
+	s, _ := NewSqliteStore(cfg.DBPath)
+	uuid, _ := s.CreateMarket(ctx, &pb.CreateMarketRequest{
+		Description: "Nationals 2022 standings",
+		MarketType:  pb.MarketType_RANKING,
+		Players:     []string{"Kenji", "Noah", "César"},
+	})
+	err := s.AddSecurities(ctx, uuid, []*pb.AddSecuritiesRequest_Security{
+		{Description: "Josh wins", Shortname: "JOSH1", Player: "Josh", Positions: []int32{1}},
+	})
+	is.Equal(err.Error(), `player "Josh" is not in this market`)
+	err = s.AddSecurities(ctx, uuid, []*pb.AddSecuritiesRequest_Security{
+		{Description: "Noah finishes 4th", Shortname: "NOAH4", Player: "Noah", Positions: []int32{4}},
+	})
+	is.Equal(err.Error(), "invalid or duplicate position: 4")
+	secs, _ := s.GetSecurities(ctx, uuid)
+	is.Equal(len(secs), 0)
+}
+
+func TestAddSecuritiesWithInitialProbabilities(t *testing.T) {
+	initDB()
+	addFixtures("./testfixtures/basic.sql")
+	is := is.New(t)
+	ctx := context.Background()
+	s, _ := NewSqliteStore(cfg.DBPath)
+	uuid, _ := s.CreateMarket(ctx, &pb.CreateMarketRequest{Description: "a foo market"})
+
+	err := s.AddSecurities(ctx, uuid, []*pb.AddSecuritiesRequest_Security{
+		{Description: "Kenji wins", Shortname: "KNJI", InitialProbability: 0.5},
+		{Description: "Noah wins", Shortname: "NOAH", InitialProbability: 0.3},
+		{Description: "someone else wins", Shortname: "FIELD", InitialProbability: 0.2},
+	})
+	is.NoErr(err)
+	secs, _ := s.GetSecurities(ctx, uuid)
+	// The seeded shares are rounded to whole micro-shares.
+	is.True(math.Abs(secs[0].LastPrice-50) < 1e-6)
+	is.True(math.Abs(secs[1].LastPrice-30) < 1e-6)
+	is.True(math.Abs(secs[2].LastPrice-20) < 1e-6)
+	is.Equal(secs[2].SharesOutstandingMicros, int64(0))
+
+	// Seeding only works for a market's first securities.
+	err = s.AddSecurities(ctx, uuid, []*pb.AddSecuritiesRequest_Security{
+		{Description: "Josh wins", Shortname: "JOSH", Rating: 1800},
+	})
+	is.Equal(err.Error(), "opening prices can only be seeded for the first securities in an exclusive market")
+
+	// Nobody holds the seeded shares, so they can't be sold.
+	is.NoErr(s.OpenMarket(ctx, uuid))
+	_, err = s.FulfillOrder(ctx, "cesar", secs[0].Id, uuid, 1*lmsr.Micros, false, "")
+	is.Equal(err.Error(), "cannot sell more securities than we own")
+}
+
+func TestAddSecuritiesWithRatings(t *testing.T) {
+	initDB()
+	is := is.New(t)
+	ctx := context.Background()
+	s, _ := NewSqliteStore(cfg.DBPath)
+	uuid, _ := s.CreateMarket(ctx, &pb.CreateMarketRequest{Description: "a foo market"})
+
+	err := s.AddSecurities(ctx, uuid, []*pb.AddSecuritiesRequest_Security{
+		{Description: "Kenji wins", Shortname: "KNJI", Rating: 2200},
+		{Description: "Josh wins", Shortname: "JOSH", Rating: 1800},
+	})
+	is.NoErr(err)
+	secs, _ := s.GetSecurities(ctx, uuid)
+	// The seeded shares are rounded to whole micro-shares.
+	is.True(math.Abs(secs[0].LastPrice-100*10.0/11) < 1e-6)
+	is.True(math.Abs(secs[1].LastPrice-100*1.0/11) < 1e-6)
+	is.Equal(secs[0].Rating, 2200.0)
+}
+
+func TestAddSecuritiesBadSeeds(t *testing.T) {
+	initDB()
+	is := is.New(t)
+	ctx := context.Background()
+	s, _ := NewSqliteStore(cfg.DBPath)
+	uuid, _ := s.CreateMarket(ctx, &pb.CreateMarketRequest{Description: "a foo market"})
+
+	err := s.AddSecurities(ctx, uuid, []*pb.AddSecuritiesRequest_Security{
+		{Description: "Kenji wins", Shortname: "KNJI", InitialProbability: 0.6},
+		{Description: "Josh wins", Shortname: "JOSH", InitialProbability: 0.6},
+	})
+	is.Equal(err.Error(), "initial probabilities must add up to 1")
+	err = s.AddSecurities(ctx, uuid, []*pb.AddSecuritiesRequest_Security{
+		{Description: "Kenji wins", Shortname: "KNJI", Rating: 2200},
+		{Description: "Josh wins", Shortname: "JOSH"},
+	})
+	is.Equal(err.Error(), "either all securities or none of them must have initial probabilities or ratings")
+}
+
+func TestScoreMarket(t *testing.T) {
+	initDB()
+	is := is.New(t)
+	ctx := context.Background()
+	s, _ := NewSqliteStore(cfg.DBPath)
+
+	uuid, err := s.CreateMarket(ctx, &pb.CreateMarketRequest{Description: "Kenji vs. Noah"})
+	is.NoErr(err)
+	is.NoErr(s.AddSecurities(ctx, uuid, []*pb.AddSecuritiesRequest_Security{
+		{Description: "Kenji wins", Shortname: "KNJI"},
+		{Description: "Noah wins", Shortname: "NOAH"},
+	}))
+	is.NoErr(s.OpenMarket(ctx, uuid))
+	secs, _ := s.GetSecurities(ctx, uuid)
+
+	// The market opened two days ago at 50-50, and moved to 80-20 twelve
+	// hours before it resolved.
+	ago := func(h time.Duration) string {
+		return time.Now().Add(-h * time.Hour).Format(time.RFC3339)
+	}
+	_, err = s.db.Exec(`UPDATE security_costs SET date = ?`, ago(48))
+	is.NoErr(err)
+	_, err = s.db.Exec(`
+		INSERT INTO security_costs(security_id, cost, date)
+		SELECT id, CASE shortname WHEN 'KNJI' THEN 80 ELSE 20 END, ?
+		FROM securities`, ago(12))
+	is.NoErr(err)
+
+	is.NoErr(s.ResolveMarket(ctx, &pb.ResolveMarketRequest{
+		MarketId: uuid,
+		Resolutions: []*pb.ResolveMarketRequest_SecurityResolution{
+			{SecurityId: secs[0].Id, Wins: true}, {SecurityId: secs[1].Id, Wins: false},
+		},
+	}))
+
+	scores, err := s.GetMarketScores(ctx, uuid)
+	is.NoErr(err)
+	is.Equal(len(scores), 4)
+	near := func(a, b float64) bool { return math.Abs(a-b) < 1e-3 }
+	byCheckpoint := map[pb.ScoreCheckpoint]*pb.MarketScore{}
+	for _, sc := range scores {
+		byCheckpoint[sc.Checkpoint] = sc
+	}
+	is.True(near(byCheckpoint[pb.ScoreCheckpoint_OPEN].Brier, 0.5))
+	is.True(near(byCheckpoint[pb.ScoreCheckpoint_OPEN].LogLoss, math.Log(2)))
+	is.True(near(byCheckpoint[pb.ScoreCheckpoint_FINAL_DAY].Brier, 0.5))
+	is.True(near(byCheckpoint[pb.ScoreCheckpoint_CLOSE].Brier, 0.08))
+	is.True(near(byCheckpoint[pb.ScoreCheckpoint_CLOSE].LogLoss, -math.Log(0.8)))
+	// 36 hours at 0.5, then 12 hours at 0.08.
+	is.True(near(byCheckpoint[pb.ScoreCheckpoint_TIME_WEIGHTED].Brier, 0.395))
+
+	all, err := s.GetMarketScores(ctx, "")
+	is.NoErr(err)
+	is.Equal(len(all), 4)
+
+	// Unresolved markets can't be scored.
+	other, _ := s.CreateMarket(ctx, &pb.CreateMarketRequest{Description: "other"})
+	_, err = s.ScoreMarket(ctx, other)
+	is.True(err != nil)
+}
+
+func TestReplay(t *testing.T) {
+	initDB()
+	addFixtures("./testfixtures/basic.sql")
+	is := is.New(t)
+	ctx := context.Background()
+	s, _ := NewSqliteStore(cfg.DBPath)
+
+	seeded, err := s.CreateMarket(ctx, &pb.CreateMarketRequest{Description: "Nationals 2023"})
+	is.NoErr(err)
+	is.NoErr(s.AddSecurities(ctx, seeded, []*pb.AddSecuritiesRequest_Security{
+		{Description: "Kenji wins", Shortname: "KNJI", InitialProbability: 0.6},
+		{Description: "someone else wins", Shortname: "FIELD", InitialProbability: 0.4},
+	}))
+	seededSecs, _ := s.GetSecurities(ctx, seeded)
+	edited, err := s.CreateMarket(ctx, &pb.CreateMarketRequest{Description: "Worlds 2023"})
+	is.NoErr(err)
+	is.NoErr(s.AddSecurities(ctx, edited, []*pb.AddSecuritiesRequest_Security{
+		{Description: "Kenji wins", Shortname: "KNJI"},
+		{Description: "Noah wins", Shortname: "NOAH"},
+	}))
+	editedSecs, _ := s.GetSecurities(ctx, edited)
+	is.NoErr(s.DeleteSecurity(ctx, edited, editedSecs[0].Id))
+	is.NoErr(s.AddSecurities(ctx, edited, []*pb.AddSecuritiesRequest_Security{
+		{Description: "Josh wins", Shortname: "JOSH"},
+	}))
+	editedSecs, _ = s.GetSecurities(ctx, edited)
+	parent, err := s.CreateMarket(ctx, &pb.CreateMarketRequest{
+		Description: "Will Kenji make the final?", MarketType: pb.MarketType_BINARY})
+	is.NoErr(err)
+	parentSecs, _ := s.GetSecurities(ctx, parent)
+	child, err := s.CreateMarket(ctx, &pb.CreateMarketRequest{
+		Description:         "Will Kenji win the final?",
+		MarketType:          pb.MarketType_BINARY,
+		ConditionSecurityId: parentSecs[0].Id,
+	})
+	is.NoErr(err)
+	childSecs, _ := s.GetSecurities(ctx, child)
+	deleted, err := s.CreateMarket(ctx, &pb.CreateMarketRequest{Description: "Never mind"})
+	is.NoErr(err)
+	is.NoErr(s.DeleteMarket(ctx, deleted))
+
+	is.NoErr(s.ScheduleMarket(ctx, &pb.ScheduleMarketRequest{Id: edited, OpenAt: "2999-01-01T00:00:00Z"}))
+	for _, id := range []string{seeded, edited, parent, child} {
+		is.NoErr(s.OpenMarket(ctx, id))
+	}
+	trades := []struct {
+		username, security, market string
+		amount                     int64
+		buy                        bool
+	}{
+		{"cesar", seededSecs[0].Id, seeded, 10 * lmsr.Micros, true},
+		{"josh", seededSecs[1].Id, seeded, lmsr.Micros / 3, true},
+		{"cesar", seededSecs[0].Id, seeded, 4 * lmsr.Micros, false},
+		{"josh", editedSecs[1].Id, edited, 5 * lmsr.Micros, true},
+		{"cesar", childSecs[0].Id, child, 5 * lmsr.Micros, true},
+		{"josh", parentSecs[1].Id, parent, 10 * lmsr.Micros, true},
+	}
+	for i, tr := range trades {
+		_, err := s.FulfillOrder(ctx, tr.username, tr.security, tr.market, tr.amount, tr.buy, "")
+		is.NoErr(err)
+		if i == 0 {
+			is.NoErr(s.HaltMarket(ctx, edited))
+		}
+		if i == 2 {
+			is.NoErr(s.GrantTokens(ctx, "josh", 50*lmsr.Micros))
+			is.NoErr(s.CloseMarket(ctx, seeded))
+			is.NoErr(s.OpenMarket(ctx, edited))
+		}
+	}
+	is.NoErr(s.ResolveMarket(ctx, &pb.ResolveMarketRequest{
+		MarketId:    parent,
+		Resolutions: []*pb.ResolveMarketRequest_SecurityResolution{{SecurityId: parentSecs[1].Id, Wins: true}},
+	}))
+	is.NoErr(s.VoidMarket(ctx, seeded))
+
+	replay := func() []*ReplayDiff {
+		replayCfg := Config{DBMigrationsPath: cfg.DBMigrationsPath, DBPath: cfg.DBPath + ".replay"}
+		os.Remove(replayCfg.DBPath)
+		EnsureMigrations(&replayCfg)
+		fresh, err := NewSqliteStore(replayCfg.DBPath)
+		is.NoErr(err)
+		diffs, err := s.Replay(ctx, fresh)
+		is.NoErr(err)
+		return diffs
+	}
+
+	// The fixture's market was never logged, but nobody traded it.
+	diffs := replay()
+	is.Equal(len(diffs), 1)
+	is.Equal(diffs[0].Table, "markets")
+	is.Equal(diffs[0].Key, "nationals2022")
+
+	_, err = s.db.ExecContext(ctx, `
+		UPDATE portfolio_securities SET amount = amount + 1
+		WHERE security_id = (SELECT id FROM securities WHERE uuid = ?)`, editedSecs[1].Id)
+	is.NoErr(err)
+	diffs = replay()
+	is.Equal(len(diffs), 2)
+	is.Equal(diffs[1].Table, "portfolio_securities")
+	is.Equal(diffs[1].Key, "josh/"+editedSecs[1].Id)
+	is.Equal(diffs[1].Live, "5000001")
+	is.Equal(diffs[1].Replayed, "5000000")
+}
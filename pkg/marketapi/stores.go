@@ -0,0 +1,98 @@
+package marketapi
+
+import (
+	"context"
+
+	"github.com/domino14/scrabfutures/pkg/events"
+	pb "github.com/domino14/scrabfutures/rpc/proto"
+)
+
+// Store keeps markets, their securities, and every user's orders and
+// portfolio. SqliteStore is the one we run; MemoryStore has the same
+// semantics and is meant for tests.
+type Store interface {
+	CreateMarket(ctx context.Context, req *pb.CreateMarketRequest) (string, error)
+	GetMarket(ctx context.Context, id string) (*pb.Market, error)
+	// GetMarkets returns the markets with any of the statuses, or every
+	// market if there are none, in the order they were created in.
+	GetMarkets(ctx context.Context, statuses ...pb.Market_Status) ([]*pb.Market, error)
+	OpenMarket(ctx context.Context, uuid string) error
+	CloseMarket(ctx context.Context, uuid string) error
+	// HaltMarket pauses trading in an open market. OpenMarket resumes it.
+	HaltMarket(ctx context.Context, uuid string) error
+	// VoidMarket cancels a market that hasn't been resolved, refunding every
+	// trader, and voids the markets that are conditional on it.
+	VoidMarket(ctx context.Context, uuid string) error
+	// ScheduleMarket sets when a market is opened and closed. See
+	// ScheduleMarketRequest.
+	ScheduleMarket(ctx context.Context, req *pb.ScheduleMarketRequest) error
+	DeleteMarket(ctx context.Context, uuid string) error
+	ResolveMarket(ctx context.Context, req *pb.ResolveMarketRequest) error
+
+	AddSecurities(ctx context.Context, marketID string, securities []*pb.AddSecuritiesRequest_Security) error
+	DeleteSecurity(ctx context.Context, marketID string, securityID string) error
+	GetSecurity(ctx context.Context, uuid string) (*pb.Security, error)
+	GetSecurities(ctx context.Context, marketID string) ([]*pb.Security, error)
+	GetSecurityCosts(ctx context.Context, securityUUID string,
+		beginDate, endDate string) ([]*pb.GetSecurityCostsResponse_SecurityCost, error)
+
+	// FulfillOrder buys or sells amount micro-shares of a security at the
+	// market maker's price, and returns what it cost in micro-tokens; sales
+	// have negative costs. If the user already made an order with the same
+	// idempotencyKey, it returns that order's cost instead of trading again.
+	// The key is optional.
+	FulfillOrder(ctx context.Context, username string, securityUUID, marketUUID string,
+		amount int64, buy bool, idempotencyKey string) (int64, error)
+	GetSecurityOrders(ctx context.Context, securityUUID string,
+		beginDate, endDate string) ([]*pb.Order, error)
+	// GetOrderBook returns a page of the orders matching the request. See
+	// GetOrderBookRequest.
+	GetOrderBook(ctx context.Context, req *pb.GetOrderBookRequest) (*pb.OrderBookResponse, error)
+	GetPortfolio(ctx context.Context, username string) (*pb.Portfolio, error)
+
+	// GrantTokens gives a user amount micro-tokens from the house.
+	GrantTokens(ctx context.Context, username string, amount int64) error
+	// GetLedger returns a user's ledger entries made between beginDate and
+	// endDate (both optional and inclusive), oldest first, along with their
+	// balance now.
+	GetLedger(ctx context.Context, username string, beginDate, endDate string) ([]*pb.LedgerEntry, int64, error)
+
+	// Events returns the hub that the store publishes changes to once
+	// they've been made.
+	Events() *events.Hub
+}
+
+// ResultsStore is a Store that also keeps the results of the games played in
+// a tournament.
+type ResultsStore interface {
+	Store
+	SubmitGameResults(ctx context.Context, marketID string, results []*pb.GameResult) (int, error)
+	GetGameResults(ctx context.Context, marketID string) ([]*pb.GameResult, error)
+}
+
+// AnalyticsStore is a Store that also scores resolved markets and ranks
+// traders.
+type AnalyticsStore interface {
+	Store
+	GetMarketScores(ctx context.Context, marketID string) ([]*pb.MarketScore, error)
+	GetLeaderboard(ctx context.Context, req *pb.GetLeaderboardRequest) (*pb.GetLeaderboardResponse, error)
+}
+
+// AuditStore is a Store whose books can be checked against the invariants
+// that trading is meant to keep.
+type AuditStore interface {
+	Store
+	Audit(ctx context.Context) ([]*pb.InvariantViolation, error)
+}
+
+var (
+	_ ResultsStore   = (*SqliteStore)(nil)
+	_ AnalyticsStore = (*SqliteStore)(nil)
+	_ AuditStore     = (*SqliteStore)(nil)
+	_ ResultsStore   = (*MemoryStore)(nil)
+	_ AnalyticsStore = (*MemoryStore)(nil)
+	_ AuditStore     = (*MemoryStore)(nil)
+	_ ResultsStore   = (*PostgresStore)(nil)
+	_ AnalyticsStore = (*PostgresStore)(nil)
+	_ AuditStore     = (*PostgresStore)(nil)
+)
@@ -0,0 +1,1315 @@
+package marketapi
+
+import (
+	"context"
+	"math"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+	"github.com/twitchtv/twirp"
+
+	"github.com/domino14/scrabfutures/pkg/events"
+	"github.com/domino14/scrabfutures/pkg/lmsr"
+	pb "github.com/domino14/scrabfutures/rpc/proto"
+)
+
+// forEachStore runs a test against every Store, each of which starts off
+// with the users from the basic fixture. See postgresURL for which database
+// the Postgres store is tested against; everything in it is dropped.
+func forEachStore(t *testing.T, test func(t *testing.T, s Store)) {
+	t.Run("sqlite", func(t *testing.T) {
+		initDB()
+		addFixtures("./testfixtures/basic.sql")
+		s, err := NewSqliteStore(cfg.DBPath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		test(t, s)
+	})
+	t.Run("memory", func(t *testing.T) {
+		s := NewMemoryStore()
+		s.AddUser("cesar", 2000*lmsr.Micros)
+		s.AddUser("josh", 2000*lmsr.Micros)
+		test(t, s)
+	})
+	t.Run("postgres", func(t *testing.T) {
+		url := postgresURL(t)
+		initPostgres(url)
+		s, err := NewPostgresStore(url)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer s.db.Close()
+		test(t, s)
+	})
+}
+
+func portfolioTokens(s Store, username string) int64 {
+	p, err := s.GetPortfolio(context.Background(), username)
+	if err != nil {
+		panic(err)
+	}
+	return p.TokensMicros
+}
+
+func createExclusiveMarket(ctx context.Context, is *is.I, s Store, shortnames ...string) (string, []*pb.Security) {
+	id, err := s.CreateMarket(ctx, &pb.CreateMarketRequest{Description: "Nationals 2023"})
+	is.NoErr(err)
+	toAdd := []*pb.AddSecuritiesRequest_Security{}
+	for _, name := range shortnames {
+		toAdd = append(toAdd, &pb.AddSecuritiesRequest_Security{
+			Description: name + " wins nationals", Shortname: name})
+	}
+	is.NoErr(s.AddSecurities(ctx, id, toAdd))
+	secs, err := s.GetSecurities(ctx, id)
+	is.NoErr(err)
+	return id, secs
+}
+
+func TestStoresTrade(t *testing.T) {
+	forEachStore(t, func(t *testing.T, s Store) {
+		is := is.New(t)
+		ctx := context.Background()
+		evts, unsubscribe := s.Events().Subscribe(20)
+		defer unsubscribe()
+
+		id, secs := createExclusiveMarket(ctx, is, s, "KNJI", "NOAH", "CSAR", "JOSH")
+		is.Equal(len(secs), 4)
+		is.Equal(secs[0].LastPrice, 25.0)
+		is.Equal((<-evts).Type, events.SecuritiesAdded)
+
+		_, err := s.FulfillOrder(ctx, "cesar", secs[2].Id, id, 10*lmsr.Micros, true, "")
+		is.True(err != nil) // not open yet
+		is.NoErr(s.OpenMarket(ctx, id))
+		is.Equal((<-evts).Type, events.MarketOpened)
+
+		bought, err := s.FulfillOrder(ctx, "cesar", secs[2].Id, id, 50*lmsr.Micros, true, "")
+		is.NoErr(err)
+		is.Equal(bought, lmsr.TradeCostMicros(lmsr.Exclusive{B: lmsr.Liquidity}, 50*lmsr.Micros, []float64{0, 0, 0, 0}, 2))
+		e := <-evts
+		is.Equal(e.Type, events.Prices)
+		is.Equal(len(e.Prices), 4)
+		_, err = s.FulfillOrder(ctx, "josh", secs[3].Id, id, 20*lmsr.Micros, true, "")
+		is.NoErr(err)
+		<-evts
+		sold, err := s.FulfillOrder(ctx, "cesar", secs[2].Id, id, 20*lmsr.Micros, false, "")
+		is.NoErr(err)
+		is.True(sold < 0)
+		<-evts
+
+		_, err = s.FulfillOrder(ctx, "cesar", secs[2].Id, id, 31*lmsr.Micros, false, "")
+		is.True(err != nil) // only 30 left
+		_, err = s.FulfillOrder(ctx, "josh", secs[0].Id, id, 5000*lmsr.Micros, true, "")
+		is.True(err != nil) // too expensive
+		_, err = s.FulfillOrder(ctx, "josh", "nope", id, 1*lmsr.Micros, true, "")
+		is.True(err != nil)
+
+		sec, err := s.GetSecurity(ctx, secs[2].Id)
+		is.NoErr(err)
+		is.Equal(sec.SharesOutstandingMicros, int64(30*lmsr.Micros))
+		is.Equal(sec.MarketId, id)
+
+		p, err := s.GetPortfolio(ctx, "cesar")
+		is.NoErr(err)
+		is.Equal(p.TokensMicros, 2000*lmsr.Micros-bought-sold)
+		is.Equal(len(p.Positions), 1)
+		is.Equal(p.Positions[0].AmountMicros, int64(30*lmsr.Micros))
+		is.True(math.Abs(p.Positions[0].AverageCost-lmsr.FromMicros(bought)/50) < 1e-9)
+		is.Equal(p.Positions[0].MarkValue, 30*sec.LastPrice)
+		is.True(p.Positions[0].LiquidationValue < p.Positions[0].MarkValue)
+
+		orders, err := s.GetSecurityOrders(ctx, secs[2].Id, "", "")
+		is.NoErr(err)
+		is.Equal(len(orders), 2)
+		is.Equal(orders[0].Username, "cesar")
+		is.Equal(orders[0].SecurityShortname, "CSAR")
+		is.Equal(orders[1].AmountMicros, int64(-20*lmsr.Micros))
+		is.Equal(orders[1].CostMicros, sold)
+		costs, err := s.GetSecurityCosts(ctx, secs[2].Id, "", "")
+		is.NoErr(err)
+		// The opening price and one for each order.
+		is.Equal(len(costs), 4)
+		is.Equal(costs[0].Cost, 25.0)
+		is.Equal(costs[3].Cost, sec.LastPrice)
+
+		markets, err := s.GetMarkets(ctx, pb.Market_OPEN)
+		is.NoErr(err)
+		is.Equal(len(markets), 1)
+		is.NoErr(s.CloseMarket(ctx, id))
+		_, err = s.FulfillOrder(ctx, "cesar", secs[2].Id, id, 1*lmsr.Micros, true, "")
+		is.True(err != nil)
+		is.True(s.DeleteMarket(ctx, id) != nil)
+	})
+}
+
+func TestStoresFulfillOrder(t *testing.T) {
+	forEachStore(t, func(t *testing.T, s Store) {
+		is := is.New(t)
+		ctx := context.Background()
+		id, secs := createExclusiveMarket(ctx, is, s, "KNJI", "NOAH", "CSAR", "JOSH")
+		is.NoErr(s.OpenMarket(ctx, id))
+		_, err := s.FulfillOrder(ctx, "cesar", secs[2].Id, id, 50*lmsr.Micros, true, "")
+		is.NoErr(err)
+		sec, err := s.GetSecurity(ctx, secs[2].Id)
+		is.NoErr(err)
+		is.Equal(sec.SharesOutstandingMicros, int64(50*lmsr.Micros))
+		is.Equal(sec.LastPrice, 35.46612443924434)
+	})
+}
+
+func TestStoresFulfillOrderNotEnoughForSale(t *testing.T) {
+	forEachStore(t, func(t *testing.T, s Store) {
+		is := is.New(t)
+		ctx := context.Background()
+		id, secs := createExclusiveMarket(ctx, is, s, "KNJI", "NOAH", "CSAR", "JOSH")
+		is.NoErr(s.OpenMarket(ctx, id))
+		_, err := s.FulfillOrder(ctx, "cesar", secs[2].Id, id, 50*lmsr.Micros, true, "")
+		is.NoErr(err)
+		// try to sell 60 shares that we don't have (we just bought 50)
+		_, err = s.FulfillOrder(ctx, "cesar", secs[2].Id, id, 60*lmsr.Micros, false, "")
+		is.Equal(err.Error(), "cannot sell more securities than we own")
+	})
+}
+
+func TestStoresFulfillOrderTooExpensive(t *testing.T) {
+	forEachStore(t, func(t *testing.T, s Store) {
+		is := is.New(t)
+		ctx := context.Background()
+		id, secs := createExclusiveMarket(ctx, is, s, "KNJI", "NOAH", "CSAR", "JOSH")
+		is.NoErr(s.OpenMarket(ctx, id))
+		_, err := s.FulfillOrder(ctx, "cesar", secs[2].Id, id, 100*lmsr.Micros, true, "")
+		is.Equal(err.Error(), "not enough tokens for this transaction")
+	})
+}
+
+func TestStoresFulfillSimultaneousOrders(t *testing.T) {
+	forEachStore(t, func(t *testing.T, s Store) {
+		is := is.New(t)
+		ctx := context.Background()
+		id, secs := createExclusiveMarket(ctx, is, s, "KNJI", "NOAH", "CSAR", "JOSH")
+		is.NoErr(s.OpenMarket(ctx, id))
+		var wg sync.WaitGroup
+
+		// Order one item simultaneously from 50 different threads. The lock should do
+		// the right thing.
+		for i := 0; i < 50; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := s.FulfillOrder(ctx, "cesar", secs[2].Id, id, 1*lmsr.Micros, true, "")
+				is.NoErr(err)
+			}()
+		}
+		wg.Wait()
+		sec, err := s.GetSecurity(ctx, secs[2].Id)
+		is.NoErr(err)
+		is.Equal(sec.SharesOutstandingMicros, int64(50*lmsr.Micros))
+		is.Equal(sec.LastPrice, 35.46612443924434)
+	})
+}
+
+func TestStoresEditSecurities(t *testing.T) {
+	forEachStore(t, func(t *testing.T, s Store) {
+		is := is.New(t)
+		ctx := context.Background()
+		id, secs := createExclusiveMarket(ctx, is, s, "KNJI", "NOAH")
+		other, otherSecs := createExclusiveMarket(ctx, is, s, "CSAR")
+
+		is.True(s.DeleteSecurity(ctx, id, otherSecs[0].Id) != nil)
+		is.NoErr(s.DeleteSecurity(ctx, id, secs[1].Id))
+		secs, err := s.GetSecurities(ctx, id)
+		is.NoErr(err)
+		is.Equal(len(secs), 1)
+		is.Equal(secs[0].LastPrice, 100.0)
+
+		// Seeds only go on the first securities.
+		err = s.AddSecurities(ctx, id, []*pb.AddSecuritiesRequest_Security{
+			{Shortname: "JOSH", InitialProbability: 1},
+		})
+		is.True(err != nil)
+		is.NoErr(s.DeleteMarket(ctx, other))
+		_, err = s.GetMarket(ctx, other)
+		is.True(err != nil)
+
+		seeded, err := s.CreateMarket(ctx, &pb.CreateMarketRequest{Description: "Seeded"})
+		is.NoErr(err)
+		is.NoErr(s.AddSecurities(ctx, seeded, []*pb.AddSecuritiesRequest_Security{
+			{Shortname: "A", InitialProbability: 0.75},
+			{Shortname: "B", InitialProbability: 0.25},
+		}))
+		secs, err = s.GetSecurities(ctx, seeded)
+		is.NoErr(err)
+		is.True(math.Abs(secs[0].LastPrice-75) < 1e-6)
+
+		binary, err := s.CreateMarket(ctx, &pb.CreateMarketRequest{
+			Description: "Will Kenji win?", MarketType: pb.MarketType_BINARY})
+		is.NoErr(err)
+		is.True(s.AddSecurities(ctx, binary, []*pb.AddSecuritiesRequest_Security{{Shortname: "MAYBE"}}) != nil)
+		_, err = s.CreateMarket(ctx, &pb.CreateMarketRequest{
+			Description: "Spread", MarketType: pb.MarketType_SCALAR, LowerBound: 10, UpperBound: 10})
+		is.True(err != nil)
+	})
+}
+
+func TestStoresResolveRanking(t *testing.T) {
+	forEachStore(t, func(t *testing.T, s Store) {
+		is := is.New(t)
+		ctx := context.Background()
+		id, err := s.CreateMarket(ctx, &pb.CreateMarketRequest{
+			Description: "Nationals 2023 standings",
+			MarketType:  pb.MarketType_RANKING,
+			Players:     []string{"Kenji", "Noah", "César"},
+		})
+		is.NoErr(err)
+		is.NoErr(s.AddSecurities(ctx, id, []*pb.AddSecuritiesRequest_Security{
+			{Shortname: "KNJI-TOP2", Player: "Kenji", Positions: []int32{1, 2}},
+			{Shortname: "NOAH-1", Player: "Noah", Positions: []int32{1}},
+		}))
+		secs, err := s.GetSecurities(ctx, id)
+		is.NoErr(err)
+		is.True(math.Abs(secs[0].LastPrice-100*2.0/3) < 1e-9)
+		is.True(s.ResolveMarket(ctx, &pb.ResolveMarketRequest{
+			MarketId: id, Standings: []string{"Noah", "Kenji", "César"}}) != nil)
+
+		is.NoErr(s.OpenMarket(ctx, id))
+		kenji, err := s.FulfillOrder(ctx, "cesar", secs[0].Id, id, 10*lmsr.Micros, true, "")
+		is.NoErr(err)
+		noah, err := s.FulfillOrder(ctx, "josh", secs[1].Id, id, 10*lmsr.Micros, true, "")
+		is.NoErr(err)
+
+		is.True(s.ResolveMarket(ctx, &pb.ResolveMarketRequest{
+			MarketId: id, Standings: []string{"Noah", "Kenji"}}) != nil)
+		is.NoErr(s.ResolveMarket(ctx, &pb.ResolveMarketRequest{
+			MarketId: id, Standings: []string{"Noah", "Kenji", "César"}}))
+		is.Equal(portfolioTokens(s, "cesar"), 3000*lmsr.Micros-kenji)
+		is.Equal(portfolioTokens(s, "josh"), 3000*lmsr.Micros-noah)
+		m, err := s.GetMarket(ctx, id)
+		is.NoErr(err)
+		is.True(!m.IsOpen)
+		is.True(m.DateResolved != "")
+		is.Equal(m.Players, []string{"Kenji", "Noah", "César"})
+		p, err := s.GetPortfolio(ctx, "cesar")
+		is.NoErr(err)
+		is.Equal(len(p.Positions), 0)
+
+		is.True(s.ResolveMarket(ctx, &pb.ResolveMarketRequest{
+			MarketId: id, Standings: []string{"Noah", "Kenji", "César"}}) != nil)
+	})
+}
+
+func TestStoresResolveBinaryMarket(t *testing.T) {
+	forEachStore(t, func(t *testing.T, s Store) {
+		is := is.New(t)
+		ctx := context.Background()
+		uuid, _ := s.CreateMarket(ctx, &pb.CreateMarketRequest{
+			Description: "someone scores 700+ at nationals",
+			MarketType:  pb.MarketType_BINARY,
+		})
+		secs, _ := s.GetSecurities(ctx, uuid)
+		yes, no := secs[0].Id, secs[1].Id
+		is.NoErr(s.OpenMarket(ctx, uuid))
+		_, err := s.FulfillOrder(ctx, "cesar", yes, uuid, 10*lmsr.Micros, true, "")
+		is.NoErr(err)
+		_, err = s.FulfillOrder(ctx, "josh", no, uuid, 10*lmsr.Micros, true, "")
+		is.NoErr(err)
+		cesarTokens := portfolioTokens(s, "cesar")
+		joshTokens := portfolioTokens(s, "josh")
+
+		err = s.ResolveMarket(ctx, &pb.ResolveMarketRequest{
+			MarketId: uuid,
+			Resolutions: []*pb.ResolveMarketRequest_SecurityResolution{
+				{SecurityId: yes, Wins: true}, {SecurityId: no, Wins: true},
+			},
+		})
+		is.Equal(err.Error(), "exactly one of YES or NO must win")
+
+		err = s.ResolveMarket(ctx, &pb.ResolveMarketRequest{
+			MarketId: uuid,
+			Resolutions: []*pb.ResolveMarketRequest_SecurityResolution{
+				{SecurityId: no, Wins: true},
+			},
+		})
+		is.NoErr(err)
+		is.Equal(portfolioTokens(s, "cesar"), cesarTokens)
+		is.Equal(portfolioTokens(s, "josh"), joshTokens+1000*lmsr.Micros)
+
+		m, _ := s.GetMarket(ctx, uuid)
+		is.True(!m.IsOpen)
+		is.True(m.DateResolved != "")
+
+		// Trading and resolving again are both disallowed.
+		_, err = s.FulfillOrder(ctx, "cesar", yes, uuid, 10*lmsr.Micros, false, "")
+		is.Equal(err.Error(), "this market is closed")
+		err = s.ResolveMarket(ctx, &pb.ResolveMarketRequest{
+			MarketId: uuid,
+			Resolutions: []*pb.ResolveMarketRequest_SecurityResolution{
+				{SecurityId: yes, Wins: true},
+			},
+		})
+		is.Equal(err.Error(), "this market has already been resolved")
+	})
+}
+
+func TestStoresResolveMarketTie(t *testing.T) {
+	forEachStore(t, func(t *testing.T, s Store) {
+		is := is.New(t)
+		ctx := context.Background()
+		id, secs := createExclusiveMarket(ctx, is, s, "KNJI", "NOAH", "CSAR", "JOSH")
+		is.NoErr(s.OpenMarket(ctx, id))
+		_, err := s.FulfillOrder(ctx, "cesar", secs[2].Id, id, 10*lmsr.Micros, true, "")
+		is.NoErr(err)
+		_, err = s.FulfillOrder(ctx, "josh", secs[3].Id, id, 10*lmsr.Micros, true, "")
+		is.NoErr(err)
+		cesarTokens := portfolioTokens(s, "cesar")
+		joshTokens := portfolioTokens(s, "josh")
+
+		// César and Kenji tied for first, but only one security in an
+		// exclusive market can win.
+		err = s.ResolveMarket(ctx, &pb.ResolveMarketRequest{
+			MarketId: id,
+			Resolutions: []*pb.ResolveMarketRequest_SecurityResolution{
+				{SecurityId: secs[0].Id, Wins: true}, {SecurityId: secs[2].Id, Wins: true},
+			},
+		})
+		is.Equal(err.Error(), "exactly one security must win")
+		is.Equal(portfolioTokens(s, "cesar"), cesarTokens)
+		m, _ := s.GetMarket(ctx, id)
+		is.Equal(m.Status, pb.Market_OPEN)
+
+		err = s.ResolveMarket(ctx, &pb.ResolveMarketRequest{
+			MarketId: id,
+			Resolutions: []*pb.ResolveMarketRequest_SecurityResolution{
+				{SecurityId: secs[2].Id, Wins: true},
+			},
+		})
+		is.NoErr(err)
+		is.Equal(portfolioTokens(s, "cesar"), cesarTokens+1000*lmsr.Micros)
+		is.Equal(portfolioTokens(s, "josh"), joshTokens)
+	})
+}
+
+func TestStoresResolveMarketNeverOpened(t *testing.T) {
+	forEachStore(t, func(t *testing.T, s Store) {
+		is := is.New(t)
+		ctx := context.Background()
+		id, secs := createExclusiveMarket(ctx, is, s, "KNJI", "NOAH", "CSAR", "JOSH")
+		err := s.ResolveMarket(ctx, &pb.ResolveMarketRequest{
+			MarketId: id,
+			Resolutions: []*pb.ResolveMarketRequest_SecurityResolution{
+				{SecurityId: secs[0].Id, Wins: true},
+			},
+		})
+		is.Equal(err.Error(), "cannot resolve a market that was never opened")
+	})
+}
+
+func TestStoresResolveScalarMarket(t *testing.T) {
+	forEachStore(t, func(t *testing.T, s Store) {
+		is := is.New(t)
+		ctx := context.Background()
+		uuid, err := s.CreateMarket(ctx, &pb.CreateMarketRequest{
+			Description: "high game at nationals",
+			MarketType:  pb.MarketType_SCALAR,
+			LowerBound:  500,
+			UpperBound:  800,
+		})
+		is.NoErr(err)
+		m, _ := s.GetMarket(ctx, uuid)
+		is.Equal(m.LowerBound, 500.0)
+		is.Equal(m.UpperBound, 800.0)
+
+		secs, _ := s.GetSecurities(ctx, uuid)
+		is.Equal(len(secs), 2)
+		long, short := secs[0].Id, secs[1].Id
+		is.Equal(secs[0].Shortname, "LONG")
+		is.Equal(secs[1].Shortname, "SHORT")
+
+		is.NoErr(s.OpenMarket(ctx, uuid))
+		_, err = s.FulfillOrder(ctx, "cesar", long, uuid, 10*lmsr.Micros, true, "")
+		is.NoErr(err)
+		_, err = s.FulfillOrder(ctx, "josh", short, uuid, 10*lmsr.Micros, true, "")
+		is.NoErr(err)
+		cesarTokens := portfolioTokens(s, "cesar")
+		joshTokens := portfolioTokens(s, "josh")
+
+		// A value that isn't a number can't be paid out on.
+		for _, bad := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+			err = s.ResolveMarket(ctx, &pb.ResolveMarketRequest{MarketId: uuid, Value: bad})
+			is.True(err != nil)
+		}
+		is.Equal(portfolioTokens(s, "cesar"), cesarTokens)
+		_, err = NewAdminService(s).ResolveMarket(ctx, &pb.ResolveMarketRequest{MarketId: uuid, Value: math.NaN()})
+		is.Equal(err.(twirp.Error).Code(), twirp.InvalidArgument)
+
+		err = s.ResolveMarket(ctx, &pb.ResolveMarketRequest{MarketId: uuid, Value: 710})
+		is.NoErr(err)
+		// 710 is 70% of the way from 500 to 800.
+		// Payouts round down to a whole micro-token.
+		is.True(cesarTokens+700*lmsr.Micros-portfolioTokens(s, "cesar") <= 1)
+		is.True(joshTokens+300*lmsr.Micros-portfolioTokens(s, "josh") <= 1)
+	})
+}
+
+// conditionalOnKenji opens a market on who wins nationals, and a binary
+// market that's conditional on Kenji winning it. It returns the securities
+// of the first and the id and YES and NO securities of the second.
+func conditionalOnKenji(ctx context.Context, is *is.I, s Store) (string, []*pb.Security, string, string, string) {
+	parent, parentSecs := createExclusiveMarket(ctx, is, s, "KNJI", "NOAH", "CSAR", "JOSH")
+	is.NoErr(s.OpenMarket(ctx, parent))
+	uuid, err := s.CreateMarket(ctx, &pb.CreateMarketRequest{
+		Description:         "If Kenji wins nationals, will he score 700+?",
+		MarketType:          pb.MarketType_BINARY,
+		ConditionSecurityId: parentSecs[0].Id,
+	})
+	is.NoErr(err)
+	m, _ := s.GetMarket(ctx, uuid)
+	is.Equal(m.ConditionSecurityId, parentSecs[0].Id)
+	is.NoErr(s.OpenMarket(ctx, uuid))
+	secs, _ := s.GetSecurities(ctx, uuid)
+	return parent, parentSecs, uuid, secs[0].Id, secs[1].Id
+}
+
+func TestStoresConditionalMarketVoided(t *testing.T) {
+	forEachStore(t, func(t *testing.T, s Store) {
+		is := is.New(t)
+		ctx := context.Background()
+		parent, parentSecs, uuid, yes, no := conditionalOnKenji(ctx, is, s)
+
+		_, err := s.FulfillOrder(ctx, "cesar", yes, uuid, 10*lmsr.Micros, true, "")
+		is.NoErr(err)
+		_, err = s.FulfillOrder(ctx, "josh", no, uuid, 20*lmsr.Micros, true, "")
+		is.NoErr(err)
+		_, err = s.FulfillOrder(ctx, "josh", no, uuid, 5*lmsr.Micros, false, "")
+		is.NoErr(err)
+
+		// The conditional market can't be resolved before its parent.
+		err = s.ResolveMarket(ctx, &pb.ResolveMarketRequest{
+			MarketId: uuid,
+			Resolutions: []*pb.ResolveMarketRequest_SecurityResolution{
+				{SecurityId: yes, Wins: true},
+			},
+		})
+		is.Equal(err.Error(), "cannot resolve a conditional market before the market it is conditional on")
+
+		// Noah wins, so the condition fails and everyone gets refunded.
+		evts, unsubscribe := s.Events().Subscribe(10)
+		defer unsubscribe()
+		err = s.ResolveMarket(ctx, &pb.ResolveMarketRequest{
+			MarketId: parent,
+			Resolutions: []*pb.ResolveMarketRequest_SecurityResolution{
+				{SecurityId: parentSecs[1].Id, Wins: true},
+			},
+		})
+		is.NoErr(err)
+		is.Equal(portfolioTokens(s, "cesar"), int64(2000*lmsr.Micros))
+		is.Equal(portfolioTokens(s, "josh"), int64(2000*lmsr.Micros))
+
+		m, _ := s.GetMarket(ctx, uuid)
+		is.True(m.Voided)
+		is.True(!m.IsOpen)
+		is.True(m.DateResolved != "")
+
+		e := <-evts
+		is.Equal(e.Type, events.MarketResolved)
+		is.Equal(e.MarketID, parent)
+		e = <-evts
+		is.Equal(e.Type, events.MarketVoided)
+		is.Equal(e.MarketID, uuid)
+	})
+}
+
+func TestStoresConditionalMarketContinues(t *testing.T) {
+	forEachStore(t, func(t *testing.T, s Store) {
+		is := is.New(t)
+		ctx := context.Background()
+		parent, parentSecs, uuid, yes, _ := conditionalOnKenji(ctx, is, s)
+
+		_, err := s.FulfillOrder(ctx, "cesar", yes, uuid, 10*lmsr.Micros, true, "")
+		is.NoErr(err)
+		err = s.ResolveMarket(ctx, &pb.ResolveMarketRequest{
+			MarketId: parent,
+			Resolutions: []*pb.ResolveMarketRequest_SecurityResolution{
+				{SecurityId: parentSecs[0].Id, Wins: true},
+			},
+		})
+		is.NoErr(err)
+
+		m, _ := s.GetMarket(ctx, uuid)
+		is.True(!m.Voided)
+		is.True(m.IsOpen)
+		_, err = s.FulfillOrder(ctx, "cesar", yes, uuid, 10*lmsr.Micros, true, "")
+		is.NoErr(err)
+
+		cesarTokens := portfolioTokens(s, "cesar")
+		err = s.ResolveMarket(ctx, &pb.ResolveMarketRequest{
+			MarketId: uuid,
+			Resolutions: []*pb.ResolveMarketRequest_SecurityResolution{
+				{SecurityId: yes, Wins: true},
+			},
+		})
+		is.NoErr(err)
+		is.Equal(portfolioTokens(s, "cesar"), cesarTokens+2000*lmsr.Micros)
+	})
+}
+
+func TestStoresConditionalVoided(t *testing.T) {
+	forEachStore(t, func(t *testing.T, s Store) {
+		is := is.New(t)
+		ctx := context.Background()
+		parent, err := s.CreateMarket(ctx, &pb.CreateMarketRequest{
+			Description: "Will Kenji make the final?", MarketType: pb.MarketType_BINARY})
+		is.NoErr(err)
+		parentSecs, err := s.GetSecurities(ctx, parent)
+		is.NoErr(err)
+		child, err := s.CreateMarket(ctx, &pb.CreateMarketRequest{
+			Description:         "Will Kenji win the final?",
+			MarketType:          pb.MarketType_BINARY,
+			ConditionSecurityId: parentSecs[0].Id,
+		})
+		is.NoErr(err)
+		grandchild, err := s.CreateMarket(ctx, &pb.CreateMarketRequest{
+			Description:         "Will Kenji win the final by 100?",
+			MarketType:          pb.MarketType_BINARY,
+			ConditionSecurityId: parentSecs[0].Id,
+		})
+		is.NoErr(err)
+		is.True(s.DeleteMarket(ctx, parent) != nil)
+		is.NoErr(s.DeleteMarket(ctx, grandchild))
+		childSecs, err := s.GetSecurities(ctx, child)
+		is.NoErr(err)
+
+		is.NoErr(s.OpenMarket(ctx, parent))
+		is.NoErr(s.OpenMarket(ctx, child))
+		is.True(s.ResolveMarket(ctx, &pb.ResolveMarketRequest{
+			MarketId:    child,
+			Resolutions: []*pb.ResolveMarketRequest_SecurityResolution{{SecurityId: childSecs[0].Id, Wins: true}},
+		}) != nil)
+		_, err = s.FulfillOrder(ctx, "cesar", childSecs[0].Id, child, 30*lmsr.Micros, true, "")
+		is.NoErr(err)
+		_, err = s.FulfillOrder(ctx, "cesar", childSecs[0].Id, child, 10*lmsr.Micros, false, "")
+		is.NoErr(err)
+		_, err = s.FulfillOrder(ctx, "josh", parentSecs[1].Id, parent, 10*lmsr.Micros, true, "")
+		is.NoErr(err)
+
+		evts, unsubscribe := s.Events().Subscribe(10)
+		defer unsubscribe()
+		is.NoErr(s.ResolveMarket(ctx, &pb.ResolveMarketRequest{
+			MarketId:    parent,
+			Resolutions: []*pb.ResolveMarketRequest_SecurityResolution{{SecurityId: parentSecs[1].Id, Wins: true}},
+		}))
+		is.Equal((<-evts).Type, events.MarketResolved)
+		e := <-evts
+		is.Equal(e.Type, events.MarketVoided)
+		is.Equal(e.MarketID, child)
+
+		m, err := s.GetMarket(ctx, child)
+		is.NoErr(err)
+		is.True(m.Voided)
+		is.Equal(m.ConditionSecurityId, parentSecs[0].Id)
+		is.Equal(portfolioTokens(s, "cesar"), int64(2000*lmsr.Micros))
+		is.True(portfolioTokens(s, "josh") > 2000*lmsr.Micros)
+	})
+}
+
+func TestStoresVoidedProfitableSeller(t *testing.T) {
+	forEachStore(t, func(t *testing.T, s Store) {
+		is := is.New(t)
+		ctx := context.Background()
+		parent, err := s.CreateMarket(ctx, &pb.CreateMarketRequest{
+			Description: "Will Kenji make the final?", MarketType: pb.MarketType_BINARY})
+		is.NoErr(err)
+		parentSecs, err := s.GetSecurities(ctx, parent)
+		is.NoErr(err)
+		child, err := s.CreateMarket(ctx, &pb.CreateMarketRequest{
+			Description:         "Will Kenji win the final?",
+			MarketType:          pb.MarketType_BINARY,
+			ConditionSecurityId: parentSecs[0].Id,
+		})
+		is.NoErr(err)
+		childSecs, err := s.GetSecurities(ctx, child)
+		is.NoErr(err)
+		is.NoErr(s.OpenMarket(ctx, parent))
+		is.NoErr(s.OpenMarket(ctx, child))
+
+		// cesar buys cheap, josh pushes the price up, and cesar sells
+		// everything at the higher price.
+		_, err = s.FulfillOrder(ctx, "cesar", childSecs[0].Id, child, 10*lmsr.Micros, true, "")
+		is.NoErr(err)
+		_, err = s.FulfillOrder(ctx, "josh", childSecs[0].Id, child, 15*lmsr.Micros, true, "")
+		is.NoErr(err)
+		_, err = s.FulfillOrder(ctx, "cesar", childSecs[0].Id, child, 10*lmsr.Micros, false, "")
+		is.NoErr(err)
+		cesar := portfolioTokens(s, "cesar")
+		is.True(cesar > 2000*lmsr.Micros)
+		josh := portfolioTokens(s, "josh")
+
+		is.NoErr(s.ResolveMarket(ctx, &pb.ResolveMarketRequest{
+			MarketId:    parent,
+			Resolutions: []*pb.ResolveMarketRequest_SecurityResolution{{SecurityId: parentSecs[1].Id, Wins: true}},
+		}))
+		m, err := s.GetMarket(ctx, child)
+		is.NoErr(err)
+		is.Equal(m.Status, pb.Market_VOIDED)
+		// cesar keeps his profit, and josh gets back what he paid.
+		is.Equal(portfolioTokens(s, "cesar"), cesar)
+		is.True(portfolioTokens(s, "josh") > josh)
+		entries, balance, err := s.GetLedger(ctx, "cesar", "", "")
+		is.NoErr(err)
+		is.Equal(balance, cesar)
+		for _, e := range entries {
+			is.True(e.Kind != pb.LedgerEntryKind_REFUND)
+		}
+		if as, ok := s.(AuditStore); ok {
+			violations, err := as.Audit(ctx)
+			is.NoErr(err)
+			is.Equal(len(violations), 0)
+		}
+	})
+}
+
+func TestStoresVoidMarket(t *testing.T) {
+	forEachStore(t, func(t *testing.T, s Store) {
+		is := is.New(t)
+		ctx := context.Background()
+		parent, err := s.CreateMarket(ctx, &pb.CreateMarketRequest{
+			Description: "Will Kenji make the final?", MarketType: pb.MarketType_BINARY})
+		is.NoErr(err)
+		parentSecs, err := s.GetSecurities(ctx, parent)
+		is.NoErr(err)
+		child, err := s.CreateMarket(ctx, &pb.CreateMarketRequest{
+			Description:         "Will Kenji win the final?",
+			MarketType:          pb.MarketType_BINARY,
+			ConditionSecurityId: parentSecs[0].Id,
+		})
+		is.NoErr(err)
+		childSecs, err := s.GetSecurities(ctx, child)
+		is.NoErr(err)
+		is.NoErr(s.OpenMarket(ctx, parent))
+		is.NoErr(s.OpenMarket(ctx, child))
+
+		cesar := portfolioTokens(s, "cesar")
+		josh := portfolioTokens(s, "josh")
+		_, err = s.FulfillOrder(ctx, "cesar", parentSecs[0].Id, parent, 10*lmsr.Micros, true, "")
+		is.NoErr(err)
+		_, err = s.FulfillOrder(ctx, "josh", childSecs[1].Id, child, 5*lmsr.Micros, true, "")
+		is.NoErr(err)
+		is.NoErr(s.HaltMarket(ctx, parent))
+
+		// Voiding the parent voids the child too, and everyone gets back
+		// what they paid.
+		is.NoErr(s.VoidMarket(ctx, parent))
+		for _, id := range []string{parent, child} {
+			m, err := s.GetMarket(ctx, id)
+			is.NoErr(err)
+			is.Equal(m.Status, pb.Market_VOIDED)
+			is.True(m.Voided)
+		}
+		is.Equal(portfolioTokens(s, "cesar"), cesar)
+		is.Equal(portfolioTokens(s, "josh"), josh)
+		_, err = s.FulfillOrder(ctx, "cesar", parentSecs[0].Id, parent, lmsr.Micros, true, "")
+		is.True(err != nil)
+		if as, ok := s.(AuditStore); ok {
+			violations, err := as.Audit(ctx)
+			is.NoErr(err)
+			is.Equal(len(violations), 0)
+		}
+
+		// A market can only be voided once, and not once it has resolved.
+		is.True(s.VoidMarket(ctx, parent) != nil)
+		resolved, err := s.CreateMarket(ctx, &pb.CreateMarketRequest{
+			Description: "Will Kenji win Nationals?", MarketType: pb.MarketType_BINARY})
+		is.NoErr(err)
+		resolvedSecs, err := s.GetSecurities(ctx, resolved)
+		is.NoErr(err)
+		is.NoErr(s.OpenMarket(ctx, resolved))
+		is.NoErr(s.ResolveMarket(ctx, &pb.ResolveMarketRequest{
+			MarketId:    resolved,
+			Resolutions: []*pb.ResolveMarketRequest_SecurityResolution{{SecurityId: resolvedSecs[0].Id, Wins: true}},
+		}))
+		is.True(s.VoidMarket(ctx, resolved) != nil)
+		m, err := s.GetMarket(ctx, resolved)
+		is.NoErr(err)
+		is.Equal(m.Status, pb.Market_RESOLVED)
+	})
+}
+
+func TestStoresSellEverything(t *testing.T) {
+	forEachStore(t, func(t *testing.T, s Store) {
+		is := is.New(t)
+		ctx := context.Background()
+		id, secs := createExclusiveMarket(ctx, is, s, "KNJI", "NOAH", "CSAR")
+		is.NoErr(s.OpenMarket(ctx, id))
+
+		// A third of a share at a time doesn't divide evenly into tokens,
+		// but selling all of it leaves nothing behind, and the rounding
+		// never works in the trader's favour.
+		for i := 0; i < 3; i++ {
+			_, err := s.FulfillOrder(ctx, "cesar", secs[1].Id, id, lmsr.Micros/3, true, "")
+			is.NoErr(err)
+		}
+		_, err := s.FulfillOrder(ctx, "cesar", secs[1].Id, id, 3*(lmsr.Micros/3), false, "")
+		is.NoErr(err)
+
+		p, err := s.GetPortfolio(ctx, "cesar")
+		is.NoErr(err)
+		is.Equal(len(p.Positions), 0)
+		is.True(p.TokensMicros <= 2000*lmsr.Micros)
+		sec, err := s.GetSecurity(ctx, secs[1].Id)
+		is.NoErr(err)
+		is.Equal(sec.SharesOutstandingMicros, int64(0))
+	})
+}
+
+func TestStoresLedger(t *testing.T) {
+	forEachStore(t, func(t *testing.T, s Store) {
+		is := is.New(t)
+		ctx := context.Background()
+		id, secs := createExclusiveMarket(ctx, is, s, "KNJI", "NOAH")
+		is.NoErr(s.OpenMarket(ctx, id))
+
+		is.True(s.GrantTokens(ctx, "josh", 0) != nil)
+		is.NoErr(s.GrantTokens(ctx, "josh", 100*lmsr.Micros))
+		is.Equal(portfolioTokens(s, "josh"), int64(2100*lmsr.Micros))
+		_, err := s.FulfillOrder(ctx, "cesar", secs[0].Id, id, 20*lmsr.Micros, true, "")
+		is.NoErr(err)
+		sold, err := s.FulfillOrder(ctx, "cesar", secs[0].Id, id, 5*lmsr.Micros, false, "")
+		is.NoErr(err)
+		_, err = s.FulfillOrder(ctx, "josh", secs[1].Id, id, 10*lmsr.Micros, true, "")
+		is.NoErr(err)
+		is.NoErr(s.ResolveMarket(ctx, &pb.ResolveMarketRequest{
+			MarketId:    id,
+			Resolutions: []*pb.ResolveMarketRequest_SecurityResolution{{SecurityId: secs[0].Id, Wins: true}},
+		}))
+
+		entries, balance, err := s.GetLedger(ctx, "cesar", "", "")
+		is.NoErr(err)
+		is.Equal(balance, portfolioTokens(s, "cesar"))
+		is.Equal(len(entries), 4)
+		is.Equal(entries[0].Kind, pb.LedgerEntryKind_GRANT)
+		is.Equal(entries[0].FromAccount, "house")
+		is.Equal(entries[1].Kind, pb.LedgerEntryKind_TRADE)
+		is.Equal(entries[1].FromAccount, "user:cesar")
+		is.Equal(entries[1].ToAccount, "market:"+id)
+		is.Equal(entries[1].MarketId, id)
+		is.True(entries[1].OrderId != "")
+		// Selling moves tokens out of the market's escrow.
+		is.Equal(entries[2].FromAccount, "market:"+id)
+		is.Equal(entries[2].AmountMicros, -sold)
+		is.Equal(entries[3].Kind, pb.LedgerEntryKind_PAYOUT)
+		is.Equal(entries[3].AmountMicros, int64(1500*lmsr.Micros))
+		is.Equal(entries[3].BalanceMicros, balance)
+
+		entries, balance, err = s.GetLedger(ctx, "josh", "", "")
+		is.NoErr(err)
+		is.Equal(balance, portfolioTokens(s, "josh"))
+		is.Equal(len(entries), 3)
+		is.Equal(entries[1].BalanceMicros, int64(2100*lmsr.Micros))
+
+		// Filtering by date leaves the balance alone.
+		entries, balance, err = s.GetLedger(ctx, "josh", "2100-01-01T00:00:00Z", "")
+		is.NoErr(err)
+		is.Equal(len(entries), 0)
+		is.Equal(balance, portfolioTokens(s, "josh"))
+
+		_, _, err = s.GetLedger(ctx, "nobody", "", "")
+		is.True(err != nil)
+	})
+}
+
+func TestStoresIdempotentOrders(t *testing.T) {
+	forEachStore(t, func(t *testing.T, s Store) {
+		is := is.New(t)
+		ctx := context.Background()
+		id, secs := createExclusiveMarket(ctx, is, s, "KNJI", "NOAH")
+		is.NoErr(s.OpenMarket(ctx, id))
+
+		cost, err := s.FulfillOrder(ctx, "cesar", secs[0].Id, id, 10*lmsr.Micros, true, "retry-me")
+		is.NoErr(err)
+		tokens := portfolioTokens(s, "cesar")
+		again, err := s.FulfillOrder(ctx, "cesar", secs[0].Id, id, 10*lmsr.Micros, true, "retry-me")
+		is.NoErr(err)
+		is.Equal(again, cost)
+		is.Equal(portfolioTokens(s, "cesar"), tokens)
+		orders, err := s.GetSecurityOrders(ctx, secs[0].Id, "", "")
+		is.NoErr(err)
+		is.Equal(len(orders), 1)
+
+		// The key can't be reused for a different trade, but other users
+		// have keys of their own.
+		_, err = s.FulfillOrder(ctx, "cesar", secs[0].Id, id, 10*lmsr.Micros, false, "retry-me")
+		is.True(err != nil)
+		_, err = s.FulfillOrder(ctx, "cesar", secs[1].Id, id, 10*lmsr.Micros, true, "retry-me")
+		is.True(err != nil)
+		_, err = s.FulfillOrder(ctx, "josh", secs[0].Id, id, 10*lmsr.Micros, true, "retry-me")
+		is.NoErr(err)
+
+		// A retry that comes in after the market closes still gets its
+		// answer.
+		is.NoErr(s.CloseMarket(ctx, id))
+		again, err = s.FulfillOrder(ctx, "cesar", secs[0].Id, id, 10*lmsr.Micros, true, "retry-me")
+		is.NoErr(err)
+		is.Equal(again, cost)
+		_, err = s.FulfillOrder(ctx, "cesar", secs[0].Id, id, 10*lmsr.Micros, true, "too-late")
+		is.True(err != nil)
+	})
+}
+
+func TestStoresOrderBook(t *testing.T) {
+	forEachStore(t, func(t *testing.T, s Store) {
+		is := is.New(t)
+		ctx := context.Background()
+		id, secs := createExclusiveMarket(ctx, is, s, "KNJI", "NOAH")
+		other, otherSecs := createExclusiveMarket(ctx, is, s, "WILL", "JOEL")
+		is.NoErr(s.OpenMarket(ctx, id))
+		is.NoErr(s.OpenMarket(ctx, other))
+		for _, trade := range []struct {
+			username string
+			market   string
+			sec      *pb.Security
+			buy      bool
+		}{
+			{"cesar", id, secs[0], true},
+			{"josh", id, secs[1], true},
+			{"cesar", id, secs[0], false},
+			{"josh", other, otherSecs[0], true},
+			{"josh", id, secs[0], true},
+			{"cesar", id, secs[1], true},
+			{"josh", id, secs[1], false},
+			{"cesar", other, otherSecs[1], true},
+		} {
+			_, err := s.FulfillOrder(ctx, trade.username, trade.sec.Id, trade.market,
+				5*lmsr.Micros, trade.buy, "")
+			is.NoErr(err)
+		}
+
+		all, err := s.GetOrderBook(ctx, &pb.GetOrderBookRequest{
+			SortOrder: pb.GetOrderBookRequest_OLDEST_FIRST, Limit: 1000})
+		is.NoErr(err)
+		is.Equal(all.NextPageToken, "")
+		is.True(len(all.Orders) >= 8)
+		mine := all.Orders[len(all.Orders)-8:]
+		is.Equal(mine[0].Username, "cesar")
+		is.Equal(mine[0].SecurityShortname, "KNJI")
+		is.Equal(mine[2].AmountMicros, int64(-5*lmsr.Micros))
+		markets := map[string]string{}
+		for _, sec := range secs {
+			markets[sec.Id] = id
+		}
+		for _, sec := range otherSecs {
+			markets[sec.Id] = other
+		}
+
+		// Try every combination of filters, reading each in pages of two
+		// and all at once, and check them against filtering the whole book.
+		for mask := 0; mask < 32; mask++ {
+			for side := range pb.GetOrderBookRequest_Side_name {
+				for sortOrder := range pb.GetOrderBookRequest_SortOrder_name {
+					req := &pb.GetOrderBookRequest{
+						Side:      pb.GetOrderBookRequest_Side(side),
+						SortOrder: pb.GetOrderBookRequest_SortOrder(sortOrder),
+					}
+					if mask&1 != 0 {
+						req.MarketId = id
+					}
+					if mask&2 != 0 {
+						req.SecurityId = secs[1].Id
+					}
+					if mask&4 != 0 {
+						req.Username = "josh"
+					}
+					if mask&8 != 0 {
+						req.SinceDate = mine[0].DateCreated
+					}
+					if mask&16 != 0 {
+						req.SinceDate = "2999-01-01T00:00:00Z"
+					}
+					expected := []string{}
+					for _, o := range all.Orders {
+						if (req.MarketId == "" || markets[o.SecurityId] == req.MarketId) &&
+							(req.SecurityId == "" || o.SecurityId == req.SecurityId) &&
+							(req.Username == "" || o.Username == req.Username) &&
+							o.DateCreated >= req.SinceDate &&
+							(req.Side != pb.GetOrderBookRequest_BUYS || o.AmountMicros > 0) &&
+							(req.Side != pb.GetOrderBookRequest_SELLS || o.AmountMicros < 0) {
+							expected = append(expected, o.Id)
+						}
+					}
+					if req.SortOrder == pb.GetOrderBookRequest_NEWEST_FIRST {
+						for i, j := 0, len(expected)-1; i < j; i, j = i+1, j-1 {
+							expected[i], expected[j] = expected[j], expected[i]
+						}
+					}
+
+					resp, err := s.GetOrderBook(ctx, req)
+					is.NoErr(err)
+					is.Equal(resp.NextPageToken, "")
+					got := []string{}
+					for _, o := range resp.Orders {
+						got = append(got, o.Id)
+					}
+					is.Equal(got, expected)
+
+					req.Limit = 2
+					paged := []string{}
+					for {
+						resp, err := s.GetOrderBook(ctx, req)
+						is.NoErr(err)
+						is.True(len(resp.Orders) <= 2)
+						for _, o := range resp.Orders {
+							paged = append(paged, o.Id)
+						}
+						if resp.NextPageToken == "" {
+							break
+						}
+						req.PageToken = resp.NextPageToken
+					}
+					is.Equal(paged, expected)
+				}
+			}
+		}
+
+		_, err = s.GetOrderBook(ctx, &pb.GetOrderBookRequest{PageToken: "not a token"})
+		is.True(err != nil)
+		_, err = s.GetOrderBook(ctx, &pb.GetOrderBookRequest{Limit: 1001})
+		is.True(err != nil)
+	})
+}
+
+func TestStoresMarketStatuses(t *testing.T) {
+	forEachStore(t, func(t *testing.T, s Store) {
+		is := is.New(t)
+		ctx := context.Background()
+		draft, _ := createExclusiveMarket(ctx, is, s, "KNJI", "NOAH")
+		open, _ := createExclusiveMarket(ctx, is, s, "KNJI", "NOAH")
+		is.NoErr(s.OpenMarket(ctx, open))
+		closed, _ := createExclusiveMarket(ctx, is, s, "KNJI", "NOAH")
+		is.NoErr(s.OpenMarket(ctx, closed))
+		is.NoErr(s.CloseMarket(ctx, closed))
+		resolved, secs := createExclusiveMarket(ctx, is, s, "KNJI", "NOAH")
+		is.NoErr(s.OpenMarket(ctx, resolved))
+		is.NoErr(s.ResolveMarket(ctx, &pb.ResolveMarketRequest{
+			MarketId:    resolved,
+			Resolutions: []*pb.ResolveMarketRequest_SecurityResolution{{SecurityId: secs[0].Id, Wins: true}},
+		}))
+		ours := map[string]bool{draft: true, open: true, closed: true, resolved: true}
+
+		for _, tc := range []struct {
+			statuses []pb.Market_Status
+			expected []string
+		}{
+			{nil, []string{draft, open, closed, resolved}},
+			{[]pb.Market_Status{pb.Market_DRAFT}, []string{draft}},
+			{[]pb.Market_Status{pb.Market_OPEN}, []string{open}},
+			{[]pb.Market_Status{pb.Market_CLOSED}, []string{closed}},
+			{[]pb.Market_Status{pb.Market_RESOLVED}, []string{resolved}},
+			{[]pb.Market_Status{pb.Market_RESOLVED, pb.Market_DRAFT}, []string{draft, resolved}},
+		} {
+			markets, err := s.GetMarkets(ctx, tc.statuses...)
+			is.NoErr(err)
+			got := []string{}
+			for _, m := range markets {
+				if ours[m.Id] {
+					got = append(got, m.Id)
+				}
+			}
+			is.Equal(got, tc.expected)
+		}
+	})
+}
+
+func TestStoresMarketLifecycle(t *testing.T) {
+	forEachStore(t, func(t *testing.T, s Store) {
+		is := is.New(t)
+		ctx := context.Background()
+		id, secs := createExclusiveMarket(ctx, is, s, "KNJI", "NOAH")
+		status := func() pb.Market_Status {
+			m, err := s.GetMarket(ctx, id)
+			is.NoErr(err)
+			return m.Status
+		}
+		buy := func() error {
+			_, err := s.FulfillOrder(ctx, "cesar", secs[0].Id, id, lmsr.Micros, true, "")
+			return err
+		}
+
+		is.Equal(status(), pb.Market_DRAFT)
+		is.True(s.HaltMarket(ctx, id) != nil)
+		is.True(s.CloseMarket(ctx, id) != nil)
+		is.True(buy() != nil)
+
+		// Scheduling a draft can be undone, and it's still being set up in
+		// the meantime.
+		is.NoErr(s.ScheduleMarket(ctx, &pb.ScheduleMarketRequest{
+			Id: id, OpenAt: "2999-01-01T00:00:00Z", CloseAt: "2999-01-02T00:00:00Z"}))
+		is.Equal(status(), pb.Market_SCHEDULED)
+		m, err := s.GetMarket(ctx, id)
+		is.NoErr(err)
+		is.Equal(m.OpenAt, "2999-01-01T00:00:00Z")
+		is.Equal(m.CloseAt, "2999-01-02T00:00:00Z")
+		is.NoErr(s.AddSecurities(ctx, id, []*pb.AddSecuritiesRequest_Security{
+			{Description: "Josh wins nationals", Shortname: "JOSH"}}))
+		is.NoErr(s.ScheduleMarket(ctx, &pb.ScheduleMarketRequest{Id: id}))
+		is.Equal(status(), pb.Market_DRAFT)
+		for _, bad := range []*pb.ScheduleMarketRequest{
+			{Id: id, OpenAt: "tomorrow"},
+			{Id: id, CloseAt: "2999-01-01"},
+			{Id: id, OpenAt: "2999-01-02T00:00:00Z", CloseAt: "2999-01-01T00:00:00Z"},
+		} {
+			is.True(s.ScheduleMarket(ctx, bad) != nil)
+		}
+		is.Equal(status(), pb.Market_DRAFT)
+
+		is.NoErr(s.OpenMarket(ctx, id))
+		is.Equal(status(), pb.Market_OPEN)
+		is.NoErr(buy())
+		is.True(s.ScheduleMarket(ctx, &pb.ScheduleMarketRequest{Id: id, OpenAt: "2999-01-01T00:00:00Z"}) != nil)
+		is.NoErr(s.ScheduleMarket(ctx, &pb.ScheduleMarketRequest{Id: id, CloseAt: "2999-01-01T00:00:00Z"}))
+		is.Equal(status(), pb.Market_OPEN)
+
+		is.NoErr(s.HaltMarket(ctx, id))
+		is.Equal(status(), pb.Market_HALTED)
+		is.True(buy() != nil)
+		is.True(s.HaltMarket(ctx, id) != nil)
+		is.NoErr(s.OpenMarket(ctx, id))
+		is.NoErr(buy())
+
+		is.NoErr(s.CloseMarket(ctx, id))
+		is.Equal(status(), pb.Market_CLOSED)
+		is.True(buy() != nil)
+		is.True(s.CloseMarket(ctx, id) != nil)
+		is.True(s.HaltMarket(ctx, id) != nil)
+		is.True(s.DeleteMarket(ctx, id) != nil)
+		is.NoErr(s.OpenMarket(ctx, id))
+		is.NoErr(s.CloseMarket(ctx, id))
+
+		is.NoErr(s.ResolveMarket(ctx, &pb.ResolveMarketRequest{
+			MarketId:    id,
+			Resolutions: []*pb.ResolveMarketRequest_SecurityResolution{{SecurityId: secs[0].Id, Wins: true}},
+		}))
+		is.Equal(status(), pb.Market_RESOLVED)
+		is.True(s.OpenMarket(ctx, id) != nil)
+		is.True(s.CloseMarket(ctx, id) != nil)
+		is.True(s.ScheduleMarket(ctx, &pb.ScheduleMarketRequest{Id: id}) != nil)
+	})
+}
+
+func TestStoresScheduler(t *testing.T) {
+	forEachStore(t, func(t *testing.T, s Store) {
+		is := is.New(t)
+		ctx := context.Background()
+		opens, _ := createExclusiveMarket(ctx, is, s, "KNJI", "NOAH")
+		later, _ := createExclusiveMarket(ctx, is, s, "KNJI", "NOAH")
+		closes, _ := createExclusiveMarket(ctx, is, s, "KNJI", "NOAH")
+		missed, _ := createExclusiveMarket(ctx, is, s, "KNJI", "NOAH")
+		is.NoErr(s.ScheduleMarket(ctx, &pb.ScheduleMarketRequest{
+			Id: opens, OpenAt: "2022-07-01T10:00:00Z", CloseAt: "2022-07-01T12:00:00Z"}))
+		is.NoErr(s.ScheduleMarket(ctx, &pb.ScheduleMarketRequest{
+			Id: later, OpenAt: "2022-07-01T11:00:00Z"}))
+		is.NoErr(s.OpenMarket(ctx, closes))
+		is.NoErr(s.HaltMarket(ctx, closes))
+		is.NoErr(s.ScheduleMarket(ctx, &pb.ScheduleMarketRequest{
+			Id: closes, CloseAt: "2022-07-01T10:00:00-04:00"}))
+		is.NoErr(s.ScheduleMarket(ctx, &pb.ScheduleMarketRequest{
+			Id: missed, OpenAt: "2022-07-01T08:00:00Z", CloseAt: "2022-07-01T09:00:00Z"}))
+		status := func(id string) pb.Market_Status {
+			m, err := s.GetMarket(ctx, id)
+			is.NoErr(err)
+			return m.Status
+		}
+
+		sched := NewScheduler(s, time.Minute)
+		now, err := time.Parse(time.RFC3339, "2022-07-01T10:30:00Z")
+		is.NoErr(err)
+		is.NoErr(sched.Tick(ctx, now))
+		is.Equal(status(opens), pb.Market_OPEN)
+		is.Equal(status(later), pb.Market_SCHEDULED)
+		// 10am in New York is still to come.
+		is.Equal(status(closes), pb.Market_HALTED)
+		is.Equal(status(missed), pb.Market_CLOSED)
+
+		is.NoErr(sched.Tick(ctx, now.Add(4*time.Hour)))
+		is.Equal(status(opens), pb.Market_CLOSED)
+		is.Equal(status(later), pb.Market_OPEN)
+		is.Equal(status(closes), pb.Market_CLOSED)
+
+		// A closed market that's reopened stays open.
+		is.NoErr(s.OpenMarket(ctx, missed))
+		m, err := s.GetMarket(ctx, missed)
+		is.NoErr(err)
+		is.Equal(m.CloseAt, "")
+		is.NoErr(sched.Tick(ctx, now.Add(4*time.Hour)))
+		is.Equal(status(missed), pb.Market_OPEN)
+	})
+}
+
+func TestStoresGameResults(t *testing.T) {
+	forEachStore(t, func(t *testing.T, s Store) {
+		is := is.New(t)
+		ctx := context.Background()
+		rs := s.(ResultsStore)
+		id, _ := createExclusiveMarket(ctx, is, s, "KNJI", "NOAH")
+		evts, unsubscribe := s.Events().Subscribe(10)
+		defer unsubscribe()
+
+		changed, err := rs.SubmitGameResults(ctx, id, []*pb.GameResult{
+			{Round: 2, Player: "Noah", Opponent: "Kenji", PlayerScore: 380, OpponentScore: 455},
+			{Round: 1, Player: "Kenji", Opponent: "Noah", PlayerScore: 400, OpponentScore: 420},
+		})
+		is.NoErr(err)
+		is.Equal(changed, 2)
+		e := <-evts
+		is.Equal(e.Type, events.GameResults)
+		is.Equal(e.Rounds, []int32{1, 2})
+
+		// The same game reported for the other player, and a correction.
+		changed, err = rs.SubmitGameResults(ctx, id, []*pb.GameResult{
+			{Round: 1, Player: "Noah", Opponent: "Kenji", PlayerScore: 420, OpponentScore: 400},
+			{Round: 2, Player: "Kenji", Opponent: "Noah", PlayerScore: 456, OpponentScore: 380},
+		})
+		is.NoErr(err)
+		is.Equal(changed, 1)
+		is.Equal((<-evts).Rounds, []int32{2})
+
+		results, err := rs.GetGameResults(ctx, id)
+		is.NoErr(err)
+		is.Equal(len(results), 2)
+		is.Equal(results[0].Round, int32(1))
+		is.Equal(results[0].Player, "Kenji")
+		is.Equal(results[0].OpponentScore, int32(420))
+		is.Equal(results[1].PlayerScore, int32(456))
+
+		_, err = rs.SubmitGameResults(ctx, id, []*pb.GameResult{{Round: 0, Player: "Kenji"}})
+		is.True(err != nil)
+	})
+}
+
+func TestStoresScoreMarket(t *testing.T) {
+	forEachStore(t, func(t *testing.T, s Store) {
+		is := is.New(t)
+		ctx := context.Background()
+		as := s.(AnalyticsStore)
+		id, secs := createExclusiveMarket(ctx, is, s, "KNJI", "NOAH")
+		is.NoErr(s.OpenMarket(ctx, id))
+		_, err := s.FulfillOrder(ctx, "cesar", secs[0].Id, id, 10*lmsr.Micros, true, "")
+		is.NoErr(err)
+		sec, err := s.GetSecurity(ctx, secs[0].Id)
+		is.NoErr(err)
+		is.NoErr(s.ResolveMarket(ctx, &pb.ResolveMarketRequest{
+			MarketId:    id,
+			Resolutions: []*pb.ResolveMarketRequest_SecurityResolution{{SecurityId: secs[0].Id, Wins: true}},
+		}))
+
+		scores, err := as.GetMarketScores(ctx, id)
+		is.NoErr(err)
+		is.Equal(len(scores), 4)
+		is.Equal(scores[0].Checkpoint, pb.ScoreCheckpoint_TIME_WEIGHTED)
+		is.Equal(scores[3].Checkpoint, pb.ScoreCheckpoint_CLOSE)
+		// The market closed with Kenji at sec.LastPrice.
+		p := sec.LastPrice / lmsr.MaxPayout
+		is.True(math.Abs(scores[3].Brier-2*(1-p)*(1-p)) < 1e-9)
+
+		// Voided markets aren't scored.
+		other, _ := createExclusiveMarket(ctx, is, s, "CSAR", "JOSH")
+		is.NoErr(s.OpenMarket(ctx, other))
+		is.NoErr(s.VoidMarket(ctx, other))
+		all, err := as.GetMarketScores(ctx, "")
+		is.NoErr(err)
+		is.Equal(len(all), 4)
+	})
+}
+
+func TestStoresLeaderboard(t *testing.T) {
+	forEachStore(t, func(t *testing.T, s Store) {
+		is := is.New(t)
+		ctx := context.Background()
+		as := s.(AnalyticsStore)
+		near := func(a, b float64) bool { return math.Abs(a-b) < 1e-6 }
+		id, secs := createExclusiveMarket(ctx, is, s, "KNJI", "NOAH")
+		is.NoErr(s.OpenMarket(ctx, id))
+		cesarCost, err := s.FulfillOrder(ctx, "cesar", secs[0].Id, id, 10*lmsr.Micros, true, "")
+		is.NoErr(err)
+		_, err = s.FulfillOrder(ctx, "josh", secs[1].Id, id, 10*lmsr.Micros, true, "")
+		is.NoErr(err)
+		open, openSecs := createExclusiveMarket(ctx, is, s, "CSAR", "JOSH")
+		is.NoErr(s.OpenMarket(ctx, open))
+		_, err = s.FulfillOrder(ctx, "josh", openSecs[1].Id, open, 5*lmsr.Micros, true, "")
+		is.NoErr(err)
+		is.NoErr(s.ResolveMarket(ctx, &pb.ResolveMarketRequest{
+			MarketId:    id,
+			Resolutions: []*pb.ResolveMarketRequest_SecurityResolution{{SecurityId: secs[0].Id, Wins: true}},
+		}))
+		// A later grant isn't part of what josh started with.
+		is.NoErr(s.GrantTokens(ctx, "josh", 500*lmsr.Micros))
+
+		resp, err := as.GetLeaderboard(ctx, &pb.GetLeaderboardRequest{})
+		is.NoErr(err)
+		is.Equal(resp.Total, int32(2))
+		joshSec, err := s.GetSecurity(ctx, openSecs[1].Id)
+		is.NoErr(err)
+		for _, e := range resp.Entries {
+			is.True(near(e.StartingTokens, 2000))
+			switch e.Username {
+			case "cesar":
+				is.True(near(e.PortfolioValue, lmsr.FromMicros(portfolioTokens(s, "cesar"))))
+				is.True(near(e.RealizedPnl, 1000-lmsr.FromMicros(cesarCost)))
+				is.True(near(e.ReturnOnStart, e.RealizedPnl/2000))
+			case "josh":
+				is.True(near(e.PortfolioValue,
+					lmsr.FromMicros(portfolioTokens(s, "josh"))+5*joshSec.LastPrice))
+				is.True(e.RealizedPnl < 0)
+				is.True(e.UnrealizedPnl != 0)
+			}
+		}
+		is.True(resp.Entries[0].PortfolioValue >= resp.Entries[1].PortfolioValue)
+
+		resp, err = as.GetLeaderboard(ctx, &pb.GetLeaderboardRequest{MarketId: open})
+		is.NoErr(err)
+		is.Equal(resp.Total, int32(1))
+		is.Equal(resp.Entries[0].Username, "josh")
+		is.Equal(resp.Entries[0].RealizedPnl, 0.0)
+
+		resp, err = as.GetLeaderboard(ctx, &pb.GetLeaderboardRequest{Limit: 1, Offset: 1})
+		is.NoErr(err)
+		is.Equal(len(resp.Entries), 1)
+		is.Equal(resp.Entries[0].Rank, int32(2))
+	})
+}
+
+func TestStoresAudit(t *testing.T) {
+	forEachStore(t, func(t *testing.T, s Store) {
+		is := is.New(t)
+		ctx := context.Background()
+		as := s.(AuditStore)
+		id, secs := createExclusiveMarket(ctx, is, s, "KNJI", "NOAH", "CSAR")
+		is.NoErr(s.OpenMarket(ctx, id))
+		_, err := s.FulfillOrder(ctx, "cesar", secs[2].Id, id, 10*lmsr.Micros, true, "")
+		is.NoErr(err)
+		_, err = s.FulfillOrder(ctx, "josh", secs[0].Id, id, 5*lmsr.Micros, true, "")
+		is.NoErr(err)
+		_, err = s.FulfillOrder(ctx, "josh", secs[0].Id, id, 2*lmsr.Micros, false, "")
+		is.NoErr(err)
+		// Seeded shares are outstanding, but nobody holds them.
+		seeded, err := s.CreateMarket(ctx, &pb.CreateMarketRequest{Description: "Seeded"})
+		is.NoErr(err)
+		is.NoErr(s.AddSecurities(ctx, seeded, []*pb.AddSecuritiesRequest_Security{
+			{Shortname: "A", InitialProbability: 0.75},
+			{Shortname: "B", InitialProbability: 0.25},
+		}))
+		is.NoErr(s.OpenMarket(ctx, seeded))
+		seededSecs, err := s.GetSecurities(ctx, seeded)
+		is.NoErr(err)
+		_, err = s.FulfillOrder(ctx, "cesar", seededSecs[1].Id, seeded, 3*lmsr.Micros, true, "")
+		is.NoErr(err)
+		is.NoErr(s.ResolveMarket(ctx, &pb.ResolveMarketRequest{
+			MarketId:    seeded,
+			Resolutions: []*pb.ResolveMarketRequest_SecurityResolution{{SecurityId: seededSecs[1].Id, Wins: true}},
+		}))
+		is.NoErr(s.GrantTokens(ctx, "josh", 100*lmsr.Micros))
+
+		violations, err := as.Audit(ctx)
+		is.NoErr(err)
+		is.Equal(len(violations), 0)
+	})
+}
@@ -0,0 +1,70 @@
+package marketapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/domino14/scrabfutures/pkg/events"
+)
+
+// EventStreamPath is where the event stream is served.
+const EventStreamPath = "/events"
+
+// heartbeatInterval is how often an idle event stream sends a comment, so
+// that proxies don't close the connection.
+const heartbeatInterval = 30 * time.Second
+
+// EventStream serves the events published to a hub as server-sent events,
+// named by their type with the event as JSON data. A market_id query
+// parameter limits the stream to one market's events.
+type EventStream struct {
+	hub *events.Hub
+}
+
+func NewEventStream(hub *events.Hub) *EventStream {
+	return &EventStream{hub: hub}
+}
+
+func (es *EventStream) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+	marketID := r.URL.Query().Get("market_id")
+
+	evts, unsubscribe := es.hub.Subscribe(64)
+	defer unsubscribe()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	fmt.Fprint(w, ": connected\n\n")
+	flusher.Flush()
+
+	heartbeat := time.NewTicker(heartbeatInterval)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-heartbeat.C:
+			fmt.Fprint(w, ": ping\n\n")
+		case e, ok := <-evts:
+			if !ok {
+				return
+			}
+			if marketID != "" && e.MarketID != marketID {
+				continue
+			}
+			data, err := json.Marshal(e)
+			if err != nil {
+				continue
+			}
+			fmt.Fprintf(w, "event: %s\ndata: %s\n\n", e.Type, data)
+		}
+		flusher.Flush()
+	}
+}
@@ -0,0 +1,93 @@
+package marketapi
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/matryer/is"
+
+	"github.com/domino14/scrabfutures/pkg/events"
+	"github.com/domino14/scrabfutures/pkg/lmsr"
+	pb "github.com/domino14/scrabfutures/rpc/proto"
+)
+
+// readEvent reads the next event from a server-sent event stream, skipping
+// comments.
+func readEvent(r *bufio.Reader) (string, events.Event, error) {
+	var name string
+	var e events.Event
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return "", e, err
+		}
+		line = strings.TrimRight(line, "\n")
+		switch {
+		case strings.HasPrefix(line, "event: "):
+			name = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			err = json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &e)
+			if err != nil {
+				return "", e, err
+			}
+		case line == "" && name != "":
+			return name, e, nil
+		}
+	}
+}
+
+func TestEventStream(t *testing.T) {
+	initDB()
+	addFixtures("./testfixtures/basic.sql")
+	is := is.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s, _ := NewSqliteStore(cfg.DBPath)
+	srv := httptest.NewServer(NewEventStream(s.Events()))
+	defer srv.Close()
+
+	req, _ := http.NewRequestWithContext(ctx, "GET", srv.URL+"?market_id=nationals2022", nil)
+	resp, err := http.DefaultClient.Do(req)
+	is.NoErr(err)
+	defer resp.Body.Close()
+	is.Equal(resp.Header.Get("Content-Type"), "text/event-stream")
+	r := bufio.NewReader(resp.Body)
+	// Once the stream says it's connected, it's subscribed.
+	line, err := r.ReadString('\n')
+	is.NoErr(err)
+	is.Equal(line, ": connected\n")
+
+	// Events for other markets are filtered out.
+	other, _ := s.CreateMarket(ctx, &pb.CreateMarketRequest{Description: "other"})
+	is.NoErr(s.OpenMarket(ctx, other))
+
+	is.NoErr(s.OpenMarket(ctx, "nationals2022"))
+	name, e, err := readEvent(r)
+	is.NoErr(err)
+	is.Equal(name, "market_opened")
+	is.Equal(e.MarketID, "nationals2022")
+
+	_, err = s.FulfillOrder(ctx, "cesar", "S3uuid", "nationals2022", 10*lmsr.Micros, true, "")
+	is.NoErr(err)
+	name, e, err = readEvent(r)
+	is.NoErr(err)
+	is.Equal(name, "prices")
+	is.Equal(len(e.Prices), 4)
+	sec, _ := s.GetSecurity(ctx, "S3uuid")
+	is.Equal(e.Prices[2], events.SecurityPrice{SecurityID: "S3uuid", Price: sec.LastPrice})
+
+	is.NoErr(s.ResolveMarket(ctx, &pb.ResolveMarketRequest{
+		MarketId: "nationals2022",
+		Resolutions: []*pb.ResolveMarketRequest_SecurityResolution{
+			{SecurityId: "S3uuid", Wins: true},
+		},
+	}))
+	name, _, err = readEvent(r)
+	is.NoErr(err)
+	is.Equal(name, "market_resolved")
+}
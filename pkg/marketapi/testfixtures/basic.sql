@@ -5,12 +5,18 @@ values
     (1, "cesar", "delsolar@gmail.com", "foo"),
     (2, "josh", "josh@gmail.com", "foo");
 
+-- 2000 tokens each, in micro-tokens.
 INSERT INTO portfolios(user_id, tokens)
 values
-    (1, 2000),
-    (2, 2000);
+    (1, 2000000000),
+    (2, 2000000000);
 
-INSERT INTO markets(id, uuid, description, date_created, is_open)
+INSERT INTO ledger(uuid, kind, from_account, to_account, amount, date)
+values
+    ("L1uuid", "grant", "house", "user:cesar", 2000000000, "2022-07-01T00:00:00Z"),
+    ("L2uuid", "grant", "house", "user:josh", 2000000000, "2022-07-01T00:00:00Z");
+
+INSERT INTO markets(id, uuid, description, date_created, status)
 values
     (1, "nationals2022", "Nationals 2022", "2022-07-08T14:00:00Z", 0);
 
@@ -0,0 +1,17 @@
+-- the users from basic.sql, for the postgres store.
+
+INSERT INTO users(id, username, email, password)
+VALUES
+    (1, 'cesar', 'delsolar@gmail.com', 'foo'),
+    (2, 'josh', 'josh@gmail.com', 'foo');
+
+-- 2000 tokens each, in micro-tokens.
+INSERT INTO portfolios(user_id, tokens)
+VALUES
+    (1, 2000000000),
+    (2, 2000000000);
+
+INSERT INTO ledger(uuid, kind, from_account, to_account, amount, date)
+VALUES
+    ('L1uuid', 'grant', 'house', 'user:cesar', 2000000000, '2022-07-01T00:00:00Z'),
+    ('L2uuid', 'grant', 'house', 'user:josh', 2000000000, '2022-07-01T00:00:00Z');
@@ -0,0 +1,41 @@
+// package rating turns player ratings into win probabilities, using the
+// logistic model that Elo-style rating systems are built on.
+package rating
+
+import "math"
+
+// Scale is the rating difference at which the stronger player is expected
+// to win ten games for every one that the weaker player wins.
+const Scale = float64(400.0)
+
+// WinProbability returns the probability that a player rated a beats a
+// player rated b in a single game.
+func WinProbability(a, b float64) float64 {
+	return 1 / (1 + math.Pow(10, (b-a)/Scale))
+}
+
+// FieldProbabilities returns the probability that each player finishes
+// ahead of everyone else in a field, if each player's chances are
+// proportional to 10^(rating/Scale). For two players this is the same as
+// WinProbability. It is a rough estimate for a whole tournament, since it
+// ignores how many games are played.
+func FieldProbabilities(ratings []float64) []float64 {
+	if len(ratings) == 0 {
+		return nil
+	}
+	max := ratings[0]
+	for _, r := range ratings {
+		max = math.Max(max, r)
+	}
+	// Subtract the highest rating to avoid overflowing.
+	strengths := make([]float64, len(ratings))
+	sum := float64(0)
+	for idx, r := range ratings {
+		strengths[idx] = math.Pow(10, (r-max)/Scale)
+		sum += strengths[idx]
+	}
+	for idx := range strengths {
+		strengths[idx] /= sum
+	}
+	return strengths
+}
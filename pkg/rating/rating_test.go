@@ -0,0 +1,33 @@
+package rating
+
+import (
+	"math"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+const Epsilon = 1e-9
+
+func withinEpsilon(a, b float64) bool {
+	return math.Abs(a-b) < Epsilon
+}
+
+func TestWinProbability(t *testing.T) {
+	is := is.New(t)
+	is.True(withinEpsilon(WinProbability(1800, 1800), 0.5))
+	is.True(withinEpsilon(WinProbability(2200, 1800), 10.0/11))
+	is.True(withinEpsilon(WinProbability(1800, 2200), 1.0/11))
+}
+
+func TestFieldProbabilities(t *testing.T) {
+	is := is.New(t)
+	probs := FieldProbabilities([]float64{2200, 1800})
+	is.True(withinEpsilon(probs[0], WinProbability(2200, 1800)))
+	is.True(withinEpsilon(probs[1], WinProbability(1800, 2200)))
+
+	probs = FieldProbabilities([]float64{2000, 2000, 2000, 2000})
+	for _, p := range probs {
+		is.True(withinEpsilon(p, 0.25))
+	}
+}
@@ -0,0 +1,96 @@
+// package scoring measures how well a market predicted its outcome, by
+// scoring the prices it traded at against what its securities paid out.
+//
+// The Brier score is the sum, over securities, of the squared difference
+// between price and outcome. Log-loss depends on whether the securities are
+// exclusive: if they are, it is the cross-entropy -Σ o ln p; otherwise (as
+// in ranking markets) each security is scored as its own binary forecast,
+// -Σ [o ln p + (1-o) ln(1-p)]. Lower is better for both.
+package scoring
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// epsilon keeps log-loss finite when a price reaches 0 or 1.
+const epsilon = 1e-9
+
+// Point is a security's price, as a probability, from Time onwards.
+type Point struct {
+	Time  time.Time
+	Price float64
+}
+
+// Forecast is a security's price history, oldest first, and how much of the
+// full payout it resolved to, from 0 to 1.
+type Forecast struct {
+	Prices  []Point
+	Outcome float64
+}
+
+type Score struct {
+	Brier   float64
+	LogLoss float64
+}
+
+// priceAt is the last price at or before t. Before the first price, it is
+// the first price.
+func (f Forecast) priceAt(t time.Time) float64 {
+	idx := sort.Search(len(f.Prices), func(i int) bool {
+		return f.Prices[i].Time.After(t)
+	})
+	if idx == 0 {
+		return f.Prices[0].Price
+	}
+	return f.Prices[idx-1].Price
+}
+
+// At scores the market as it was priced at time t. Every forecast must have
+// at least one price.
+func At(forecasts []Forecast, exclusive bool, t time.Time) Score {
+	s := Score{}
+	for _, f := range forecasts {
+		p := f.priceAt(t)
+		s.Brier += (p - f.Outcome) * (p - f.Outcome)
+		clamped := math.Max(epsilon, math.Min(1-epsilon, p))
+		s.LogLoss -= f.Outcome * math.Log(clamped)
+		if !exclusive {
+			s.LogLoss -= (1 - f.Outcome) * math.Log(1-clamped)
+		}
+	}
+	return s
+}
+
+// TimeWeighted averages the market's score over the time from start to end,
+// weighting each price by how long it stood. If end is not after start, it
+// is the score at start.
+func TimeWeighted(forecasts []Forecast, exclusive bool, start, end time.Time) Score {
+	if !end.After(start) {
+		return At(forecasts, exclusive, start)
+	}
+	changes := []time.Time{start}
+	for _, f := range forecasts {
+		for _, p := range f.Prices {
+			if p.Time.After(start) && p.Time.Before(end) {
+				changes = append(changes, p.Time)
+			}
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Before(changes[j]) })
+	changes = append(changes, end)
+
+	total := Score{}
+	for idx := 0; idx < len(changes)-1; idx++ {
+		weight := changes[idx+1].Sub(changes[idx]).Seconds()
+		if weight == 0 {
+			continue
+		}
+		s := At(forecasts, exclusive, changes[idx])
+		total.Brier += s.Brier * weight
+		total.LogLoss += s.LogLoss * weight
+	}
+	duration := end.Sub(start).Seconds()
+	return Score{Brier: total.Brier / duration, LogLoss: total.LogLoss / duration}
+}
@@ -0,0 +1,67 @@
+package scoring
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+)
+
+var t0 = time.Date(2022, 7, 8, 14, 0, 0, 0, time.UTC)
+
+func hours(h float64) time.Time {
+	return t0.Add(time.Duration(h * float64(time.Hour)))
+}
+
+func near(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestAt(t *testing.T) {
+	is := is.New(t)
+	forecasts := []Forecast{
+		{Prices: []Point{{hours(0), 0.5}, {hours(1), 0.8}}, Outcome: 1},
+		{Prices: []Point{{hours(0), 0.5}, {hours(1), 0.2}}, Outcome: 0},
+	}
+	s := At(forecasts, true, hours(0))
+	is.True(near(s.Brier, 0.5))
+	is.True(near(s.LogLoss, math.Log(2)))
+
+	// Prices hold until they change, and the first price holds before the
+	// market opened.
+	s = At(forecasts, true, hours(2))
+	is.True(near(s.Brier, 0.08))
+	is.True(near(s.LogLoss, -math.Log(0.8)))
+	is.Equal(At(forecasts, true, hours(-1)), At(forecasts, true, hours(0)))
+
+	// Scored as separate binary forecasts, both securities count towards
+	// log-loss.
+	s = At(forecasts, false, hours(2))
+	is.True(near(s.LogLoss, -2*math.Log(0.8)))
+}
+
+func TestAtClampsCertainty(t *testing.T) {
+	is := is.New(t)
+	s := At([]Forecast{{Prices: []Point{{hours(0), 0}}, Outcome: 1}}, true, hours(0))
+	is.True(near(s.Brier, 1))
+	is.True(!math.IsInf(s.LogLoss, 1))
+	is.True(s.LogLoss > 20)
+}
+
+func TestTimeWeighted(t *testing.T) {
+	is := is.New(t)
+	forecasts := []Forecast{
+		{Prices: []Point{{hours(0), 0.5}, {hours(3), 1}}, Outcome: 1},
+		{Prices: []Point{{hours(0), 0.5}, {hours(3), 0}}, Outcome: 0},
+	}
+	// Three hours at a Brier score of 0.5, then one hour of perfection.
+	s := TimeWeighted(forecasts, true, hours(0), hours(4))
+	is.True(near(s.Brier, 0.375))
+	is.True(near(s.LogLoss, 3*math.Log(2)/4))
+
+	// Price changes outside of the window don't count.
+	s = TimeWeighted(forecasts, true, hours(3), hours(5))
+	is.True(near(s.Brier, 0))
+	is.Equal(TimeWeighted(forecasts, true, hours(1), hours(1)), At(forecasts, true, hours(1)))
+}
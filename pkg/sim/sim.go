@@ -0,0 +1,182 @@
+// package sim estimates each player's chances of winning a tournament by
+// simulating it many times, with the result of every game drawn from the
+// players' ratings.
+package sim
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+
+	"github.com/domino14/scrabfutures/pkg/rating"
+)
+
+type PairingSystem int
+
+const (
+	// RoundRobin pairs every player with every other player, repeating the
+	// schedule if there are more rounds than that.
+	RoundRobin PairingSystem = iota
+	// Swiss pairs players with similar records, in order of standings,
+	// avoiding rematches where possible.
+	Swiss
+	// KingOfTheHill pairs first with second, third with fourth, and so on,
+	// rematches and all.
+	KingOfTheHill
+)
+
+// ByeSpread is the spread awarded for a bye, which also counts as a win.
+const ByeSpread = 50
+
+// SpreadStdDev is the standard deviation of the margin of victory in a game.
+// Spread only matters for breaking ties in the standings.
+const SpreadStdDev = 80
+
+type Tournament struct {
+	Ratings []float64
+	Rounds  int
+	Pairing PairingSystem
+}
+
+type record struct {
+	player int
+	wins   float64
+	spread float64
+}
+
+// WinProbabilities simulates the tournament n times and returns the
+// probability that each player finishes in first place.
+func WinProbabilities(t Tournament, n int, rng *rand.Rand) []float64 {
+	probs := make([]float64, len(t.Ratings))
+	if len(t.Ratings) == 0 || n <= 0 {
+		return probs
+	}
+	for i := 0; i < n; i++ {
+		probs[t.simulate(rng)]++
+	}
+	for idx := range probs {
+		probs[idx] /= float64(n)
+	}
+	return probs
+}
+
+// simulate plays the tournament once and returns the winner.
+func (t Tournament) simulate(rng *rand.Rand) int {
+	records := make([]*record, len(t.Ratings))
+	for idx := range records {
+		records[idx] = &record{player: idx}
+	}
+	played := map[[2]int]bool{}
+
+	for round := 0; round < t.Rounds; round++ {
+		for _, pair := range t.pair(round, records, played) {
+			a, b := pair[0], pair[1]
+			if b == -1 {
+				records[a].wins++
+				records[a].spread += ByeSpread
+				continue
+			}
+			played[[2]int{a, b}] = true
+			played[[2]int{b, a}] = true
+			winner, loser := a, b
+			if rng.Float64() >= rating.WinProbability(t.Ratings[a], t.Ratings[b]) {
+				winner, loser = b, a
+			}
+			margin := math.Abs(rng.NormFloat64() * SpreadStdDev)
+			records[winner].wins++
+			records[winner].spread += margin
+			records[loser].spread -= margin
+		}
+	}
+	return standings(records)[0].player
+}
+
+// standings sorts a copy of the records from first place to last.
+func standings(records []*record) []*record {
+	sorted := append([]*record{}, records...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].wins != sorted[j].wins {
+			return sorted[i].wins > sorted[j].wins
+		}
+		return sorted[i].spread > sorted[j].spread
+	})
+	return sorted
+}
+
+// pair returns the pairings for a round as pairs of player indexes. The
+// second player is -1 for a bye.
+func (t Tournament) pair(round int, records []*record, played map[[2]int]bool) [][2]int {
+	switch t.Pairing {
+	case RoundRobin:
+		return roundRobinPairings(len(records), round)
+	case Swiss:
+		return standingsPairings(standings(records), played)
+	default:
+		return standingsPairings(standings(records), nil)
+	}
+}
+
+// roundRobinPairings pairs players using the circle method: one player stays
+// put and everyone else rotates around them each round.
+func roundRobinPairings(players int, round int) [][2]int {
+	circle := []int{}
+	for i := 0; i < players; i++ {
+		circle = append(circle, i)
+	}
+	if players%2 == 1 {
+		circle = append(circle, -1)
+	}
+	n := len(circle)
+	if n < 2 {
+		return nil
+	}
+	rotation := round % (n - 1)
+	rotated := []int{circle[0]}
+	for i := 0; i < n-1; i++ {
+		rotated = append(rotated, circle[1+(i+rotation)%(n-1)])
+	}
+	pairings := [][2]int{}
+	for i := 0; i < n/2; i++ {
+		a, b := rotated[i], rotated[n-1-i]
+		if a == -1 {
+			a, b = b, a
+		}
+		pairings = append(pairings, [2]int{a, b})
+	}
+	return pairings
+}
+
+// standingsPairings pairs each unpaired player, in order of standings, with
+// the next player below them that they haven't played yet, or with the next
+// player below them if they've played everyone. If there is an odd number of
+// players, the last player gets a bye.
+func standingsPairings(sorted []*record, played map[[2]int]bool) [][2]int {
+	paired := make([]bool, len(sorted))
+	pairings := [][2]int{}
+	for i := range sorted {
+		if paired[i] {
+			continue
+		}
+		paired[i] = true
+		opp := -1
+		for j := i + 1; j < len(sorted); j++ {
+			if paired[j] {
+				continue
+			}
+			if opp == -1 {
+				opp = j
+			}
+			if !played[[2]int{sorted[i].player, sorted[j].player}] {
+				opp = j
+				break
+			}
+		}
+		if opp == -1 {
+			pairings = append(pairings, [2]int{sorted[i].player, -1})
+			continue
+		}
+		paired[opp] = true
+		pairings = append(pairings, [2]int{sorted[i].player, sorted[opp].player})
+	}
+	return pairings
+}
@@ -0,0 +1,78 @@
+package sim
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestRoundRobinPairings(t *testing.T) {
+	is := is.New(t)
+	for _, players := range []int{4, 5} {
+		games := map[[2]int]int{}
+		byes := map[int]int{}
+		rounds := players - 1 + players%2
+		for round := 0; round < rounds; round++ {
+			for _, p := range roundRobinPairings(players, round) {
+				if p[1] == -1 {
+					byes[p[0]]++
+					continue
+				}
+				games[[2]int{p[0], p[1]}]++
+				games[[2]int{p[1], p[0]}]++
+			}
+		}
+		// Everyone plays everyone else exactly once.
+		for a := 0; a < players; a++ {
+			for b := 0; b < players; b++ {
+				if a != b {
+					is.Equal(games[[2]int{a, b}], 1)
+				}
+			}
+		}
+		if players%2 == 1 {
+			is.Equal(len(byes), players)
+		}
+	}
+}
+
+func TestStandingsPairingsAvoidRematches(t *testing.T) {
+	is := is.New(t)
+	sorted := []*record{{player: 0}, {player: 1}, {player: 2}, {player: 3}, {player: 4}}
+	played := map[[2]int]bool{{0, 1}: true, {1, 0}: true}
+	is.Equal(standingsPairings(sorted, played), [][2]int{{0, 2}, {1, 3}, {4, -1}})
+	is.Equal(standingsPairings(sorted, nil), [][2]int{{0, 1}, {2, 3}, {4, -1}})
+}
+
+func TestWinProbabilitiesEvenField(t *testing.T) {
+	is := is.New(t)
+	rng := rand.New(rand.NewSource(1))
+	for _, pairing := range []PairingSystem{RoundRobin, Swiss, KingOfTheHill} {
+		probs := WinProbabilities(Tournament{
+			Ratings: []float64{1800, 1800, 1800, 1800},
+			Rounds:  6,
+			Pairing: pairing,
+		}, 20000, rng)
+		sum := float64(0)
+		for _, p := range probs {
+			is.True(math.Abs(p-0.25) < 0.02)
+			sum += p
+		}
+		is.True(math.Abs(sum-1) < 1e-9)
+	}
+}
+
+func TestWinProbabilitiesFavorStrongerPlayers(t *testing.T) {
+	is := is.New(t)
+	rng := rand.New(rand.NewSource(1))
+	probs := WinProbabilities(Tournament{
+		Ratings: []float64{2100, 1900, 1700, 1500, 1300},
+		Rounds:  10,
+		Pairing: Swiss,
+	}, 20000, rng)
+	for idx := 1; idx < len(probs); idx++ {
+		is.True(probs[idx-1] > probs[idx])
+	}
+}
@@ -0,0 +1,5 @@
+# Nationals 2022, division A
+Matsumoto, Kenji      2050  2 3 4 ; 455 420 380 ; off 0
+Hercules, Noah        2000  1 4 3 ; 390 410 402
+del Solar, César      1850  4 1 2 ; 435 402 388
+Weinstein, Josh       1800  3 2 1 ; 377 399 401
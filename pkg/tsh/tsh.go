@@ -0,0 +1,234 @@
+// package tsh reads the player files written by tsh, the tournament software
+// that runs our Scrabble tournaments.
+//
+// Every non-blank line of a tsh .t file describes one player: their name,
+// their rating, their opponents' player numbers for each round, then a
+// semicolon and their score for each round. Any further semicolon-separated
+// fields are ignored. Player numbers are 1-indexed, in file order, and an
+// opponent of 0 is a bye.
+//
+//	Matsumoto, Kenji  2050 2 3 ; 455 420
+//	Hercules, Noah    2000 1 0 ; 390 50
+//	del Solar, César  1850 0 1 ; 50 380
+package tsh
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type Player struct {
+	// Number is the player's 1-indexed position in the file, which is how
+	// other players refer to them.
+	Number int
+	Name   string
+	Rating int
+	// Opponents holds the opponent's player number for each round, or 0
+	// for a bye.
+	Opponents []int
+	// Scores holds the player's score for each round that has been played.
+	Scores []int
+}
+
+// Division is all of the players in a tsh division.
+type Division struct {
+	Players []*Player
+}
+
+// Game is the result of one player's game in a round.
+type Game struct {
+	Round int // 1-indexed
+	// Opponent is nil for a bye.
+	Opponent      *Player
+	Score         int
+	OpponentScore int
+}
+
+// Spread is how many points the player won the game by.
+func (g Game) Spread() int {
+	return g.Score - g.OpponentScore
+}
+
+// Wins is 1 for a win, 0.5 for a tie, and 0 for a loss. A bye is a win if
+// its score is positive.
+func (g Game) Wins() float64 {
+	switch {
+	case g.Score > g.OpponentScore:
+		return 1
+	case g.Score == g.OpponentScore && g.Opponent != nil:
+		return 0.5
+	}
+	return 0
+}
+
+// Standing is a player's record across all played games.
+type Standing struct {
+	Player *Player
+	Wins   float64
+	Losses float64
+	Spread int
+}
+
+func ParseFile(path string) (*Division, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return Parse(f)
+}
+
+func Parse(r io.Reader) (*Division, error) {
+	d := &Division{}
+	scanner := bufio.NewScanner(r)
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		p, err := parsePlayer(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNo, err)
+		}
+		p.Number = len(d.Players) + 1
+		d.Players = append(d.Players, p)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	for _, p := range d.Players {
+		for _, opp := range p.Opponents {
+			if opp < 0 || opp > len(d.Players) {
+				return nil, fmt.Errorf("%s has a nonexistent opponent: %d", p.Name, opp)
+			}
+		}
+	}
+	return d, nil
+}
+
+func parsePlayer(line string) (*Player, error) {
+	// Players with no scores yet might not have a semicolon at all.
+	fields := append(strings.Split(line, ";"), "")
+	tokens := strings.Fields(fields[0])
+	// The name can have any number of words, so work backwards from the
+	// end to find where the numbers start.
+	first := len(tokens)
+	for first > 0 {
+		if _, err := strconv.Atoi(tokens[first-1]); err != nil {
+			break
+		}
+		first--
+	}
+	if first == 0 || first == len(tokens) {
+		return nil, fmt.Errorf("missing name or rating: %q", line)
+	}
+	ints, err := atois(tokens[first:])
+	if err != nil {
+		return nil, err
+	}
+	scores, err := atois(strings.Fields(fields[1]))
+	if err != nil {
+		return nil, err
+	}
+	if len(scores) > len(ints)-1 {
+		return nil, fmt.Errorf("more scores than opponents: %q", line)
+	}
+	return &Player{
+		Name:      strings.Join(tokens[:first], " "),
+		Rating:    ints[0],
+		Opponents: ints[1:],
+		Scores:    scores,
+	}, nil
+}
+
+func atois(strs []string) ([]int, error) {
+	ints := make([]int, len(strs))
+	for idx, s := range strs {
+		i, err := strconv.Atoi(s)
+		if err != nil {
+			return nil, err
+		}
+		ints[idx] = i
+	}
+	return ints, nil
+}
+
+// Player returns the player with the given number, or nil for a bye.
+func (d *Division) Player(number int) *Player {
+	if number < 1 || number > len(d.Players) {
+		return nil
+	}
+	return d.Players[number-1]
+}
+
+// Games returns every game the player has played. A game is only played
+// once both players' scores for it have been entered.
+func (d *Division) Games(p *Player) []Game {
+	games := []Game{}
+	for idx, score := range p.Scores {
+		g := Game{Round: idx + 1, Score: score, Opponent: d.Player(p.Opponents[idx])}
+		if g.Opponent != nil {
+			if len(g.Opponent.Scores) <= idx {
+				continue
+			}
+			g.OpponentScore = g.Opponent.Scores[idx]
+		}
+		games = append(games, g)
+	}
+	return games
+}
+
+// Standings returns every player's record, from first place to last. Players
+// are ranked by wins and then by spread.
+func (d *Division) Standings() []Standing {
+	standings := []Standing{}
+	for _, p := range d.Players {
+		st := Standing{Player: p}
+		for _, g := range d.Games(p) {
+			st.Wins += g.Wins()
+			st.Losses += 1 - g.Wins()
+			st.Spread += g.Spread()
+		}
+		standings = append(standings, st)
+	}
+	sort.SliceStable(standings, func(i, j int) bool {
+		if standings[i].Wins != standings[j].Wins {
+			return standings[i].Wins > standings[j].Wins
+		}
+		return standings[i].Spread > standings[j].Spread
+	})
+	return standings
+}
+
+// Leaders returns the players who are tied for first place in the standings.
+func (d *Division) Leaders() []*Player {
+	standings := d.Standings()
+	leaders := []*Player{}
+	for _, st := range standings {
+		if st.Wins != standings[0].Wins || st.Spread != standings[0].Spread {
+			break
+		}
+		leaders = append(leaders, st.Player)
+	}
+	return leaders
+}
+
+// Unfinished returns the players who are still missing a score for a round
+// they've been paired in. The division's results are final once there are
+// none.
+func (d *Division) Unfinished() []*Player {
+	unfinished := []*Player{}
+	for _, p := range d.Players {
+		if len(p.Scores) < len(p.Opponents) {
+			unfinished = append(unfinished, p)
+		}
+	}
+	return unfinished
+}
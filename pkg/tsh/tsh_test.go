@@ -0,0 +1,71 @@
+package tsh
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestParseFile(t *testing.T) {
+	is := is.New(t)
+	d, err := ParseFile("./testfixtures/a.t")
+	is.NoErr(err)
+	is.Equal(len(d.Players), 4)
+	is.Equal(d.Players[2], &Player{
+		Number: 3, Name: "del Solar, César", Rating: 1850,
+		Opponents: []int{4, 1, 2}, Scores: []int{435, 402, 388},
+	})
+}
+
+func TestStandings(t *testing.T) {
+	is := is.New(t)
+	d, err := ParseFile("./testfixtures/a.t")
+	is.NoErr(err)
+	standings := d.Standings()
+	is.Equal(standings[0].Player.Name, "Matsumoto, Kenji")
+	is.Equal(standings[0].Wins, 2.0)
+	is.Equal(standings[0].Losses, 1.0)
+	is.Equal(standings[0].Spread, 65+18-21)
+	is.Equal(standings[1].Player.Name, "Hercules, Noah")
+	is.Equal(standings[1].Wins, 2.0)
+	is.Equal(standings[2].Player.Name, "del Solar, César")
+	is.Equal(standings[3].Player.Name, "Weinstein, Josh")
+
+	is.Equal(len(d.Leaders()), 1)
+	is.Equal(d.Leaders()[0].Name, "Matsumoto, Kenji")
+	is.Equal(len(d.Unfinished()), 0)
+}
+
+func TestByesAndUnplayedRounds(t *testing.T) {
+	is := is.New(t)
+	d, err := Parse(strings.NewReader(`
+Matsumoto, Kenji  2050 2 3
+Hercules, Noah    2000 1 0 ; 390 50
+del Solar, César  1850 0 1 ; 50
+`))
+	is.NoErr(err)
+	// Kenji's scores haven't been entered, so none of his games count.
+	is.Equal(len(d.Games(d.Players[0])), 0)
+	is.Equal(d.Games(d.Players[1]), []Game{{Round: 2, Score: 50}})
+
+	standings := d.Standings()
+	is.Equal(standings[0].Player.Name, "Hercules, Noah")
+	is.Equal(standings[0].Wins, 1.0)
+	is.Equal(standings[0].Spread, 50)
+
+	// Noah and César have the same record.
+	is.Equal(len(d.Leaders()), 2)
+
+	is.Equal(len(d.Unfinished()), 2)
+	is.Equal(d.Unfinished()[0].Name, "Matsumoto, Kenji")
+	is.Equal(d.Unfinished()[1].Name, "del Solar, César")
+}
+
+func TestParseErrors(t *testing.T) {
+	is := is.New(t)
+	_, err := Parse(strings.NewReader("Matsumoto, Kenji 2050 2 ; 455\n"))
+	is.Equal(err.Error(), "Matsumoto, Kenji has a nonexistent opponent: 2")
+	_, err = Parse(strings.NewReader("2050 1 ; 455\n"))
+	is.Equal(err.Error(), `line 1: missing name or rating: "2050 1 ; 455"`)
+}
@@ -20,6 +20,511 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type MarketType int32
+
+const (
+	// A set of mutually exclusive securities priced together; exactly one of
+	// them is expected to win.
+	MarketType_EXCLUSIVE MarketType = 0
+	// A standalone yes/no question. Binary markets always have exactly two
+	// securities, YES and NO, which are created along with the market.
+	MarketType_BINARY MarketType = 1
+	// A market on a numeric quantity between a lower and an upper bound. Scalar
+	// markets always have exactly two securities, LONG and SHORT, which are
+	// created along with the market. When the market resolves to a value, LONG
+	// pays out linearly from nothing at the lower bound to the full payout at
+	// the upper bound, and SHORT pays out the rest.
+	MarketType_SCALAR MarketType = 2
+	// A market on the final standings of a field of players. Each security is
+	// a predicate on one player's finishing position, such as "Kenji finishes
+	// in the top 3", and all of them are priced together by a single LMSR over
+	// every possible ranking of the field.
+	MarketType_RANKING MarketType = 3
+)
+
+// Enum value maps for MarketType.
+var (
+	MarketType_name = map[int32]string{
+		0: "EXCLUSIVE",
+		1: "BINARY",
+		2: "SCALAR",
+		3: "RANKING",
+	}
+	MarketType_value = map[string]int32{
+		"EXCLUSIVE": 0,
+		"BINARY":    1,
+		"SCALAR":    2,
+		"RANKING":   3,
+	}
+)
+
+func (x MarketType) Enum() *MarketType {
+	p := new(MarketType)
+	*p = x
+	return p
+}
+
+func (x MarketType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MarketType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_market_proto_enumTypes[0].Descriptor()
+}
+
+func (MarketType) Type() protoreflect.EnumType {
+	return &file_proto_market_proto_enumTypes[0]
+}
+
+func (x MarketType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MarketType.Descriptor instead.
+func (MarketType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_market_proto_rawDescGZIP(), []int{0}
+}
+
+type CandleInterval int32
+
+const (
+	CandleInterval_ONE_MINUTE CandleInterval = 0
+	CandleInterval_ONE_HOUR   CandleInterval = 1
+	CandleInterval_ONE_DAY    CandleInterval = 2
+)
+
+// Enum value maps for CandleInterval.
+var (
+	CandleInterval_name = map[int32]string{
+		0: "ONE_MINUTE",
+		1: "ONE_HOUR",
+		2: "ONE_DAY",
+	}
+	CandleInterval_value = map[string]int32{
+		"ONE_MINUTE": 0,
+		"ONE_HOUR":   1,
+		"ONE_DAY":    2,
+	}
+)
+
+func (x CandleInterval) Enum() *CandleInterval {
+	p := new(CandleInterval)
+	*p = x
+	return p
+}
+
+func (x CandleInterval) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CandleInterval) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_market_proto_enumTypes[1].Descriptor()
+}
+
+func (CandleInterval) Type() protoreflect.EnumType {
+	return &file_proto_market_proto_enumTypes[1]
+}
+
+func (x CandleInterval) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CandleInterval.Descriptor instead.
+func (CandleInterval) EnumDescriptor() ([]byte, []int) {
+	return file_proto_market_proto_rawDescGZIP(), []int{1}
+}
+
+type PairingSystem int32
+
+const (
+	PairingSystem_ROUND_ROBIN PairingSystem = 0
+	// Players with similar records are paired, avoiding rematches.
+	PairingSystem_SWISS PairingSystem = 1
+	// First plays second, third plays fourth, and so on, rematches and all.
+	PairingSystem_KING_OF_THE_HILL PairingSystem = 2
+)
+
+// Enum value maps for PairingSystem.
+var (
+	PairingSystem_name = map[int32]string{
+		0: "ROUND_ROBIN",
+		1: "SWISS",
+		2: "KING_OF_THE_HILL",
+	}
+	PairingSystem_value = map[string]int32{
+		"ROUND_ROBIN":      0,
+		"SWISS":            1,
+		"KING_OF_THE_HILL": 2,
+	}
+)
+
+func (x PairingSystem) Enum() *PairingSystem {
+	p := new(PairingSystem)
+	*p = x
+	return p
+}
+
+func (x PairingSystem) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PairingSystem) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_market_proto_enumTypes[2].Descriptor()
+}
+
+func (PairingSystem) Type() protoreflect.EnumType {
+	return &file_proto_market_proto_enumTypes[2]
+}
+
+func (x PairingSystem) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PairingSystem.Descriptor instead.
+func (PairingSystem) EnumDescriptor() ([]byte, []int) {
+	return file_proto_market_proto_rawDescGZIP(), []int{2}
+}
+
+// When a market's prices are scored. See MarketScore.
+type ScoreCheckpoint int32
+
+const (
+	// Averaged over the whole time the market traded, weighting each price by
+	// how long it stood.
+	ScoreCheckpoint_TIME_WEIGHTED ScoreCheckpoint = 0
+	// The opening prices.
+	ScoreCheckpoint_OPEN ScoreCheckpoint = 1
+	// The prices a day before the market resolved.
+	ScoreCheckpoint_FINAL_DAY ScoreCheckpoint = 2
+	// The last prices before the market resolved.
+	ScoreCheckpoint_CLOSE ScoreCheckpoint = 3
+)
+
+// Enum value maps for ScoreCheckpoint.
+var (
+	ScoreCheckpoint_name = map[int32]string{
+		0: "TIME_WEIGHTED",
+		1: "OPEN",
+		2: "FINAL_DAY",
+		3: "CLOSE",
+	}
+	ScoreCheckpoint_value = map[string]int32{
+		"TIME_WEIGHTED": 0,
+		"OPEN":          1,
+		"FINAL_DAY":     2,
+		"CLOSE":         3,
+	}
+)
+
+func (x ScoreCheckpoint) Enum() *ScoreCheckpoint {
+	p := new(ScoreCheckpoint)
+	*p = x
+	return p
+}
+
+func (x ScoreCheckpoint) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScoreCheckpoint) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_market_proto_enumTypes[3].Descriptor()
+}
+
+func (ScoreCheckpoint) Type() protoreflect.EnumType {
+	return &file_proto_market_proto_enumTypes[3]
+}
+
+func (x ScoreCheckpoint) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScoreCheckpoint.Descriptor instead.
+func (ScoreCheckpoint) EnumDescriptor() ([]byte, []int) {
+	return file_proto_market_proto_rawDescGZIP(), []int{3}
+}
+
+// Every change to a token balance is a ledger entry that moves tokens from one
+// account to another. Accounts are named "house", "user:<username>", or
+// "market:<market id>" for the tokens a market holds in escrow between trades
+// and payouts.
+type LedgerEntryKind int32
+
+const (
+	LedgerEntryKind_GRANT    LedgerEntryKind = 0 // the house gives a user tokens
+	LedgerEntryKind_TRADE    LedgerEntryKind = 1 // a user buys from or sells to a market
+	LedgerEntryKind_FEE      LedgerEntryKind = 2 // no fees are charged yet
+	LedgerEntryKind_PAYOUT   LedgerEntryKind = 3 // a resolved market pays out its winning shares
+	LedgerEntryKind_REFUND   LedgerEntryKind = 4 // a voided market refunds its trades
+	LedgerEntryKind_TRANSFER LedgerEntryKind = 5 // a market settles what's left in escrow with the house
+)
+
+// Enum value maps for LedgerEntryKind.
+var (
+	LedgerEntryKind_name = map[int32]string{
+		0: "GRANT",
+		1: "TRADE",
+		2: "FEE",
+		3: "PAYOUT",
+		4: "REFUND",
+		5: "TRANSFER",
+	}
+	LedgerEntryKind_value = map[string]int32{
+		"GRANT":    0,
+		"TRADE":    1,
+		"FEE":      2,
+		"PAYOUT":   3,
+		"REFUND":   4,
+		"TRANSFER": 5,
+	}
+)
+
+func (x LedgerEntryKind) Enum() *LedgerEntryKind {
+	p := new(LedgerEntryKind)
+	*p = x
+	return p
+}
+
+func (x LedgerEntryKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LedgerEntryKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_market_proto_enumTypes[4].Descriptor()
+}
+
+func (LedgerEntryKind) Type() protoreflect.EnumType {
+	return &file_proto_market_proto_enumTypes[4]
+}
+
+func (x LedgerEntryKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LedgerEntryKind.Descriptor instead.
+func (LedgerEntryKind) EnumDescriptor() ([]byte, []int) {
+	return file_proto_market_proto_rawDescGZIP(), []int{4}
+}
+
+type Invariant int32
+
+const (
+	// A security's shares outstanding are its seed shares plus everything
+	// its holders hold.
+	Invariant_SHARES_OUTSTANDING Invariant = 0
+	// An unresolved security's last price is the market maker's price for the
+	// shares outstanding.
+	Invariant_LAST_PRICE Invariant = 1
+	// A user's tokens are what they were granted, less what their orders cost,
+	// plus what they were paid out or refunded.
+	Invariant_TOKEN_BALANCE Invariant = 2
+	// Nobody holds a negative amount of a security, or of tokens.
+	Invariant_NO_NEGATIVE_HOLDINGS Invariant = 3
+)
+
+// Enum value maps for Invariant.
+var (
+	Invariant_name = map[int32]string{
+		0: "SHARES_OUTSTANDING",
+		1: "LAST_PRICE",
+		2: "TOKEN_BALANCE",
+		3: "NO_NEGATIVE_HOLDINGS",
+	}
+	Invariant_value = map[string]int32{
+		"SHARES_OUTSTANDING":   0,
+		"LAST_PRICE":           1,
+		"TOKEN_BALANCE":        2,
+		"NO_NEGATIVE_HOLDINGS": 3,
+	}
+)
+
+func (x Invariant) Enum() *Invariant {
+	p := new(Invariant)
+	*p = x
+	return p
+}
+
+func (x Invariant) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Invariant) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_market_proto_enumTypes[5].Descriptor()
+}
+
+func (Invariant) Type() protoreflect.EnumType {
+	return &file_proto_market_proto_enumTypes[5]
+}
+
+func (x Invariant) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Invariant.Descriptor instead.
+func (Invariant) EnumDescriptor() ([]byte, []int) {
+	return file_proto_market_proto_rawDescGZIP(), []int{5}
+}
+
+// Where a market is in its life. A market is set up as a DRAFT, optionally
+// SCHEDULED to open, and then trades while it's OPEN. Trading can be
+// HALTED for a while, and stops once it's CLOSED; a closed market can be
+// reopened until it's RESOLVED or VOIDED. Only drafts and scheduled markets
+// can have their securities changed, or be deleted.
+type Market_Status int32
+
+const (
+	Market_DRAFT     Market_Status = 0
+	Market_OPEN      Market_Status = 1
+	Market_CLOSED    Market_Status = 2
+	Market_RESOLVED  Market_Status = 3
+	Market_SCHEDULED Market_Status = 4
+	Market_HALTED    Market_Status = 5
+	Market_VOIDED    Market_Status = 6
+)
+
+// Enum value maps for Market_Status.
+var (
+	Market_Status_name = map[int32]string{
+		0: "DRAFT",
+		1: "OPEN",
+		2: "CLOSED",
+		3: "RESOLVED",
+		4: "SCHEDULED",
+		5: "HALTED",
+		6: "VOIDED",
+	}
+	Market_Status_value = map[string]int32{
+		"DRAFT":     0,
+		"OPEN":      1,
+		"CLOSED":    2,
+		"RESOLVED":  3,
+		"SCHEDULED": 4,
+		"HALTED":    5,
+		"VOIDED":    6,
+	}
+)
+
+func (x Market_Status) Enum() *Market_Status {
+	p := new(Market_Status)
+	*p = x
+	return p
+}
+
+func (x Market_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Market_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_market_proto_enumTypes[6].Descriptor()
+}
+
+func (Market_Status) Type() protoreflect.EnumType {
+	return &file_proto_market_proto_enumTypes[6]
+}
+
+func (x Market_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Market_Status.Descriptor instead.
+func (Market_Status) EnumDescriptor() ([]byte, []int) {
+	return file_proto_market_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type GetOrderBookRequest_Side int32
+
+const (
+	GetOrderBookRequest_BUYS_AND_SELLS GetOrderBookRequest_Side = 0
+	GetOrderBookRequest_BUYS           GetOrderBookRequest_Side = 1
+	GetOrderBookRequest_SELLS          GetOrderBookRequest_Side = 2
+)
+
+// Enum value maps for GetOrderBookRequest_Side.
+var (
+	GetOrderBookRequest_Side_name = map[int32]string{
+		0: "BUYS_AND_SELLS",
+		1: "BUYS",
+		2: "SELLS",
+	}
+	GetOrderBookRequest_Side_value = map[string]int32{
+		"BUYS_AND_SELLS": 0,
+		"BUYS":           1,
+		"SELLS":          2,
+	}
+)
+
+func (x GetOrderBookRequest_Side) Enum() *GetOrderBookRequest_Side {
+	p := new(GetOrderBookRequest_Side)
+	*p = x
+	return p
+}
+
+func (x GetOrderBookRequest_Side) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetOrderBookRequest_Side) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_market_proto_enumTypes[7].Descriptor()
+}
+
+func (GetOrderBookRequest_Side) Type() protoreflect.EnumType {
+	return &file_proto_market_proto_enumTypes[7]
+}
+
+func (x GetOrderBookRequest_Side) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetOrderBookRequest_Side.Descriptor instead.
+func (GetOrderBookRequest_Side) EnumDescriptor() ([]byte, []int) {
+	return file_proto_market_proto_rawDescGZIP(), []int{5, 0}
+}
+
+type GetOrderBookRequest_SortOrder int32
+
+const (
+	GetOrderBookRequest_NEWEST_FIRST GetOrderBookRequest_SortOrder = 0
+	GetOrderBookRequest_OLDEST_FIRST GetOrderBookRequest_SortOrder = 1
+)
+
+// Enum value maps for GetOrderBookRequest_SortOrder.
+var (
+	GetOrderBookRequest_SortOrder_name = map[int32]string{
+		0: "NEWEST_FIRST",
+		1: "OLDEST_FIRST",
+	}
+	GetOrderBookRequest_SortOrder_value = map[string]int32{
+		"NEWEST_FIRST": 0,
+		"OLDEST_FIRST": 1,
+	}
+)
+
+func (x GetOrderBookRequest_SortOrder) Enum() *GetOrderBookRequest_SortOrder {
+	p := new(GetOrderBookRequest_SortOrder)
+	*p = x
+	return p
+}
+
+func (x GetOrderBookRequest_SortOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetOrderBookRequest_SortOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_market_proto_enumTypes[8].Descriptor()
+}
+
+func (GetOrderBookRequest_SortOrder) Type() protoreflect.EnumType {
+	return &file_proto_market_proto_enumTypes[8]
+}
+
+func (x GetOrderBookRequest_SortOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetOrderBookRequest_SortOrder.Descriptor instead.
+func (GetOrderBookRequest_SortOrder) EnumDescriptor() ([]byte, []int) {
+	return file_proto_market_proto_rawDescGZIP(), []int{5, 1}
+}
+
 type SecurityRequest_BuyOrSell int32
 
 const (
@@ -50,11 +555,11 @@ func (x SecurityRequest_BuyOrSell) String() string {
 }
 
 func (SecurityRequest_BuyOrSell) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_market_proto_enumTypes[0].Descriptor()
+	return file_proto_market_proto_enumTypes[9].Descriptor()
 }
 
 func (SecurityRequest_BuyOrSell) Type() protoreflect.EnumType {
-	return &file_proto_market_proto_enumTypes[0]
+	return &file_proto_market_proto_enumTypes[9]
 }
 
 func (x SecurityRequest_BuyOrSell) Number() protoreflect.EnumNumber {
@@ -63,7 +568,7 @@ func (x SecurityRequest_BuyOrSell) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SecurityRequest_BuyOrSell.Descriptor instead.
 func (SecurityRequest_BuyOrSell) EnumDescriptor() ([]byte, []int) {
-	return file_proto_market_proto_rawDescGZIP(), []int{6, 0}
+	return file_proto_market_proto_rawDescGZIP(), []int{7, 0}
 }
 
 type Market struct {
@@ -75,7 +580,29 @@ type Market struct {
 	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
 	DateCreated string `protobuf:"bytes,3,opt,name=date_created,json=dateCreated,proto3" json:"date_created,omitempty"` // RFC3339
 	DateClosed  string `protobuf:"bytes,4,opt,name=date_closed,json=dateClosed,proto3" json:"date_closed,omitempty"`
-	IsOpen      bool   `protobuf:"varint,5,opt,name=is_open,json=isOpen,proto3" json:"is_open,omitempty"`
+	// Deprecated: use status.
+	//
+	// Deprecated: Do not use.
+	IsOpen       bool       `protobuf:"varint,5,opt,name=is_open,json=isOpen,proto3" json:"is_open,omitempty"`
+	MarketType   MarketType `protobuf:"varint,6,opt,name=market_type,json=marketType,proto3,enum=market.MarketType" json:"market_type,omitempty"`
+	DateResolved string     `protobuf:"bytes,7,opt,name=date_resolved,json=dateResolved,proto3" json:"date_resolved,omitempty"`
+	// The range of a SCALAR market.
+	LowerBound float64 `protobuf:"fixed64,8,opt,name=lower_bound,json=lowerBound,proto3" json:"lower_bound,omitempty"`
+	UpperBound float64 `protobuf:"fixed64,9,opt,name=upper_bound,json=upperBound,proto3" json:"upper_bound,omitempty"`
+	// Set for conditional markets. See CreateMarketRequest.
+	ConditionSecurityId string `protobuf:"bytes,10,opt,name=condition_security_id,json=conditionSecurityId,proto3" json:"condition_security_id,omitempty"`
+	// A voided market was closed without being resolved, and everyone who
+	// traded in it was refunded.
+	Voided bool `protobuf:"varint,11,opt,name=voided,proto3" json:"voided,omitempty"`
+	// The field of players in a RANKING market.
+	Players []string `protobuf:"bytes,12,rep,name=players,proto3" json:"players,omitempty"`
+	// Only filled in by GetOpenMarkets, and only if it's asked for them.
+	Securities []*Security   `protobuf:"bytes,13,rep,name=securities,proto3" json:"securities,omitempty"`
+	Status     Market_Status `protobuf:"varint,14,opt,name=status,proto3,enum=market.Market_Status" json:"status,omitempty"`
+	// When the scheduler opens and closes the market (RFC3339). Either can be
+	// empty. See ScheduleMarketRequest.
+	OpenAt  string `protobuf:"bytes,15,opt,name=open_at,json=openAt,proto3" json:"open_at,omitempty"`
+	CloseAt string `protobuf:"bytes,16,opt,name=close_at,json=closeAt,proto3" json:"close_at,omitempty"`
 }
 
 func (x *Market) Reset() {
@@ -138,6 +665,7 @@ func (x *Market) GetDateClosed() string {
 	return ""
 }
 
+// Deprecated: Do not use.
 func (x *Market) GetIsOpen() bool {
 	if x != nil {
 		return x.IsOpen
@@ -145,30 +673,119 @@ func (x *Market) GetIsOpen() bool {
 	return false
 }
 
-type Security struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Market) GetMarketType() MarketType {
+	if x != nil {
+		return x.MarketType
+	}
+	return MarketType_EXCLUSIVE
+}
 
-	Id                string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Description       string  `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Shortname         string  `protobuf:"bytes,3,opt,name=shortname,proto3" json:"shortname,omitempty"`
-	DateCreated       string  `protobuf:"bytes,4,opt,name=date_created,json=dateCreated,proto3" json:"date_created,omitempty"`
-	MarketId          string  `protobuf:"bytes,5,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	SharesOutstanding float64 `protobuf:"fixed64,6,opt,name=shares_outstanding,json=sharesOutstanding,proto3" json:"shares_outstanding,omitempty"`
-	LastPrice         float64 `protobuf:"fixed64,7,opt,name=last_price,json=lastPrice,proto3" json:"last_price,omitempty"`
+func (x *Market) GetDateResolved() string {
+	if x != nil {
+		return x.DateResolved
+	}
+	return ""
 }
 
-func (x *Security) Reset() {
-	*x = Security{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_market_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Market) GetLowerBound() float64 {
+	if x != nil {
+		return x.LowerBound
 	}
+	return 0
 }
 
-func (x *Security) String() string {
+func (x *Market) GetUpperBound() float64 {
+	if x != nil {
+		return x.UpperBound
+	}
+	return 0
+}
+
+func (x *Market) GetConditionSecurityId() string {
+	if x != nil {
+		return x.ConditionSecurityId
+	}
+	return ""
+}
+
+func (x *Market) GetVoided() bool {
+	if x != nil {
+		return x.Voided
+	}
+	return false
+}
+
+func (x *Market) GetPlayers() []string {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *Market) GetSecurities() []*Security {
+	if x != nil {
+		return x.Securities
+	}
+	return nil
+}
+
+func (x *Market) GetStatus() Market_Status {
+	if x != nil {
+		return x.Status
+	}
+	return Market_DRAFT
+}
+
+func (x *Market) GetOpenAt() string {
+	if x != nil {
+		return x.OpenAt
+	}
+	return ""
+}
+
+func (x *Market) GetCloseAt() string {
+	if x != nil {
+		return x.CloseAt
+	}
+	return ""
+}
+
+type Security struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Shortname   string `protobuf:"bytes,3,opt,name=shortname,proto3" json:"shortname,omitempty"`
+	DateCreated string `protobuf:"bytes,4,opt,name=date_created,json=dateCreated,proto3" json:"date_created,omitempty"`
+	MarketId    string `protobuf:"bytes,5,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// Deprecated: use shares_outstanding_micros.
+	//
+	// Deprecated: Do not use.
+	SharesOutstanding float64 `protobuf:"fixed64,6,opt,name=shares_outstanding,json=sharesOutstanding,proto3" json:"shares_outstanding,omitempty"`
+	LastPrice         float64 `protobuf:"fixed64,7,opt,name=last_price,json=lastPrice,proto3" json:"last_price,omitempty"`
+	// For securities in RANKING markets, the predicate: that the player
+	// finishes in one of the (1-indexed) positions.
+	Player    string  `protobuf:"bytes,8,opt,name=player,proto3" json:"player,omitempty"`
+	Positions []int32 `protobuf:"varint,9,rep,packed,name=positions,proto3" json:"positions,omitempty"`
+	// The rating that the security's opening price was seeded from, if any.
+	Rating float64 `protobuf:"fixed64,10,opt,name=rating,proto3" json:"rating,omitempty"`
+	// Shares and tokens are counted in whole micro-units: a millionth of a
+	// share or a token.
+	SharesOutstandingMicros int64 `protobuf:"varint,11,opt,name=shares_outstanding_micros,json=sharesOutstandingMicros,proto3" json:"shares_outstanding_micros,omitempty"`
+}
+
+func (x *Security) Reset() {
+	*x = Security{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_market_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Security) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
@@ -226,6 +843,7 @@ func (x *Security) GetMarketId() string {
 	return ""
 }
 
+// Deprecated: Do not use.
 func (x *Security) GetSharesOutstanding() float64 {
 	if x != nil {
 		return x.SharesOutstanding
@@ -240,18 +858,52 @@ func (x *Security) GetLastPrice() float64 {
 	return 0
 }
 
+func (x *Security) GetPlayer() string {
+	if x != nil {
+		return x.Player
+	}
+	return ""
+}
+
+func (x *Security) GetPositions() []int32 {
+	if x != nil {
+		return x.Positions
+	}
+	return nil
+}
+
+func (x *Security) GetRating() float64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *Security) GetSharesOutstandingMicros() int64 {
+	if x != nil {
+		return x.SharesOutstandingMicros
+	}
+	return 0
+}
+
 type Order struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id                string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Username          string  `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	SecurityId        string  `protobuf:"bytes,3,opt,name=security_id,json=securityId,proto3" json:"security_id,omitempty"`
-	SecurityShortname string  `protobuf:"bytes,4,opt,name=security_shortname,json=securityShortname,proto3" json:"security_shortname,omitempty"`
-	Amount            float64 `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"` // how many securities
-	Cost              float64 `protobuf:"fixed64,6,opt,name=cost,proto3" json:"cost,omitempty"`     // total cost (negative if sale)
-	DateCreated       string  `protobuf:"bytes,7,opt,name=date_created,json=dateCreated,proto3" json:"date_created,omitempty"`
+	Id                string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Username          string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	SecurityId        string `protobuf:"bytes,3,opt,name=security_id,json=securityId,proto3" json:"security_id,omitempty"`
+	SecurityShortname string `protobuf:"bytes,4,opt,name=security_shortname,json=securityShortname,proto3" json:"security_shortname,omitempty"`
+	// Deprecated: use amount_micros and cost_micros.
+	//
+	// Deprecated: Do not use.
+	Amount float64 `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Deprecated: Do not use.
+	Cost         float64 `protobuf:"fixed64,6,opt,name=cost,proto3" json:"cost,omitempty"`
+	DateCreated  string  `protobuf:"bytes,7,opt,name=date_created,json=dateCreated,proto3" json:"date_created,omitempty"`
+	AmountMicros int64   `protobuf:"varint,8,opt,name=amount_micros,json=amountMicros,proto3" json:"amount_micros,omitempty"` // how many securities
+	CostMicros   int64   `protobuf:"varint,9,opt,name=cost_micros,json=costMicros,proto3" json:"cost_micros,omitempty"`       // total cost (negative if sale)
 }
 
 func (x *Order) Reset() {
@@ -314,6 +966,7 @@ func (x *Order) GetSecurityShortname() string {
 	return ""
 }
 
+// Deprecated: Do not use.
 func (x *Order) GetAmount() float64 {
 	if x != nil {
 		return x.Amount
@@ -321,6 +974,7 @@ func (x *Order) GetAmount() float64 {
 	return 0
 }
 
+// Deprecated: Do not use.
 func (x *Order) GetCost() float64 {
 	if x != nil {
 		return x.Cost
@@ -335,20 +989,149 @@ func (x *Order) GetDateCreated() string {
 	return ""
 }
 
+func (x *Order) GetAmountMicros() int64 {
+	if x != nil {
+		return x.AmountMicros
+	}
+	return 0
+}
+
+func (x *Order) GetCostMicros() int64 {
+	if x != nil {
+		return x.CostMicros
+	}
+	return 0
+}
+
+type Position struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Security *Security `protobuf:"bytes,1,opt,name=security,proto3" json:"security,omitempty"`
+	// Deprecated: use amount_micros.
+	//
+	// Deprecated: Do not use.
+	Amount float64 `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// What the shares cost on average. Sales don't change it: they sell
+	// shares at the average cost of the ones held.
+	AverageCost float64 `protobuf:"fixed64,3,opt,name=average_cost,json=averageCost,proto3" json:"average_cost,omitempty"`
+	// amount * last_price.
+	MarkValue float64 `protobuf:"fixed64,4,opt,name=mark_value,json=markValue,proto3" json:"mark_value,omitempty"`
+	// What selling the whole position right now would bring in. This is less
+	// than the mark value, since selling pushes the price down.
+	LiquidationValue float64 `protobuf:"fixed64,5,opt,name=liquidation_value,json=liquidationValue,proto3" json:"liquidation_value,omitempty"`
+	// The mark value less what the shares cost.
+	UnrealizedPnl float64 `protobuf:"fixed64,6,opt,name=unrealized_pnl,json=unrealizedPnl,proto3" json:"unrealized_pnl,omitempty"`
+	// How many shares are held.
+	AmountMicros int64 `protobuf:"varint,7,opt,name=amount_micros,json=amountMicros,proto3" json:"amount_micros,omitempty"`
+}
+
+func (x *Position) Reset() {
+	*x = Position{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_market_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Position) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Position) ProtoMessage() {}
+
+func (x *Position) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_market_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Position.ProtoReflect.Descriptor instead.
+func (*Position) Descriptor() ([]byte, []int) {
+	return file_proto_market_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Position) GetSecurity() *Security {
+	if x != nil {
+		return x.Security
+	}
+	return nil
+}
+
+// Deprecated: Do not use.
+func (x *Position) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Position) GetAverageCost() float64 {
+	if x != nil {
+		return x.AverageCost
+	}
+	return 0
+}
+
+func (x *Position) GetMarkValue() float64 {
+	if x != nil {
+		return x.MarkValue
+	}
+	return 0
+}
+
+func (x *Position) GetLiquidationValue() float64 {
+	if x != nil {
+		return x.LiquidationValue
+	}
+	return 0
+}
+
+func (x *Position) GetUnrealizedPnl() float64 {
+	if x != nil {
+		return x.UnrealizedPnl
+	}
+	return 0
+}
+
+func (x *Position) GetAmountMicros() int64 {
+	if x != nil {
+		return x.AmountMicros
+	}
+	return 0
+}
+
 type Portfolio struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Username   string      `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	Tokens     float64     `protobuf:"fixed64,2,opt,name=tokens,proto3" json:"tokens,omitempty"`
-	Securities []*Security `protobuf:"bytes,3,rep,name=securities,proto3" json:"securities,omitempty"`
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// Deprecated: use tokens_micros.
+	//
+	// Deprecated: Do not use.
+	Tokens float64 `protobuf:"fixed64,2,opt,name=tokens,proto3" json:"tokens,omitempty"`
+	// Deprecated: securities can't say how much of each is held. Use
+	// positions instead.
+	//
+	// Deprecated: Do not use.
+	Securities   []*Security `protobuf:"bytes,3,rep,name=securities,proto3" json:"securities,omitempty"`
+	Positions    []*Position `protobuf:"bytes,4,rep,name=positions,proto3" json:"positions,omitempty"`
+	TokensMicros int64       `protobuf:"varint,5,opt,name=tokens_micros,json=tokensMicros,proto3" json:"tokens_micros,omitempty"`
 }
 
 func (x *Portfolio) Reset() {
 	*x = Portfolio{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_market_proto_msgTypes[3]
+		mi := &file_proto_market_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -361,7 +1144,7 @@ func (x *Portfolio) String() string {
 func (*Portfolio) ProtoMessage() {}
 
 func (x *Portfolio) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_market_proto_msgTypes[3]
+	mi := &file_proto_market_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -374,7 +1157,7 @@ func (x *Portfolio) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Portfolio.ProtoReflect.Descriptor instead.
 func (*Portfolio) Descriptor() ([]byte, []int) {
-	return file_proto_market_proto_rawDescGZIP(), []int{3}
+	return file_proto_market_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Portfolio) GetUsername() string {
@@ -384,6 +1167,7 @@ func (x *Portfolio) GetUsername() string {
 	return ""
 }
 
+// Deprecated: Do not use.
 func (x *Portfolio) GetTokens() float64 {
 	if x != nil {
 		return x.Tokens
@@ -391,6 +1175,7 @@ func (x *Portfolio) GetTokens() float64 {
 	return 0
 }
 
+// Deprecated: Do not use.
 func (x *Portfolio) GetSecurities() []*Security {
 	if x != nil {
 		return x.Securities
@@ -398,6 +1183,21 @@ func (x *Portfolio) GetSecurities() []*Security {
 	return nil
 }
 
+func (x *Portfolio) GetPositions() []*Position {
+	if x != nil {
+		return x.Positions
+	}
+	return nil
+}
+
+func (x *Portfolio) GetTokensMicros() int64 {
+	if x != nil {
+		return x.TokensMicros
+	}
+	return 0
+}
+
+// Every filter is optional.
 type GetOrderBookRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -406,14 +1206,21 @@ type GetOrderBookRequest struct {
 	MarketId   string `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
 	SecurityId string `protobuf:"bytes,2,opt,name=security_id,json=securityId,proto3" json:"security_id,omitempty"`
 	Username   string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
-	SinceDate  string `protobuf:"bytes,4,opt,name=since_date,json=sinceDate,proto3" json:"since_date,omitempty"`
-	Limit      int32  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Only orders made at or after this date (RFC3339).
+	SinceDate string `protobuf:"bytes,4,opt,name=since_date,json=sinceDate,proto3" json:"since_date,omitempty"`
+	// How many orders to return. Defaults to 100, and can't be more than 1000.
+	Limit int32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	// The next_page_token from the previous page. Every other field should be
+	// the same as it was for the first page.
+	PageToken string                        `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	SortOrder GetOrderBookRequest_SortOrder `protobuf:"varint,7,opt,name=sort_order,json=sortOrder,proto3,enum=market.GetOrderBookRequest_SortOrder" json:"sort_order,omitempty"`
+	Side      GetOrderBookRequest_Side      `protobuf:"varint,8,opt,name=side,proto3,enum=market.GetOrderBookRequest_Side" json:"side,omitempty"`
 }
 
 func (x *GetOrderBookRequest) Reset() {
 	*x = GetOrderBookRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_market_proto_msgTypes[4]
+		mi := &file_proto_market_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -426,7 +1233,7 @@ func (x *GetOrderBookRequest) String() string {
 func (*GetOrderBookRequest) ProtoMessage() {}
 
 func (x *GetOrderBookRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_market_proto_msgTypes[4]
+	mi := &file_proto_market_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -439,7 +1246,7 @@ func (x *GetOrderBookRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOrderBookRequest.ProtoReflect.Descriptor instead.
 func (*GetOrderBookRequest) Descriptor() ([]byte, []int) {
-	return file_proto_market_proto_rawDescGZIP(), []int{4}
+	return file_proto_market_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetOrderBookRequest) GetMarketId() string {
@@ -477,18 +1284,41 @@ func (x *GetOrderBookRequest) GetLimit() int32 {
 	return 0
 }
 
+func (x *GetOrderBookRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetOrderBookRequest) GetSortOrder() GetOrderBookRequest_SortOrder {
+	if x != nil {
+		return x.SortOrder
+	}
+	return GetOrderBookRequest_NEWEST_FIRST
+}
+
+func (x *GetOrderBookRequest) GetSide() GetOrderBookRequest_Side {
+	if x != nil {
+		return x.Side
+	}
+	return GetOrderBookRequest_BUYS_AND_SELLS
+}
+
 type OrderBookResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Orders []*Order `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	// Empty on the last page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 }
 
 func (x *OrderBookResponse) Reset() {
 	*x = OrderBookResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_market_proto_msgTypes[5]
+		mi := &file_proto_market_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -501,7 +1331,7 @@ func (x *OrderBookResponse) String() string {
 func (*OrderBookResponse) ProtoMessage() {}
 
 func (x *OrderBookResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_market_proto_msgTypes[5]
+	mi := &file_proto_market_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -514,7 +1344,7 @@ func (x *OrderBookResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OrderBookResponse.ProtoReflect.Descriptor instead.
 func (*OrderBookResponse) Descriptor() ([]byte, []int) {
-	return file_proto_market_proto_rawDescGZIP(), []int{5}
+	return file_proto_market_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *OrderBookResponse) GetOrders() []*Order {
@@ -524,21 +1354,37 @@ func (x *OrderBookResponse) GetOrders() []*Order {
 	return nil
 }
 
+func (x *OrderBookResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type SecurityRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BuyOrSell  SecurityRequest_BuyOrSell `protobuf:"varint,1,opt,name=buy_or_sell,json=buyOrSell,proto3,enum=market.SecurityRequest_BuyOrSell" json:"buy_or_sell,omitempty"`
-	Amount     float64                   `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	SecurityId string                    `protobuf:"bytes,3,opt,name=security_id,json=securityId,proto3" json:"security_id,omitempty"`
-	MarketId   string                    `protobuf:"bytes,4,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	BuyOrSell SecurityRequest_BuyOrSell `protobuf:"varint,1,opt,name=buy_or_sell,json=buyOrSell,proto3,enum=market.SecurityRequest_BuyOrSell" json:"buy_or_sell,omitempty"`
+	// Deprecated: use amount_micros. It is only read if amount_micros isn't
+	// set.
+	//
+	// Deprecated: Do not use.
+	Amount       float64 `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	SecurityId   string  `protobuf:"bytes,3,opt,name=security_id,json=securityId,proto3" json:"security_id,omitempty"`
+	MarketId     string  `protobuf:"bytes,4,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	AmountMicros int64   `protobuf:"varint,5,opt,name=amount_micros,json=amountMicros,proto3" json:"amount_micros,omitempty"`
+	// Optional. A request that is sent again with the same key, for example
+	// after a timeout, gets the first one's result instead of trading again.
+	// Keys are per user.
+	IdempotencyKey string `protobuf:"bytes,6,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
 }
 
 func (x *SecurityRequest) Reset() {
 	*x = SecurityRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_market_proto_msgTypes[6]
+		mi := &file_proto_market_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -551,7 +1397,7 @@ func (x *SecurityRequest) String() string {
 func (*SecurityRequest) ProtoMessage() {}
 
 func (x *SecurityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_market_proto_msgTypes[6]
+	mi := &file_proto_market_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -564,7 +1410,7 @@ func (x *SecurityRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SecurityRequest.ProtoReflect.Descriptor instead.
 func (*SecurityRequest) Descriptor() ([]byte, []int) {
-	return file_proto_market_proto_rawDescGZIP(), []int{6}
+	return file_proto_market_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *SecurityRequest) GetBuyOrSell() SecurityRequest_BuyOrSell {
@@ -574,6 +1420,7 @@ func (x *SecurityRequest) GetBuyOrSell() SecurityRequest_BuyOrSell {
 	return SecurityRequest_BUY
 }
 
+// Deprecated: Do not use.
 func (x *SecurityRequest) GetAmount() float64 {
 	if x != nil {
 		return x.Amount
@@ -595,18 +1442,38 @@ func (x *SecurityRequest) GetMarketId() string {
 	return ""
 }
 
+func (x *SecurityRequest) GetAmountMicros() int64 {
+	if x != nil {
+		return x.AmountMicros
+	}
+	return 0
+}
+
+func (x *SecurityRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
 type MarketActionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// Deprecated: use cost_micros.
+	//
+	// Deprecated: Do not use.
 	Cost float64 `protobuf:"fixed64,1,opt,name=cost,proto3" json:"cost,omitempty"`
+	// Costs are rounded up to a whole micro-token, so buyers pay a little more
+	// and sellers get a little less than the exact price.
+	CostMicros int64 `protobuf:"varint,2,opt,name=cost_micros,json=costMicros,proto3" json:"cost_micros,omitempty"`
 }
 
 func (x *MarketActionResponse) Reset() {
 	*x = MarketActionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_market_proto_msgTypes[7]
+		mi := &file_proto_market_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -619,7 +1486,7 @@ func (x *MarketActionResponse) String() string {
 func (*MarketActionResponse) ProtoMessage() {}
 
 func (x *MarketActionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_market_proto_msgTypes[7]
+	mi := &file_proto_market_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -632,9 +1499,10 @@ func (x *MarketActionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MarketActionResponse.ProtoReflect.Descriptor instead.
 func (*MarketActionResponse) Descriptor() ([]byte, []int) {
-	return file_proto_market_proto_rawDescGZIP(), []int{7}
+	return file_proto_market_proto_rawDescGZIP(), []int{8}
 }
 
+// Deprecated: Do not use.
 func (x *MarketActionResponse) GetCost() float64 {
 	if x != nil {
 		return x.Cost
@@ -642,16 +1510,28 @@ func (x *MarketActionResponse) GetCost() float64 {
 	return 0
 }
 
+func (x *MarketActionResponse) GetCostMicros() int64 {
+	if x != nil {
+		return x.CostMicros
+	}
+	return 0
+}
+
 type GetOpenMarketsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Which markets to return. Empty means only OPEN ones.
+	Statuses []Market_Status `protobuf:"varint,1,rep,packed,name=statuses,proto3,enum=market.Market_Status" json:"statuses,omitempty"`
+	// Fill in each market's securities, with their current prices.
+	IncludeSecurities bool `protobuf:"varint,2,opt,name=include_securities,json=includeSecurities,proto3" json:"include_securities,omitempty"`
 }
 
 func (x *GetOpenMarketsRequest) Reset() {
 	*x = GetOpenMarketsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_market_proto_msgTypes[8]
+		mi := &file_proto_market_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -664,7 +1544,7 @@ func (x *GetOpenMarketsRequest) String() string {
 func (*GetOpenMarketsRequest) ProtoMessage() {}
 
 func (x *GetOpenMarketsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_market_proto_msgTypes[8]
+	mi := &file_proto_market_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -677,21 +1557,36 @@ func (x *GetOpenMarketsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOpenMarketsRequest.ProtoReflect.Descriptor instead.
 func (*GetOpenMarketsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_market_proto_rawDescGZIP(), []int{8}
+	return file_proto_market_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetOpenMarketsRequest) GetStatuses() []Market_Status {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *GetOpenMarketsRequest) GetIncludeSecurities() bool {
+	if x != nil {
+		return x.IncludeSecurities
+	}
+	return false
 }
 
+// Markets are in the order they were created in.
 type GetOpenMarketsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Markets *Market `protobuf:"bytes,1,opt,name=markets,proto3" json:"markets,omitempty"`
+	Markets []*Market `protobuf:"bytes,1,rep,name=markets,proto3" json:"markets,omitempty"`
 }
 
 func (x *GetOpenMarketsResponse) Reset() {
 	*x = GetOpenMarketsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_market_proto_msgTypes[9]
+		mi := &file_proto_market_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -704,7 +1599,7 @@ func (x *GetOpenMarketsResponse) String() string {
 func (*GetOpenMarketsResponse) ProtoMessage() {}
 
 func (x *GetOpenMarketsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_market_proto_msgTypes[9]
+	mi := &file_proto_market_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -717,10 +1612,10 @@ func (x *GetOpenMarketsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOpenMarketsResponse.ProtoReflect.Descriptor instead.
 func (*GetOpenMarketsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_market_proto_rawDescGZIP(), []int{9}
+	return file_proto_market_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *GetOpenMarketsResponse) GetMarkets() *Market {
+func (x *GetOpenMarketsResponse) GetMarkets() []*Market {
 	if x != nil {
 		return x.Markets
 	}
@@ -736,7 +1631,7 @@ type GetPortfolioRequest struct {
 func (x *GetPortfolioRequest) Reset() {
 	*x = GetPortfolioRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_market_proto_msgTypes[10]
+		mi := &file_proto_market_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -749,7 +1644,7 @@ func (x *GetPortfolioRequest) String() string {
 func (*GetPortfolioRequest) ProtoMessage() {}
 
 func (x *GetPortfolioRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_market_proto_msgTypes[10]
+	mi := &file_proto_market_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -762,7 +1657,7 @@ func (x *GetPortfolioRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPortfolioRequest.ProtoReflect.Descriptor instead.
 func (*GetPortfolioRequest) Descriptor() ([]byte, []int) {
-	return file_proto_market_proto_rawDescGZIP(), []int{10}
+	return file_proto_market_proto_rawDescGZIP(), []int{11}
 }
 
 type GetPortfolioResponse struct {
@@ -776,7 +1671,7 @@ type GetPortfolioResponse struct {
 func (x *GetPortfolioResponse) Reset() {
 	*x = GetPortfolioResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_market_proto_msgTypes[11]
+		mi := &file_proto_market_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -789,7 +1684,7 @@ func (x *GetPortfolioResponse) String() string {
 func (*GetPortfolioResponse) ProtoMessage() {}
 
 func (x *GetPortfolioResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_market_proto_msgTypes[11]
+	mi := &file_proto_market_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -802,7 +1697,7 @@ func (x *GetPortfolioResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPortfolioResponse.ProtoReflect.Descriptor instead.
 func (*GetPortfolioResponse) Descriptor() ([]byte, []int) {
-	return file_proto_market_proto_rawDescGZIP(), []int{11}
+	return file_proto_market_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *GetPortfolioResponse) GetPortfolio() *Portfolio {
@@ -812,33 +1707,38 @@ func (x *GetPortfolioResponse) GetPortfolio() *Portfolio {
 	return nil
 }
 
-type GetSecurityCostsRequest struct {
+// Exactly one of security_id and market_id should be set; a market_id gets
+// candles for all of the market's securities.
+type GetCandlesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SecurityId string `protobuf:"bytes,1,opt,name=security_id,json=securityId,proto3" json:"security_id,omitempty"`
-	BeginDate  string `protobuf:"bytes,2,opt,name=begin_date,json=beginDate,proto3" json:"begin_date,omitempty"`
-	EndDate    string `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	SecurityId string         `protobuf:"bytes,1,opt,name=security_id,json=securityId,proto3" json:"security_id,omitempty"`
+	MarketId   string         `protobuf:"bytes,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	Interval   CandleInterval `protobuf:"varint,3,opt,name=interval,proto3,enum=market.CandleInterval" json:"interval,omitempty"`
+	// Optional RFC3339 bounds, inclusive.
+	BeginDate string `protobuf:"bytes,4,opt,name=begin_date,json=beginDate,proto3" json:"begin_date,omitempty"`
+	EndDate   string `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
 }
 
-func (x *GetSecurityCostsRequest) Reset() {
-	*x = GetSecurityCostsRequest{}
+func (x *GetCandlesRequest) Reset() {
+	*x = GetCandlesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_market_proto_msgTypes[12]
+		mi := &file_proto_market_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetSecurityCostsRequest) String() string {
+func (x *GetCandlesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSecurityCostsRequest) ProtoMessage() {}
+func (*GetCandlesRequest) ProtoMessage() {}
 
-func (x *GetSecurityCostsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_market_proto_msgTypes[12]
+func (x *GetCandlesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_market_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -849,57 +1749,80 @@ func (x *GetSecurityCostsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSecurityCostsRequest.ProtoReflect.Descriptor instead.
-func (*GetSecurityCostsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_market_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use GetCandlesRequest.ProtoReflect.Descriptor instead.
+func (*GetCandlesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_market_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *GetSecurityCostsRequest) GetSecurityId() string {
+func (x *GetCandlesRequest) GetSecurityId() string {
 	if x != nil {
 		return x.SecurityId
 	}
 	return ""
 }
 
-func (x *GetSecurityCostsRequest) GetBeginDate() string {
+func (x *GetCandlesRequest) GetMarketId() string {
+	if x != nil {
+		return x.MarketId
+	}
+	return ""
+}
+
+func (x *GetCandlesRequest) GetInterval() CandleInterval {
+	if x != nil {
+		return x.Interval
+	}
+	return CandleInterval_ONE_MINUTE
+}
+
+func (x *GetCandlesRequest) GetBeginDate() string {
 	if x != nil {
 		return x.BeginDate
 	}
 	return ""
 }
 
-func (x *GetSecurityCostsRequest) GetEndDate() string {
+func (x *GetCandlesRequest) GetEndDate() string {
 	if x != nil {
 		return x.EndDate
 	}
 	return ""
 }
 
-type GetSecurityCostsResponse struct {
+// Prices over an interval, in UTC. Intervals in which the price didn't
+// change are left out, and the price was the previous candle's close
+// throughout them.
+type Candle struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Costs []*GetSecurityCostsResponse_SecurityCost `protobuf:"bytes,1,rep,name=costs,proto3" json:"costs,omitempty"`
+	Start string  `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"` // RFC3339
+	Open  float64 `protobuf:"fixed64,2,opt,name=open,proto3" json:"open,omitempty"`
+	High  float64 `protobuf:"fixed64,3,opt,name=high,proto3" json:"high,omitempty"`
+	Low   float64 `protobuf:"fixed64,4,opt,name=low,proto3" json:"low,omitempty"`
+	Close float64 `protobuf:"fixed64,5,opt,name=close,proto3" json:"close,omitempty"`
+	// Shares bought or sold.
+	Volume float64 `protobuf:"fixed64,6,opt,name=volume,proto3" json:"volume,omitempty"`
 }
 
-func (x *GetSecurityCostsResponse) Reset() {
-	*x = GetSecurityCostsResponse{}
+func (x *Candle) Reset() {
+	*x = Candle{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_market_proto_msgTypes[13]
+		mi := &file_proto_market_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetSecurityCostsResponse) String() string {
+func (x *Candle) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSecurityCostsResponse) ProtoMessage() {}
+func (*Candle) ProtoMessage() {}
 
-func (x *GetSecurityCostsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_market_proto_msgTypes[13]
+func (x *Candle) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_market_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -910,75 +1833,63 @@ func (x *GetSecurityCostsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSecurityCostsResponse.ProtoReflect.Descriptor instead.
-func (*GetSecurityCostsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_market_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use Candle.ProtoReflect.Descriptor instead.
+func (*Candle) Descriptor() ([]byte, []int) {
+	return file_proto_market_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *GetSecurityCostsResponse) GetCosts() []*GetSecurityCostsResponse_SecurityCost {
+func (x *Candle) GetStart() string {
 	if x != nil {
-		return x.Costs
+		return x.Start
 	}
-	return nil
-}
-
-type CreateMarketRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Description string `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	return ""
 }
 
-func (x *CreateMarketRequest) Reset() {
-	*x = CreateMarketRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_market_proto_msgTypes[14]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Candle) GetOpen() float64 {
+	if x != nil {
+		return x.Open
 	}
+	return 0
 }
 
-func (x *CreateMarketRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Candle) GetHigh() float64 {
+	if x != nil {
+		return x.High
+	}
+	return 0
 }
 
-func (*CreateMarketRequest) ProtoMessage() {}
-
-func (x *CreateMarketRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_market_proto_msgTypes[14]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Candle) GetLow() float64 {
+	if x != nil {
+		return x.Low
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use CreateMarketRequest.ProtoReflect.Descriptor instead.
-func (*CreateMarketRequest) Descriptor() ([]byte, []int) {
-	return file_proto_market_proto_rawDescGZIP(), []int{14}
+func (x *Candle) GetClose() float64 {
+	if x != nil {
+		return x.Close
+	}
+	return 0
 }
 
-func (x *CreateMarketRequest) GetDescription() string {
+func (x *Candle) GetVolume() float64 {
 	if x != nil {
-		return x.Description
+		return x.Volume
 	}
-	return ""
+	return 0
 }
 
-type CreateMarketResponse struct {
+type GetCandlesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Securities []*GetCandlesResponse_SecurityCandles `protobuf:"bytes,1,rep,name=securities,proto3" json:"securities,omitempty"`
 }
 
-func (x *CreateMarketResponse) Reset() {
-	*x = CreateMarketResponse{}
+func (x *GetCandlesResponse) Reset() {
+	*x = GetCandlesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_market_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -986,13 +1897,13 @@ func (x *CreateMarketResponse) Reset() {
 	}
 }
 
-func (x *CreateMarketResponse) String() string {
+func (x *GetCandlesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateMarketResponse) ProtoMessage() {}
+func (*GetCandlesResponse) ProtoMessage() {}
 
-func (x *CreateMarketResponse) ProtoReflect() protoreflect.Message {
+func (x *GetCandlesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_market_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1004,28 +1915,28 @@ func (x *CreateMarketResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateMarketResponse.ProtoReflect.Descriptor instead.
-func (*CreateMarketResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCandlesResponse.ProtoReflect.Descriptor instead.
+func (*GetCandlesResponse) Descriptor() ([]byte, []int) {
 	return file_proto_market_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *CreateMarketResponse) GetId() string {
+func (x *GetCandlesResponse) GetSecurities() []*GetCandlesResponse_SecurityCandles {
 	if x != nil {
-		return x.Id
+		return x.Securities
 	}
-	return ""
+	return nil
 }
 
-type OpenMarketRequest struct {
+type GetSecuritiesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	MarketId string `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
 }
 
-func (x *OpenMarketRequest) Reset() {
-	*x = OpenMarketRequest{}
+func (x *GetSecuritiesRequest) Reset() {
+	*x = GetSecuritiesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_market_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1033,13 +1944,13 @@ func (x *OpenMarketRequest) Reset() {
 	}
 }
 
-func (x *OpenMarketRequest) String() string {
+func (x *GetSecuritiesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OpenMarketRequest) ProtoMessage() {}
+func (*GetSecuritiesRequest) ProtoMessage() {}
 
-func (x *OpenMarketRequest) ProtoReflect() protoreflect.Message {
+func (x *GetSecuritiesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_market_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1051,26 +1962,28 @@ func (x *OpenMarketRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OpenMarketRequest.ProtoReflect.Descriptor instead.
-func (*OpenMarketRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSecuritiesRequest.ProtoReflect.Descriptor instead.
+func (*GetSecuritiesRequest) Descriptor() ([]byte, []int) {
 	return file_proto_market_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *OpenMarketRequest) GetId() string {
+func (x *GetSecuritiesRequest) GetMarketId() string {
 	if x != nil {
-		return x.Id
+		return x.MarketId
 	}
 	return ""
 }
 
-type AdminServiceResponse struct {
+type GetSecuritiesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Securities []*Security `protobuf:"bytes,1,rep,name=securities,proto3" json:"securities,omitempty"`
 }
 
-func (x *AdminServiceResponse) Reset() {
-	*x = AdminServiceResponse{}
+func (x *GetSecuritiesResponse) Reset() {
+	*x = GetSecuritiesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_market_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1078,13 +1991,13 @@ func (x *AdminServiceResponse) Reset() {
 	}
 }
 
-func (x *AdminServiceResponse) String() string {
+func (x *GetSecuritiesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminServiceResponse) ProtoMessage() {}
+func (*GetSecuritiesResponse) ProtoMessage() {}
 
-func (x *AdminServiceResponse) ProtoReflect() protoreflect.Message {
+func (x *GetSecuritiesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_market_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1096,21 +2009,30 @@ func (x *AdminServiceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminServiceResponse.ProtoReflect.Descriptor instead.
-func (*AdminServiceResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSecuritiesResponse.ProtoReflect.Descriptor instead.
+func (*GetSecuritiesResponse) Descriptor() ([]byte, []int) {
 	return file_proto_market_proto_rawDescGZIP(), []int{17}
 }
 
-type DeleteMarketRequest struct {
+func (x *GetSecuritiesResponse) GetSecurities() []*Security {
+	if x != nil {
+		return x.Securities
+	}
+	return nil
+}
+
+type GetSecurityCostsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SecurityId string `protobuf:"bytes,1,opt,name=security_id,json=securityId,proto3" json:"security_id,omitempty"`
+	BeginDate  string `protobuf:"bytes,2,opt,name=begin_date,json=beginDate,proto3" json:"begin_date,omitempty"`
+	EndDate    string `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
 }
 
-func (x *DeleteMarketRequest) Reset() {
-	*x = DeleteMarketRequest{}
+func (x *GetSecurityCostsRequest) Reset() {
+	*x = GetSecurityCostsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_market_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1118,13 +2040,13 @@ func (x *DeleteMarketRequest) Reset() {
 	}
 }
 
-func (x *DeleteMarketRequest) String() string {
+func (x *GetSecurityCostsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteMarketRequest) ProtoMessage() {}
+func (*GetSecurityCostsRequest) ProtoMessage() {}
 
-func (x *DeleteMarketRequest) ProtoReflect() protoreflect.Message {
+func (x *GetSecurityCostsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_market_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1136,29 +2058,42 @@ func (x *DeleteMarketRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteMarketRequest.ProtoReflect.Descriptor instead.
-func (*DeleteMarketRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSecurityCostsRequest.ProtoReflect.Descriptor instead.
+func (*GetSecurityCostsRequest) Descriptor() ([]byte, []int) {
 	return file_proto_market_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *DeleteMarketRequest) GetId() string {
+func (x *GetSecurityCostsRequest) GetSecurityId() string {
 	if x != nil {
-		return x.Id
+		return x.SecurityId
 	}
 	return ""
 }
 
-type AddSecuritiesRequest struct {
+func (x *GetSecurityCostsRequest) GetBeginDate() string {
+	if x != nil {
+		return x.BeginDate
+	}
+	return ""
+}
+
+func (x *GetSecurityCostsRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+type GetSecurityCostsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MarketId   string                           `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	Securities []*AddSecuritiesRequest_Security `protobuf:"bytes,2,rep,name=securities,proto3" json:"securities,omitempty"`
+	Costs []*GetSecurityCostsResponse_SecurityCost `protobuf:"bytes,1,rep,name=costs,proto3" json:"costs,omitempty"`
 }
 
-func (x *AddSecuritiesRequest) Reset() {
-	*x = AddSecuritiesRequest{}
+func (x *GetSecurityCostsResponse) Reset() {
+	*x = GetSecurityCostsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_market_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1166,13 +2101,13 @@ func (x *AddSecuritiesRequest) Reset() {
 	}
 }
 
-func (x *AddSecuritiesRequest) String() string {
+func (x *GetSecurityCostsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddSecuritiesRequest) ProtoMessage() {}
+func (*GetSecurityCostsResponse) ProtoMessage() {}
 
-func (x *AddSecuritiesRequest) ProtoReflect() protoreflect.Message {
+func (x *GetSecurityCostsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_market_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1184,36 +2119,35 @@ func (x *AddSecuritiesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddSecuritiesRequest.ProtoReflect.Descriptor instead.
-func (*AddSecuritiesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSecurityCostsResponse.ProtoReflect.Descriptor instead.
+func (*GetSecurityCostsResponse) Descriptor() ([]byte, []int) {
 	return file_proto_market_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *AddSecuritiesRequest) GetMarketId() string {
-	if x != nil {
-		return x.MarketId
-	}
-	return ""
-}
-
-func (x *AddSecuritiesRequest) GetSecurities() []*AddSecuritiesRequest_Security {
+func (x *GetSecurityCostsResponse) GetCosts() []*GetSecurityCostsResponse_SecurityCost {
 	if x != nil {
-		return x.Securities
+		return x.Costs
 	}
 	return nil
 }
 
-type DeleteSecurityRequest struct {
+// Simulates the tournament that an EXCLUSIVE market is on, using the ratings
+// its securities were added with, to estimate each player's chances of
+// winning.
+type GetModelProbabilitiesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	MarketId string `protobuf:"bytes,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	MarketId      string        `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	Rounds        int32         `protobuf:"varint,2,opt,name=rounds,proto3" json:"rounds,omitempty"`
+	PairingSystem PairingSystem `protobuf:"varint,3,opt,name=pairing_system,json=pairingSystem,proto3,enum=market.PairingSystem" json:"pairing_system,omitempty"`
+	// Defaults to 10000.
+	Simulations int32 `protobuf:"varint,4,opt,name=simulations,proto3" json:"simulations,omitempty"`
 }
 
-func (x *DeleteSecurityRequest) Reset() {
-	*x = DeleteSecurityRequest{}
+func (x *GetModelProbabilitiesRequest) Reset() {
+	*x = GetModelProbabilitiesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_market_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1221,13 +2155,13 @@ func (x *DeleteSecurityRequest) Reset() {
 	}
 }
 
-func (x *DeleteSecurityRequest) String() string {
+func (x *GetModelProbabilitiesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteSecurityRequest) ProtoMessage() {}
+func (*GetModelProbabilitiesRequest) ProtoMessage() {}
 
-func (x *DeleteSecurityRequest) ProtoReflect() protoreflect.Message {
+func (x *GetModelProbabilitiesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_market_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1239,36 +2173,49 @@ func (x *DeleteSecurityRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteSecurityRequest.ProtoReflect.Descriptor instead.
-func (*DeleteSecurityRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetModelProbabilitiesRequest.ProtoReflect.Descriptor instead.
+func (*GetModelProbabilitiesRequest) Descriptor() ([]byte, []int) {
 	return file_proto_market_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *DeleteSecurityRequest) GetId() string {
+func (x *GetModelProbabilitiesRequest) GetMarketId() string {
 	if x != nil {
-		return x.Id
+		return x.MarketId
 	}
 	return ""
 }
 
-func (x *DeleteSecurityRequest) GetMarketId() string {
+func (x *GetModelProbabilitiesRequest) GetRounds() int32 {
 	if x != nil {
-		return x.MarketId
+		return x.Rounds
 	}
-	return ""
+	return 0
 }
 
-type ResolveMarketRequest struct {
+func (x *GetModelProbabilitiesRequest) GetPairingSystem() PairingSystem {
+	if x != nil {
+		return x.PairingSystem
+	}
+	return PairingSystem_ROUND_ROBIN
+}
+
+func (x *GetModelProbabilitiesRequest) GetSimulations() int32 {
+	if x != nil {
+		return x.Simulations
+	}
+	return 0
+}
+
+type GetModelProbabilitiesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MarketId    string                                     `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	Resolutions []*ResolveMarketRequest_SecurityResolution `protobuf:"bytes,2,rep,name=resolutions,proto3" json:"resolutions,omitempty"`
+	Probabilities []*GetModelProbabilitiesResponse_SecurityProbability `protobuf:"bytes,1,rep,name=probabilities,proto3" json:"probabilities,omitempty"`
 }
 
-func (x *ResolveMarketRequest) Reset() {
-	*x = ResolveMarketRequest{}
+func (x *GetModelProbabilitiesResponse) Reset() {
+	*x = GetModelProbabilitiesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_market_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1276,13 +2223,13 @@ func (x *ResolveMarketRequest) Reset() {
 	}
 }
 
-func (x *ResolveMarketRequest) String() string {
+func (x *GetModelProbabilitiesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResolveMarketRequest) ProtoMessage() {}
+func (*GetModelProbabilitiesResponse) ProtoMessage() {}
 
-func (x *ResolveMarketRequest) ProtoReflect() protoreflect.Message {
+func (x *GetModelProbabilitiesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_market_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1294,33 +2241,38 @@ func (x *ResolveMarketRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResolveMarketRequest.ProtoReflect.Descriptor instead.
-func (*ResolveMarketRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetModelProbabilitiesResponse.ProtoReflect.Descriptor instead.
+func (*GetModelProbabilitiesResponse) Descriptor() ([]byte, []int) {
 	return file_proto_market_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *ResolveMarketRequest) GetMarketId() string {
-	if x != nil {
-		return x.MarketId
-	}
-	return ""
-}
-
-func (x *ResolveMarketRequest) GetResolutions() []*ResolveMarketRequest_SecurityResolution {
+func (x *GetModelProbabilitiesResponse) GetProbabilities() []*GetModelProbabilitiesResponse_SecurityProbability {
 	if x != nil {
-		return x.Resolutions
+		return x.Probabilities
 	}
 	return nil
 }
 
-type ResolveMarketResponse struct {
+type CreateMarketRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Description string     `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	MarketType  MarketType `protobuf:"varint,2,opt,name=market_type,json=marketType,proto3,enum=market.MarketType" json:"market_type,omitempty"`
+	// Required for SCALAR markets.
+	LowerBound float64 `protobuf:"fixed64,3,opt,name=lower_bound,json=lowerBound,proto3" json:"lower_bound,omitempty"`
+	UpperBound float64 `protobuf:"fixed64,4,opt,name=upper_bound,json=upperBound,proto3" json:"upper_bound,omitempty"`
+	// Makes this a conditional market: if the given security, in another
+	// market, does not win when its market resolves, this market is voided.
+	// Otherwise it keeps trading and is resolved as usual.
+	ConditionSecurityId string `protobuf:"bytes,5,opt,name=condition_security_id,json=conditionSecurityId,proto3" json:"condition_security_id,omitempty"`
+	// Required for RANKING markets.
+	Players []string `protobuf:"bytes,6,rep,name=players,proto3" json:"players,omitempty"`
 }
 
-func (x *ResolveMarketResponse) Reset() {
-	*x = ResolveMarketResponse{}
+func (x *CreateMarketRequest) Reset() {
+	*x = CreateMarketRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_market_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1328,13 +2280,13 @@ func (x *ResolveMarketResponse) Reset() {
 	}
 }
 
-func (x *ResolveMarketResponse) String() string {
+func (x *CreateMarketRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResolveMarketResponse) ProtoMessage() {}
+func (*CreateMarketRequest) ProtoMessage() {}
 
-func (x *ResolveMarketResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateMarketRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_market_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1346,22 +2298,63 @@ func (x *ResolveMarketResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResolveMarketResponse.ProtoReflect.Descriptor instead.
-func (*ResolveMarketResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateMarketRequest.ProtoReflect.Descriptor instead.
+func (*CreateMarketRequest) Descriptor() ([]byte, []int) {
 	return file_proto_market_proto_rawDescGZIP(), []int{22}
 }
 
-type GetSecurityCostsResponse_SecurityCost struct {
+func (x *CreateMarketRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateMarketRequest) GetMarketType() MarketType {
+	if x != nil {
+		return x.MarketType
+	}
+	return MarketType_EXCLUSIVE
+}
+
+func (x *CreateMarketRequest) GetLowerBound() float64 {
+	if x != nil {
+		return x.LowerBound
+	}
+	return 0
+}
+
+func (x *CreateMarketRequest) GetUpperBound() float64 {
+	if x != nil {
+		return x.UpperBound
+	}
+	return 0
+}
+
+func (x *CreateMarketRequest) GetConditionSecurityId() string {
+	if x != nil {
+		return x.ConditionSecurityId
+	}
+	return ""
+}
+
+func (x *CreateMarketRequest) GetPlayers() []string {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+type CreateMarketResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Date string  `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
-	Cost float64 `protobuf:"fixed64,2,opt,name=cost,proto3" json:"cost,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *GetSecurityCostsResponse_SecurityCost) Reset() {
-	*x = GetSecurityCostsResponse_SecurityCost{}
+func (x *CreateMarketResponse) Reset() {
+	*x = CreateMarketResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_market_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1369,13 +2362,13 @@ func (x *GetSecurityCostsResponse_SecurityCost) Reset() {
 	}
 }
 
-func (x *GetSecurityCostsResponse_SecurityCost) String() string {
+func (x *CreateMarketResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSecurityCostsResponse_SecurityCost) ProtoMessage() {}
+func (*CreateMarketResponse) ProtoMessage() {}
 
-func (x *GetSecurityCostsResponse_SecurityCost) ProtoReflect() protoreflect.Message {
+func (x *CreateMarketResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_market_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1387,443 +2380,3653 @@ func (x *GetSecurityCostsResponse_SecurityCost) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSecurityCostsResponse_SecurityCost.ProtoReflect.Descriptor instead.
-func (*GetSecurityCostsResponse_SecurityCost) Descriptor() ([]byte, []int) {
-	return file_proto_market_proto_rawDescGZIP(), []int{13, 0}
+// Deprecated: Use CreateMarketResponse.ProtoReflect.Descriptor instead.
+func (*CreateMarketResponse) Descriptor() ([]byte, []int) {
+	return file_proto_market_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *GetSecurityCostsResponse_SecurityCost) GetDate() string {
+func (x *CreateMarketResponse) GetId() string {
 	if x != nil {
-		return x.Date
+		return x.Id
 	}
 	return ""
 }
 
-func (x *GetSecurityCostsResponse_SecurityCost) GetCost() float64 {
+type OpenMarketRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *OpenMarketRequest) Reset() {
+	*x = OpenMarketRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_market_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenMarketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenMarketRequest) ProtoMessage() {}
+
+func (x *OpenMarketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_market_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenMarketRequest.ProtoReflect.Descriptor instead.
+func (*OpenMarketRequest) Descriptor() ([]byte, []int) {
+	return file_proto_market_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *OpenMarketRequest) GetId() string {
 	if x != nil {
-		return x.Cost
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-type AddSecuritiesRequest_Security struct {
+type CloseMarketRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Description string `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
-	Shortname   string `protobuf:"bytes,2,opt,name=shortname,proto3" json:"shortname,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *AddSecuritiesRequest_Security) Reset() {
-	*x = AddSecuritiesRequest_Security{}
+func (x *CloseMarketRequest) Reset() {
+	*x = CloseMarketRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_market_proto_msgTypes[24]
+		mi := &file_proto_market_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddSecuritiesRequest_Security) String() string {
+func (x *CloseMarketRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddSecuritiesRequest_Security) ProtoMessage() {}
+func (*CloseMarketRequest) ProtoMessage() {}
 
-func (x *AddSecuritiesRequest_Security) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_market_proto_msgTypes[24]
+func (x *CloseMarketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_market_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use AddSecuritiesRequest_Security.ProtoReflect.Descriptor instead.
-func (*AddSecuritiesRequest_Security) Descriptor() ([]byte, []int) {
-	return file_proto_market_proto_rawDescGZIP(), []int{19, 0}
-}
-
-func (x *AddSecuritiesRequest_Security) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-func (x *AddSecuritiesRequest_Security) GetShortname() string {
-	if x != nil {
-		return x.Shortname
-	}
-	return ""
-}
-
-type ResolveMarketRequest_SecurityResolution struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	SecurityId string `protobuf:"bytes,1,opt,name=security_id,json=securityId,proto3" json:"security_id,omitempty"`
-	Wins       bool   `protobuf:"varint,2,opt,name=wins,proto3" json:"wins,omitempty"`
-}
-
-func (x *ResolveMarketRequest_SecurityResolution) Reset() {
-	*x = ResolveMarketRequest_SecurityResolution{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_market_proto_msgTypes[25]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *ResolveMarketRequest_SecurityResolution) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ResolveMarketRequest_SecurityResolution) ProtoMessage() {}
-
-func (x *ResolveMarketRequest_SecurityResolution) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_market_proto_msgTypes[25]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseMarketRequest.ProtoReflect.Descriptor instead.
+func (*CloseMarketRequest) Descriptor() ([]byte, []int) {
+	return file_proto_market_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CloseMarketRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type HaltMarketRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *HaltMarketRequest) Reset() {
+	*x = HaltMarketRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_market_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HaltMarketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HaltMarketRequest) ProtoMessage() {}
+
+func (x *HaltMarketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_market_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HaltMarketRequest.ProtoReflect.Descriptor instead.
+func (*HaltMarketRequest) Descriptor() ([]byte, []int) {
+	return file_proto_market_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *HaltMarketRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type VoidMarketRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *VoidMarketRequest) Reset() {
+	*x = VoidMarketRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_market_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VoidMarketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoidMarketRequest) ProtoMessage() {}
+
+func (x *VoidMarketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_market_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoidMarketRequest.ProtoReflect.Descriptor instead.
+func (*VoidMarketRequest) Descriptor() ([]byte, []int) {
+	return file_proto_market_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *VoidMarketRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Sets when a market is opened and closed, replacing any earlier schedule.
+// Setting open_at schedules a draft market, and leaving it empty unschedules
+// one; markets that have already opened can only have close_at set. Both are
+// RFC3339, and the market must open before it closes.
+type ScheduleMarketRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OpenAt  string `protobuf:"bytes,2,opt,name=open_at,json=openAt,proto3" json:"open_at,omitempty"`
+	CloseAt string `protobuf:"bytes,3,opt,name=close_at,json=closeAt,proto3" json:"close_at,omitempty"`
+}
+
+func (x *ScheduleMarketRequest) Reset() {
+	*x = ScheduleMarketRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_market_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduleMarketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleMarketRequest) ProtoMessage() {}
+
+func (x *ScheduleMarketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_market_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleMarketRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleMarketRequest) Descriptor() ([]byte, []int) {
+	return file_proto_market_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ScheduleMarketRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ScheduleMarketRequest) GetOpenAt() string {
+	if x != nil {
+		return x.OpenAt
+	}
+	return ""
+}
+
+func (x *ScheduleMarketRequest) GetCloseAt() string {
+	if x != nil {
+		return x.CloseAt
+	}
+	return ""
+}
+
+type AdminServiceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AdminServiceResponse) Reset() {
+	*x = AdminServiceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_market_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminServiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminServiceResponse) ProtoMessage() {}
+
+func (x *AdminServiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_market_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminServiceResponse.ProtoReflect.Descriptor instead.
+func (*AdminServiceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_market_proto_rawDescGZIP(), []int{29}
+}
+
+type DeleteMarketRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteMarketRequest) Reset() {
+	*x = DeleteMarketRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_market_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteMarketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMarketRequest) ProtoMessage() {}
+
+func (x *DeleteMarketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_market_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMarketRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMarketRequest) Descriptor() ([]byte, []int) {
+	return file_proto_market_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DeleteMarketRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type AddSecuritiesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MarketId   string                           `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	Securities []*AddSecuritiesRequest_Security `protobuf:"bytes,2,rep,name=securities,proto3" json:"securities,omitempty"`
+}
+
+func (x *AddSecuritiesRequest) Reset() {
+	*x = AddSecuritiesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_market_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddSecuritiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddSecuritiesRequest) ProtoMessage() {}
+
+func (x *AddSecuritiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_market_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddSecuritiesRequest.ProtoReflect.Descriptor instead.
+func (*AddSecuritiesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_market_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *AddSecuritiesRequest) GetMarketId() string {
+	if x != nil {
+		return x.MarketId
+	}
+	return ""
+}
+
+func (x *AddSecuritiesRequest) GetSecurities() []*AddSecuritiesRequest_Security {
+	if x != nil {
+		return x.Securities
+	}
+	return nil
+}
+
+type DeleteSecurityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	MarketId string `protobuf:"bytes,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+}
+
+func (x *DeleteSecurityRequest) Reset() {
+	*x = DeleteSecurityRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_market_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteSecurityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSecurityRequest) ProtoMessage() {}
+
+func (x *DeleteSecurityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_market_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSecurityRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSecurityRequest) Descriptor() ([]byte, []int) {
+	return file_proto_market_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *DeleteSecurityRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteSecurityRequest) GetMarketId() string {
+	if x != nil {
+		return x.MarketId
+	}
+	return ""
+}
+
+// For EXCLUSIVE markets, at least one security must win; if several do (a
+// tie), the payout is split evenly among them. For BINARY markets exactly one
+// of YES or NO must win. Securities that are not listed lose.
+// SCALAR markets are resolved with the actual value instead of resolutions,
+// and RANKING markets with the final standings of every player in the field.
+type ResolveMarketRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MarketId    string                                     `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	Resolutions []*ResolveMarketRequest_SecurityResolution `protobuf:"bytes,2,rep,name=resolutions,proto3" json:"resolutions,omitempty"`
+	Value       float64                                    `protobuf:"fixed64,3,opt,name=value,proto3" json:"value,omitempty"`
+	// Player names, from first place to last.
+	Standings []string `protobuf:"bytes,4,rep,name=standings,proto3" json:"standings,omitempty"`
+}
+
+func (x *ResolveMarketRequest) Reset() {
+	*x = ResolveMarketRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_market_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResolveMarketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveMarketRequest) ProtoMessage() {}
+
+func (x *ResolveMarketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_market_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveMarketRequest.ProtoReflect.Descriptor instead.
+func (*ResolveMarketRequest) Descriptor() ([]byte, []int) {
+	return file_proto_market_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ResolveMarketRequest) GetMarketId() string {
+	if x != nil {
+		return x.MarketId
+	}
+	return ""
+}
+
+func (x *ResolveMarketRequest) GetResolutions() []*ResolveMarketRequest_SecurityResolution {
+	if x != nil {
+		return x.Resolutions
+	}
+	return nil
+}
+
+func (x *ResolveMarketRequest) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *ResolveMarketRequest) GetStandings() []string {
+	if x != nil {
+		return x.Standings
+	}
+	return nil
+}
+
+type ResolveMarketResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ResolveMarketResponse) Reset() {
+	*x = ResolveMarketResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_market_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResolveMarketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveMarketResponse) ProtoMessage() {}
+
+func (x *ResolveMarketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_market_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveMarketResponse.ProtoReflect.Descriptor instead.
+func (*ResolveMarketResponse) Descriptor() ([]byte, []int) {
+	return file_proto_market_proto_rawDescGZIP(), []int{34}
+}
+
+type Pairing struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerOne string `protobuf:"bytes,1,opt,name=player_one,json=playerOne,proto3" json:"player_one,omitempty"`
+	PlayerTwo string `protobuf:"bytes,2,opt,name=player_two,json=playerTwo,proto3" json:"player_two,omitempty"`
+	// If both players' ratings are given, they seed the market's opening
+	// prices.
+	PlayerOneRating float64 `protobuf:"fixed64,3,opt,name=player_one_rating,json=playerOneRating,proto3" json:"player_one_rating,omitempty"`
+	PlayerTwoRating float64 `protobuf:"fixed64,4,opt,name=player_two_rating,json=playerTwoRating,proto3" json:"player_two_rating,omitempty"`
+}
+
+func (x *Pairing) Reset() {
+	*x = Pairing{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_market_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pairing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pairing) ProtoMessage() {}
+
+func (x *Pairing) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_market_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pairing.ProtoReflect.Descriptor instead.
+func (*Pairing) Descriptor() ([]byte, []int) {
+	return file_proto_market_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *Pairing) GetPlayerOne() string {
+	if x != nil {
+		return x.PlayerOne
+	}
+	return ""
+}
+
+func (x *Pairing) GetPlayerTwo() string {
+	if x != nil {
+		return x.PlayerTwo
+	}
+	return ""
+}
+
+func (x *Pairing) GetPlayerOneRating() float64 {
+	if x != nil {
+		return x.PlayerOneRating
+	}
+	return 0
+}
+
+func (x *Pairing) GetPlayerTwoRating() float64 {
+	if x != nil {
+		return x.PlayerTwoRating
+	}
+	return 0
+}
+
+type CreateMatchupMarketsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Describes the round, e.g. "Nationals 2022 round 5". Each market is
+	// described by this followed by its pairing.
+	Description string     `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	Pairings    []*Pairing `protobuf:"bytes,2,rep,name=pairings,proto3" json:"pairings,omitempty"`
+	// When the round starts (RFC3339), if it's known. Trading in its markets
+	// closes then.
+	CloseAt string `protobuf:"bytes,3,opt,name=close_at,json=closeAt,proto3" json:"close_at,omitempty"`
+}
+
+func (x *CreateMatchupMarketsRequest) Reset() {
+	*x = CreateMatchupMarketsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_market_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateMatchupMarketsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMatchupMarketsRequest) ProtoMessage() {}
+
+func (x *CreateMatchupMarketsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_market_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMatchupMarketsRequest.ProtoReflect.Descriptor instead.
+func (*CreateMatchupMarketsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_market_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CreateMatchupMarketsRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateMatchupMarketsRequest) GetPairings() []*Pairing {
+	if x != nil {
+		return x.Pairings
+	}
+	return nil
+}
+
+func (x *CreateMatchupMarketsRequest) GetCloseAt() string {
+	if x != nil {
+		return x.CloseAt
+	}
+	return ""
+}
+
+type CreateMatchupMarketsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The IDs of the new markets, in the same order as the pairings.
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (x *CreateMatchupMarketsResponse) Reset() {
+	*x = CreateMatchupMarketsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_market_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateMatchupMarketsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMatchupMarketsResponse) ProtoMessage() {}
+
+func (x *CreateMatchupMarketsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_market_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMatchupMarketsResponse.ProtoReflect.Descriptor instead.
+func (*CreateMatchupMarketsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_market_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CreateMatchupMarketsResponse) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type ResolveMatchupMarketsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*ResolveMatchupMarketsRequest_Result `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *ResolveMatchupMarketsRequest) Reset() {
+	*x = ResolveMatchupMarketsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_market_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResolveMatchupMarketsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveMatchupMarketsRequest) ProtoMessage() {}
+
+func (x *ResolveMatchupMarketsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_market_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveMatchupMarketsRequest.ProtoReflect.Descriptor instead.
+func (*ResolveMatchupMarketsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_market_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ResolveMatchupMarketsRequest) GetResults() []*ResolveMatchupMarketsRequest_Result {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// The result of one game in the tournament that a market is on. A bye has
+// no opponent.
+type GameResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Round         int32  `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"` // 1-indexed
+	Player        string `protobuf:"bytes,2,opt,name=player,proto3" json:"player,omitempty"`
+	Opponent      string `protobuf:"bytes,3,opt,name=opponent,proto3" json:"opponent,omitempty"`
+	PlayerScore   int32  `protobuf:"varint,4,opt,name=player_score,json=playerScore,proto3" json:"player_score,omitempty"`
+	OpponentScore int32  `protobuf:"varint,5,opt,name=opponent_score,json=opponentScore,proto3" json:"opponent_score,omitempty"`
+}
+
+func (x *GameResult) Reset() {
+	*x = GameResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_market_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameResult) ProtoMessage() {}
+
+func (x *GameResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_market_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameResult.ProtoReflect.Descriptor instead.
+func (*GameResult) Descriptor() ([]byte, []int) {
+	return file_proto_market_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GameResult) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *GameResult) GetPlayer() string {
+	if x != nil {
+		return x.Player
+	}
+	return ""
+}
+
+func (x *GameResult) GetOpponent() string {
+	if x != nil {
+		return x.Opponent
+	}
+	return ""
+}
+
+func (x *GameResult) GetPlayerScore() int32 {
+	if x != nil {
+		return x.PlayerScore
+	}
+	return 0
+}
+
+func (x *GameResult) GetOpponentScore() int32 {
+	if x != nil {
+		return x.OpponentScore
+	}
+	return 0
+}
+
+type SubmitGameResultsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MarketId string        `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	Results  []*GameResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *SubmitGameResultsRequest) Reset() {
+	*x = SubmitGameResultsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_market_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitGameResultsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitGameResultsRequest) ProtoMessage() {}
+
+func (x *SubmitGameResultsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_market_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitGameResultsRequest.ProtoReflect.Descriptor instead.
+func (*SubmitGameResultsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_market_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SubmitGameResultsRequest) GetMarketId() string {
+	if x != nil {
+		return x.MarketId
+	}
+	return ""
+}
+
+func (x *SubmitGameResultsRequest) GetResults() []*GameResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type SubmitGameResultsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// How many of the results were new or corrected an earlier result.
+	// Resubmitting a result that is already stored changes nothing.
+	Changed int32 `protobuf:"varint,1,opt,name=changed,proto3" json:"changed,omitempty"`
+}
+
+func (x *SubmitGameResultsResponse) Reset() {
+	*x = SubmitGameResultsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_market_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitGameResultsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitGameResultsResponse) ProtoMessage() {}
+
+func (x *SubmitGameResultsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_market_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitGameResultsResponse.ProtoReflect.Descriptor instead.
+func (*SubmitGameResultsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_market_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SubmitGameResultsResponse) GetChanged() int32 {
+	if x != nil {
+		return x.Changed
+	}
+	return 0
+}
+
+// How well a resolved market's prices predicted its outcome. The Brier score
+// is the sum over securities of (price - outcome)^2, with prices and
+// outcomes as fractions of the full payout. Log-loss is the cross-entropy
+// of the prices against the outcome; in ranking markets each security is
+// scored as a separate yes/no forecast. Lower is better for both.
+type MarketScore struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MarketId   string          `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	Checkpoint ScoreCheckpoint `protobuf:"varint,2,opt,name=checkpoint,proto3,enum=market.ScoreCheckpoint" json:"checkpoint,omitempty"`
+	Brier      float64         `protobuf:"fixed64,3,opt,name=brier,proto3" json:"brier,omitempty"`
+	LogLoss    float64         `protobuf:"fixed64,4,opt,name=log_loss,json=logLoss,proto3" json:"log_loss,omitempty"`
+	DateScored string          `protobuf:"bytes,5,opt,name=date_scored,json=dateScored,proto3" json:"date_scored,omitempty"`
+}
+
+func (x *MarketScore) Reset() {
+	*x = MarketScore{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_market_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketScore) ProtoMessage() {}
+
+func (x *MarketScore) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_market_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketScore.ProtoReflect.Descriptor instead.
+func (*MarketScore) Descriptor() ([]byte, []int) {
+	return file_proto_market_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *MarketScore) GetMarketId() string {
+	if x != nil {
+		return x.MarketId
+	}
+	return ""
+}
+
+func (x *MarketScore) GetCheckpoint() ScoreCheckpoint {
+	if x != nil {
+		return x.Checkpoint
+	}
+	return ScoreCheckpoint_TIME_WEIGHTED
+}
+
+func (x *MarketScore) GetBrier() float64 {
+	if x != nil {
+		return x.Brier
+	}
+	return 0
+}
+
+func (x *MarketScore) GetLogLoss() float64 {
+	if x != nil {
+		return x.LogLoss
+	}
+	return 0
+}
+
+func (x *MarketScore) GetDateScored() string {
+	if x != nil {
+		return x.DateScored
+	}
+	return ""
+}
+
+type GetMarketScoresRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// If empty, the scores for every scored market are returned.
+	MarketId string `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+}
+
+func (x *GetMarketScoresRequest) Reset() {
+	*x = GetMarketScoresRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_market_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMarketScoresRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMarketScoresRequest) ProtoMessage() {}
+
+func (x *GetMarketScoresRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_market_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMarketScoresRequest.ProtoReflect.Descriptor instead.
+func (*GetMarketScoresRequest) Descriptor() ([]byte, []int) {
+	return file_proto_market_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetMarketScoresRequest) GetMarketId() string {
+	if x != nil {
+		return x.MarketId
+	}
+	return ""
+}
+
+type GetMarketScoresResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Scores []*MarketScore `protobuf:"bytes,1,rep,name=scores,proto3" json:"scores,omitempty"`
+}
+
+func (x *GetMarketScoresResponse) Reset() {
+	*x = GetMarketScoresResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_market_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMarketScoresResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMarketScoresResponse) ProtoMessage() {}
+
+func (x *GetMarketScoresResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_market_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMarketScoresResponse.ProtoReflect.Descriptor instead.
+func (*GetMarketScoresResponse) Descriptor() ([]byte, []int) {
+	return file_proto_market_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetMarketScoresResponse) GetScores() []*MarketScore {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+// The leaderboard ranks users by portfolio value: their tokens plus their
+// holdings in unresolved markets, marked at last_price. If it is filtered to
+// a market or a time window, it ranks them by the P&L of their trades in it
+// instead.
+type GetLeaderboardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Only count trades in this market.
+	MarketId string `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// Only count trades made between these dates (RFC3339, inclusive). Either
+	// can be left empty.
+	BeginDate string `protobuf:"bytes,2,opt,name=begin_date,json=beginDate,proto3" json:"begin_date,omitempty"`
+	EndDate   string `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// 0 means no limit.
+	Limit  int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset int32 `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+}
+
+func (x *GetLeaderboardRequest) Reset() {
+	*x = GetLeaderboardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_market_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLeaderboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeaderboardRequest) ProtoMessage() {}
+
+func (x *GetLeaderboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_market_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeaderboardRequest.ProtoReflect.Descriptor instead.
+func (*GetLeaderboardRequest) Descriptor() ([]byte, []int) {
+	return file_proto_market_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetLeaderboardRequest) GetMarketId() string {
+	if x != nil {
+		return x.MarketId
+	}
+	return ""
+}
+
+func (x *GetLeaderboardRequest) GetBeginDate() string {
+	if x != nil {
+		return x.BeginDate
+	}
+	return ""
+}
+
+func (x *GetLeaderboardRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *GetLeaderboardRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetLeaderboardRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type LeaderboardEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rank     int32  `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"` // 1-indexed
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// These aren't affected by the request's filters.
+	PortfolioValue float64 `protobuf:"fixed64,3,opt,name=portfolio_value,json=portfolioValue,proto3" json:"portfolio_value,omitempty"`
+	// What the user was first granted. Later grants aren't counted.
+	StartingTokens float64 `protobuf:"fixed64,4,opt,name=starting_tokens,json=startingTokens,proto3" json:"starting_tokens,omitempty"`
+	// A trade's P&L is what its shares are worth (their payout if the market
+	// resolved, otherwise last_price) less what it cost. Realized P&L is from
+	// trades in resolved markets, and unrealized from the rest. Trades in
+	// voided markets were refunded and don't count.
+	RealizedPnl   float64 `protobuf:"fixed64,5,opt,name=realized_pnl,json=realizedPnl,proto3" json:"realized_pnl,omitempty"`
+	UnrealizedPnl float64 `protobuf:"fixed64,6,opt,name=unrealized_pnl,json=unrealizedPnl,proto3" json:"unrealized_pnl,omitempty"`
+	// Realized plus unrealized P&L, as a fraction of starting tokens.
+	ReturnOnStart float64 `protobuf:"fixed64,7,opt,name=return_on_start,json=returnOnStart,proto3" json:"return_on_start,omitempty"`
+}
+
+func (x *LeaderboardEntry) Reset() {
+	*x = LeaderboardEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_market_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaderboardEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaderboardEntry) ProtoMessage() {}
+
+func (x *LeaderboardEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_market_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaderboardEntry.ProtoReflect.Descriptor instead.
+func (*LeaderboardEntry) Descriptor() ([]byte, []int) {
+	return file_proto_market_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *LeaderboardEntry) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *LeaderboardEntry) GetPortfolioValue() float64 {
+	if x != nil {
+		return x.PortfolioValue
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetStartingTokens() float64 {
+	if x != nil {
+		return x.StartingTokens
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetRealizedPnl() float64 {
+	if x != nil {
+		return x.RealizedPnl
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetUnrealizedPnl() float64 {
+	if x != nil {
+		return x.UnrealizedPnl
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetReturnOnStart() float64 {
+	if x != nil {
+		return x.ReturnOnStart
+	}
+	return 0
+}
+
+type GetLeaderboardResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*LeaderboardEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	// How many users are on the leaderboard, across all pages.
+	Total int32 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *GetLeaderboardResponse) Reset() {
+	*x = GetLeaderboardResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_market_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLeaderboardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeaderboardResponse) ProtoMessage() {}
+
+func (x *GetLeaderboardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_market_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeaderboardResponse.ProtoReflect.Descriptor instead.
+func (*GetLeaderboardResponse) Descriptor() ([]byte, []int) {
+	return file_proto_market_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetLeaderboardResponse) GetEntries() []*LeaderboardEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *GetLeaderboardResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type GetGameResultsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MarketId string `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+}
+
+func (x *GetGameResultsRequest) Reset() {
+	*x = GetGameResultsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_market_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGameResultsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameResultsRequest) ProtoMessage() {}
+
+func (x *GetGameResultsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_market_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameResultsRequest.ProtoReflect.Descriptor instead.
+func (*GetGameResultsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_market_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetGameResultsRequest) GetMarketId() string {
+	if x != nil {
+		return x.MarketId
+	}
+	return ""
+}
+
+type GetGameResultsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*GameResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *GetGameResultsResponse) Reset() {
+	*x = GetGameResultsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_market_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGameResultsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameResultsResponse) ProtoMessage() {}
+
+func (x *GetGameResultsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_market_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameResultsResponse.ProtoReflect.Descriptor instead.
+func (*GetGameResultsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_market_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetGameResultsResponse) GetResults() []*GameResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type LedgerEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind         LedgerEntryKind `protobuf:"varint,2,opt,name=kind,proto3,enum=market.LedgerEntryKind" json:"kind,omitempty"`
+	FromAccount  string          `protobuf:"bytes,3,opt,name=from_account,json=fromAccount,proto3" json:"from_account,omitempty"`
+	ToAccount    string          `protobuf:"bytes,4,opt,name=to_account,json=toAccount,proto3" json:"to_account,omitempty"`
+	AmountMicros int64           `protobuf:"varint,5,opt,name=amount_micros,json=amountMicros,proto3" json:"amount_micros,omitempty"` // always positive
+	// The market and order that the entry is for, if any.
+	MarketId string `protobuf:"bytes,6,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	OrderId  string `protobuf:"bytes,7,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Date     string `protobuf:"bytes,8,opt,name=date,proto3" json:"date,omitempty"`
+	// The user's balance once this entry was made.
+	BalanceMicros int64 `protobuf:"varint,9,opt,name=balance_micros,json=balanceMicros,proto3" json:"balance_micros,omitempty"`
+}
+
+func (x *LedgerEntry) Reset() {
+	*x = LedgerEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_market_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LedgerEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerEntry) ProtoMessage() {}
+
+func (x *LedgerEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_market_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerEntry.ProtoReflect.Descriptor instead.
+func (*LedgerEntry) Descriptor() ([]byte, []int) {
+	return file_proto_market_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *LedgerEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetKind() LedgerEntryKind {
+	if x != nil {
+		return x.Kind
+	}
+	return LedgerEntryKind_GRANT
+}
+
+func (x *LedgerEntry) GetFromAccount() string {
+	if x != nil {
+		return x.FromAccount
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetToAccount() string {
+	if x != nil {
+		return x.ToAccount
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetAmountMicros() int64 {
+	if x != nil {
+		return x.AmountMicros
+	}
+	return 0
+}
+
+func (x *LedgerEntry) GetMarketId() string {
+	if x != nil {
+		return x.MarketId
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetBalanceMicros() int64 {
+	if x != nil {
+		return x.BalanceMicros
+	}
+	return 0
+}
+
+type GetLedgerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Only entries made between these dates (RFC3339, inclusive). Either can be
+	// left empty.
+	BeginDate string `protobuf:"bytes,1,opt,name=begin_date,json=beginDate,proto3" json:"begin_date,omitempty"`
+	EndDate   string `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+}
+
+func (x *GetLedgerRequest) Reset() {
+	*x = GetLedgerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_market_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLedgerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLedgerRequest) ProtoMessage() {}
+
+func (x *GetLedgerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_market_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLedgerRequest.ProtoReflect.Descriptor instead.
+func (*GetLedgerRequest) Descriptor() ([]byte, []int) {
+	return file_proto_market_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetLedgerRequest) GetBeginDate() string {
+	if x != nil {
+		return x.BeginDate
+	}
+	return ""
+}
+
+func (x *GetLedgerRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+// The logged-in user's statement, oldest entry first.
+type GetLedgerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries       []*LedgerEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	BalanceMicros int64          `protobuf:"varint,2,opt,name=balance_micros,json=balanceMicros,proto3" json:"balance_micros,omitempty"`
+}
+
+func (x *GetLedgerResponse) Reset() {
+	*x = GetLedgerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_market_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLedgerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLedgerResponse) ProtoMessage() {}
+
+func (x *GetLedgerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_market_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLedgerResponse.ProtoReflect.Descriptor instead.
+func (*GetLedgerResponse) Descriptor() ([]byte, []int) {
+	return file_proto_market_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetLedgerResponse) GetEntries() []*LedgerEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *GetLedgerResponse) GetBalanceMicros() int64 {
+	if x != nil {
+		return x.BalanceMicros
+	}
+	return 0
+}
+
+type GrantTokensRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username     string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	AmountMicros int64  `protobuf:"varint,2,opt,name=amount_micros,json=amountMicros,proto3" json:"amount_micros,omitempty"`
+}
+
+func (x *GrantTokensRequest) Reset() {
+	*x = GrantTokensRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_market_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GrantTokensRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantTokensRequest) ProtoMessage() {}
+
+func (x *GrantTokensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_market_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantTokensRequest.ProtoReflect.Descriptor instead.
+func (*GrantTokensRequest) Descriptor() ([]byte, []int) {
+	return file_proto_market_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GrantTokensRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *GrantTokensRequest) GetAmountMicros() int64 {
+	if x != nil {
+		return x.AmountMicros
+	}
+	return 0
+}
+
+// One row that breaks an invariant. Only the ids that the row is about are
+// set.
+type InvariantViolation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Invariant  Invariant `protobuf:"varint,1,opt,name=invariant,proto3,enum=market.Invariant" json:"invariant,omitempty"`
+	MarketId   string    `protobuf:"bytes,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	SecurityId string    `protobuf:"bytes,3,opt,name=security_id,json=securityId,proto3" json:"security_id,omitempty"`
+	Username   string    `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
+	// What the invariant says the value should be, and what it is. Micro-units
+	// are written as integers.
+	Expected string `protobuf:"bytes,5,opt,name=expected,proto3" json:"expected,omitempty"`
+	Actual   string `protobuf:"bytes,6,opt,name=actual,proto3" json:"actual,omitempty"`
+}
+
+func (x *InvariantViolation) Reset() {
+	*x = InvariantViolation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_market_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvariantViolation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvariantViolation) ProtoMessage() {}
+
+func (x *InvariantViolation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_market_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvariantViolation.ProtoReflect.Descriptor instead.
+func (*InvariantViolation) Descriptor() ([]byte, []int) {
+	return file_proto_market_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *InvariantViolation) GetInvariant() Invariant {
+	if x != nil {
+		return x.Invariant
+	}
+	return Invariant_SHARES_OUTSTANDING
+}
+
+func (x *InvariantViolation) GetMarketId() string {
+	if x != nil {
+		return x.MarketId
+	}
+	return ""
+}
+
+func (x *InvariantViolation) GetSecurityId() string {
+	if x != nil {
+		return x.SecurityId
+	}
+	return ""
+}
+
+func (x *InvariantViolation) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *InvariantViolation) GetExpected() string {
+	if x != nil {
+		return x.Expected
+	}
+	return ""
+}
+
+func (x *InvariantViolation) GetActual() string {
+	if x != nil {
+		return x.Actual
+	}
+	return ""
+}
+
+type AuditRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AuditRequest) Reset() {
+	*x = AuditRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_market_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditRequest) ProtoMessage() {}
+
+func (x *AuditRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_market_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditRequest.ProtoReflect.Descriptor instead.
+func (*AuditRequest) Descriptor() ([]byte, []int) {
+	return file_proto_market_proto_rawDescGZIP(), []int{55}
+}
+
+type AuditResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Violations []*InvariantViolation `protobuf:"bytes,1,rep,name=violations,proto3" json:"violations,omitempty"`
+}
+
+func (x *AuditResponse) Reset() {
+	*x = AuditResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_market_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditResponse) ProtoMessage() {}
+
+func (x *AuditResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_market_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditResponse.ProtoReflect.Descriptor instead.
+func (*AuditResponse) Descriptor() ([]byte, []int) {
+	return file_proto_market_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *AuditResponse) GetViolations() []*InvariantViolation {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+type GetCandlesResponse_SecurityCandles struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SecurityId string    `protobuf:"bytes,1,opt,name=security_id,json=securityId,proto3" json:"security_id,omitempty"`
+	Shortname  string    `protobuf:"bytes,2,opt,name=shortname,proto3" json:"shortname,omitempty"`
+	Candles    []*Candle `protobuf:"bytes,3,rep,name=candles,proto3" json:"candles,omitempty"`
+}
+
+func (x *GetCandlesResponse_SecurityCandles) Reset() {
+	*x = GetCandlesResponse_SecurityCandles{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_market_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCandlesResponse_SecurityCandles) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCandlesResponse_SecurityCandles) ProtoMessage() {}
+
+func (x *GetCandlesResponse_SecurityCandles) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_market_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCandlesResponse_SecurityCandles.ProtoReflect.Descriptor instead.
+func (*GetCandlesResponse_SecurityCandles) Descriptor() ([]byte, []int) {
+	return file_proto_market_proto_rawDescGZIP(), []int{15, 0}
+}
+
+func (x *GetCandlesResponse_SecurityCandles) GetSecurityId() string {
+	if x != nil {
+		return x.SecurityId
+	}
+	return ""
+}
+
+func (x *GetCandlesResponse_SecurityCandles) GetShortname() string {
+	if x != nil {
+		return x.Shortname
+	}
+	return ""
+}
+
+func (x *GetCandlesResponse_SecurityCandles) GetCandles() []*Candle {
+	if x != nil {
+		return x.Candles
+	}
+	return nil
+}
+
+type GetSecurityCostsResponse_SecurityCost struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Date string  `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Cost float64 `protobuf:"fixed64,2,opt,name=cost,proto3" json:"cost,omitempty"`
+}
+
+func (x *GetSecurityCostsResponse_SecurityCost) Reset() {
+	*x = GetSecurityCostsResponse_SecurityCost{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_market_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSecurityCostsResponse_SecurityCost) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSecurityCostsResponse_SecurityCost) ProtoMessage() {}
+
+func (x *GetSecurityCostsResponse_SecurityCost) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_market_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSecurityCostsResponse_SecurityCost.ProtoReflect.Descriptor instead.
+func (*GetSecurityCostsResponse_SecurityCost) Descriptor() ([]byte, []int) {
+	return file_proto_market_proto_rawDescGZIP(), []int{19, 0}
+}
+
+func (x *GetSecurityCostsResponse_SecurityCost) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *GetSecurityCostsResponse_SecurityCost) GetCost() float64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+type GetModelProbabilitiesResponse_SecurityProbability struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SecurityId string `protobuf:"bytes,1,opt,name=security_id,json=securityId,proto3" json:"security_id,omitempty"`
+	Shortname  string `protobuf:"bytes,2,opt,name=shortname,proto3" json:"shortname,omitempty"`
+	// Between 0 and 1.
+	ModelProbability float64 `protobuf:"fixed64,3,opt,name=model_probability,json=modelProbability,proto3" json:"model_probability,omitempty"`
+	// Between 0 and the full payout of 100.
+	LastPrice float64 `protobuf:"fixed64,4,opt,name=last_price,json=lastPrice,proto3" json:"last_price,omitempty"`
+}
+
+func (x *GetModelProbabilitiesResponse_SecurityProbability) Reset() {
+	*x = GetModelProbabilitiesResponse_SecurityProbability{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_market_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetModelProbabilitiesResponse_SecurityProbability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetModelProbabilitiesResponse_SecurityProbability) ProtoMessage() {}
+
+func (x *GetModelProbabilitiesResponse_SecurityProbability) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_market_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetModelProbabilitiesResponse_SecurityProbability.ProtoReflect.Descriptor instead.
+func (*GetModelProbabilitiesResponse_SecurityProbability) Descriptor() ([]byte, []int) {
+	return file_proto_market_proto_rawDescGZIP(), []int{21, 0}
+}
+
+func (x *GetModelProbabilitiesResponse_SecurityProbability) GetSecurityId() string {
+	if x != nil {
+		return x.SecurityId
+	}
+	return ""
+}
+
+func (x *GetModelProbabilitiesResponse_SecurityProbability) GetShortname() string {
+	if x != nil {
+		return x.Shortname
+	}
+	return ""
+}
+
+func (x *GetModelProbabilitiesResponse_SecurityProbability) GetModelProbability() float64 {
+	if x != nil {
+		return x.ModelProbability
+	}
+	return 0
+}
+
+func (x *GetModelProbabilitiesResponse_SecurityProbability) GetLastPrice() float64 {
+	if x != nil {
+		return x.LastPrice
+	}
+	return 0
+}
+
+type AddSecuritiesRequest_Security struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Description string `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	Shortname   string `protobuf:"bytes,2,opt,name=shortname,proto3" json:"shortname,omitempty"`
+	// Required for securities in RANKING markets. See Security.
+	Player    string  `protobuf:"bytes,3,opt,name=player,proto3" json:"player,omitempty"`
+	Positions []int32 `protobuf:"varint,4,rep,packed,name=positions,proto3" json:"positions,omitempty"`
+	// Optionally seeds the opening prices of a market's securities, instead
+	// of every security opening at the same price. Either every security or
+	// none of them must have an initial probability (which must add up to
+	// 1), or a player rating from which one is calculated. Seeding is only
+	// possible when the securities are the first to be added to an
+	// EXCLUSIVE market. A rating of 0 means no rating.
+	InitialProbability float64 `protobuf:"fixed64,5,opt,name=initial_probability,json=initialProbability,proto3" json:"initial_probability,omitempty"`
+	Rating             float64 `protobuf:"fixed64,6,opt,name=rating,proto3" json:"rating,omitempty"`
+}
+
+func (x *AddSecuritiesRequest_Security) Reset() {
+	*x = AddSecuritiesRequest_Security{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_market_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddSecuritiesRequest_Security) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddSecuritiesRequest_Security) ProtoMessage() {}
+
+func (x *AddSecuritiesRequest_Security) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_market_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddSecuritiesRequest_Security.ProtoReflect.Descriptor instead.
+func (*AddSecuritiesRequest_Security) Descriptor() ([]byte, []int) {
+	return file_proto_market_proto_rawDescGZIP(), []int{31, 0}
+}
+
+func (x *AddSecuritiesRequest_Security) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *AddSecuritiesRequest_Security) GetShortname() string {
+	if x != nil {
+		return x.Shortname
+	}
+	return ""
+}
+
+func (x *AddSecuritiesRequest_Security) GetPlayer() string {
+	if x != nil {
+		return x.Player
+	}
+	return ""
+}
+
+func (x *AddSecuritiesRequest_Security) GetPositions() []int32 {
+	if x != nil {
+		return x.Positions
+	}
+	return nil
+}
+
+func (x *AddSecuritiesRequest_Security) GetInitialProbability() float64 {
+	if x != nil {
+		return x.InitialProbability
+	}
+	return 0
+}
+
+func (x *AddSecuritiesRequest_Security) GetRating() float64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+type ResolveMarketRequest_SecurityResolution struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SecurityId string `protobuf:"bytes,1,opt,name=security_id,json=securityId,proto3" json:"security_id,omitempty"`
+	Wins       bool   `protobuf:"varint,2,opt,name=wins,proto3" json:"wins,omitempty"`
+}
+
+func (x *ResolveMarketRequest_SecurityResolution) Reset() {
+	*x = ResolveMarketRequest_SecurityResolution{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_market_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResolveMarketRequest_SecurityResolution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveMarketRequest_SecurityResolution) ProtoMessage() {}
+
+func (x *ResolveMarketRequest_SecurityResolution) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_market_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveMarketRequest_SecurityResolution.ProtoReflect.Descriptor instead.
+func (*ResolveMarketRequest_SecurityResolution) Descriptor() ([]byte, []int) {
+	return file_proto_market_proto_rawDescGZIP(), []int{33, 0}
+}
+
+func (x *ResolveMarketRequest_SecurityResolution) GetSecurityId() string {
+	if x != nil {
+		return x.SecurityId
+	}
+	return ""
+}
+
+func (x *ResolveMarketRequest_SecurityResolution) GetWins() bool {
+	if x != nil {
+		return x.Wins
+	}
+	return false
+}
+
+type ResolveMatchupMarketsRequest_Result struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MarketId       string `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	PlayerOneScore int32  `protobuf:"varint,2,opt,name=player_one_score,json=playerOneScore,proto3" json:"player_one_score,omitempty"`
+	PlayerTwoScore int32  `protobuf:"varint,3,opt,name=player_two_score,json=playerTwoScore,proto3" json:"player_two_score,omitempty"`
+}
+
+func (x *ResolveMatchupMarketsRequest_Result) Reset() {
+	*x = ResolveMatchupMarketsRequest_Result{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_market_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResolveMatchupMarketsRequest_Result) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveMatchupMarketsRequest_Result) ProtoMessage() {}
+
+func (x *ResolveMatchupMarketsRequest_Result) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_market_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveMatchupMarketsRequest_Result.ProtoReflect.Descriptor instead.
+func (*ResolveMatchupMarketsRequest_Result) Descriptor() ([]byte, []int) {
+	return file_proto_market_proto_rawDescGZIP(), []int{38, 0}
+}
+
+func (x *ResolveMatchupMarketsRequest_Result) GetMarketId() string {
+	if x != nil {
+		return x.MarketId
+	}
+	return ""
+}
+
+func (x *ResolveMatchupMarketsRequest_Result) GetPlayerOneScore() int32 {
+	if x != nil {
+		return x.PlayerOneScore
+	}
+	return 0
+}
+
+func (x *ResolveMatchupMarketsRequest_Result) GetPlayerTwoScore() int32 {
+	if x != nil {
+		return x.PlayerTwoScore
+	}
+	return 0
+}
+
+var File_proto_market_proto protoreflect.FileDescriptor
+
+var file_proto_market_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x22, 0x92, 0x05, 0x0a,
+	0x06, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x61, 0x74,
+	0x65, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
+	0x64, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x12, 0x1b, 0x0a,
+	0x07, 0x69, 0x73, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x42, 0x02,
+	0x18, 0x01, 0x52, 0x06, 0x69, 0x73, 0x4f, 0x70, 0x65, 0x6e, 0x12, 0x33, 0x0a, 0x0b, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x12, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x0a, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x23, 0x0a, 0x0d, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x62, 0x6f,
+	0x75, 0x6e, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x6c, 0x6f, 0x77, 0x65, 0x72,
+	0x42, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x70, 0x70, 0x65, 0x72, 0x5f, 0x62,
+	0x6f, 0x75, 0x6e, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x75, 0x70, 0x70, 0x65,
+	0x72, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x32, 0x0a, 0x15, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f,
+	0x69, 0x64, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x76, 0x6f, 0x69, 0x64,
+	0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x0c, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x30, 0x0a, 0x0a,
+	0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69,
+	0x74, 0x79, 0x52, 0x0a, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x2d,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15,
+	0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x17, 0x0a,
+	0x07, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x61, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6f, 0x70, 0x65, 0x6e, 0x41, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f,
+	0x61, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x41,
+	0x74, 0x22, 0x5e, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x09, 0x0a, 0x05, 0x44,
+	0x52, 0x41, 0x46, 0x54, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x01,
+	0x12, 0x0a, 0x0a, 0x06, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08,
+	0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x43,
+	0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x12, 0x0a, 0x0a, 0x06, 0x48, 0x41, 0x4c,
+	0x54, 0x45, 0x44, 0x10, 0x05, 0x12, 0x0a, 0x0a, 0x06, 0x56, 0x4f, 0x49, 0x44, 0x45, 0x44, 0x10,
+	0x06, 0x22, 0xf6, 0x02, 0x0a, 0x08, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21,
+	0x0a, 0x0c, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x31,
+	0x0a, 0x12, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x5f, 0x6f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x42, 0x02, 0x18, 0x01, 0x52, 0x11,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x05, 0x52, 0x09, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x3a,
+	0x0a, 0x19, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x5f, 0x6f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x17, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x22, 0xa0, 0x02, 0x0a, 0x05, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x49,
+	0x64, 0x12, 0x2d, 0x0a, 0x12, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x73, 0x68,
+	0x6f, 0x72, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1a, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01,
+	0x42, 0x02, 0x18, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x04,
+	0x63, 0x6f, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x42, 0x02, 0x18, 0x01, 0x52, 0x04,
+	0x63, 0x6f, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x65,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x63, 0x6f, 0x73, 0x74, 0x5f, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x63, 0x6f, 0x73, 0x74, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x22, 0x8f, 0x02,
+	0x0a, 0x08, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x08, 0x73, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x08,
+	0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x42, 0x02, 0x18, 0x01, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f,
+	0x63, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x61, 0x76, 0x65, 0x72,
+	0x61, 0x67, 0x65, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x72, 0x6b, 0x5f,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6d, 0x61, 0x72,
+	0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x10, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x6c, 0x69, 0x7a, 0x65,
+	0x64, 0x5f, 0x70, 0x6e, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x75, 0x6e, 0x72,
+	0x65, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x50, 0x6e, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0c, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x22,
+	0xce, 0x01, 0x0a, 0x09, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x12, 0x1a, 0x0a,
+	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x06, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x42, 0x02, 0x18, 0x01, 0x52, 0x06, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x34, 0x0a, 0x0a, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x42, 0x02, 0x18, 0x01, 0x52,
+	0x0a, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x09, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x5f, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73,
+	0x22, 0xa1, 0x03, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
+	0x79, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x44, 0x61, 0x74,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x44, 0x0a, 0x0a, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x6d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x52, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x04,
+	0x73, 0x69, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x6d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x69, 0x64, 0x65, 0x52, 0x04, 0x73, 0x69,
+	0x64, 0x65, 0x22, 0x2f, 0x0a, 0x04, 0x53, 0x69, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x42, 0x55,
+	0x59, 0x53, 0x5f, 0x41, 0x4e, 0x44, 0x5f, 0x53, 0x45, 0x4c, 0x4c, 0x53, 0x10, 0x00, 0x12, 0x08,
+	0x0a, 0x04, 0x42, 0x55, 0x59, 0x53, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x53, 0x45, 0x4c, 0x4c,
+	0x53, 0x10, 0x02, 0x22, 0x2f, 0x0a, 0x09, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x12, 0x10, 0x0a, 0x0c, 0x4e, 0x45, 0x57, 0x45, 0x53, 0x54, 0x5f, 0x46, 0x49, 0x52, 0x53, 0x54,
+	0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x4f, 0x4c, 0x44, 0x45, 0x53, 0x54, 0x5f, 0x46, 0x49, 0x52,
+	0x53, 0x54, 0x10, 0x01, 0x22, 0x62, 0x0a, 0x11, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f,
+	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73,
+	0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x9c, 0x02, 0x0a, 0x0f, 0x53, 0x65, 0x63,
+	0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x0b,
+	0x62, 0x75, 0x79, 0x5f, 0x6f, 0x72, 0x5f, 0x73, 0x65, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x21, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72,
+	0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x42, 0x75, 0x79, 0x4f, 0x72,
+	0x53, 0x65, 0x6c, 0x6c, 0x52, 0x09, 0x62, 0x75, 0x79, 0x4f, 0x72, 0x53, 0x65, 0x6c, 0x6c, 0x12,
+	0x1a, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x42,
+	0x02, 0x18, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x12, 0x27,
+	0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74,
+	0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x22, 0x1e, 0x0a, 0x09, 0x42, 0x75, 0x79, 0x4f, 0x72,
+	0x53, 0x65, 0x6c, 0x6c, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x55, 0x59, 0x10, 0x00, 0x12, 0x08, 0x0a,
+	0x04, 0x53, 0x45, 0x4c, 0x4c, 0x10, 0x01, 0x22, 0x4f, 0x0a, 0x14, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x16, 0x0a, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x42, 0x02, 0x18,
+	0x01, 0x52, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x73, 0x74, 0x5f,
+	0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x6f,
+	0x73, 0x74, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x22, 0x79, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4f,
+	0x70, 0x65, 0x6e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x31, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f,
+	0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x11, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x22, 0x42, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a,
+	0x07, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x07,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x22, 0x15, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x6f,
+	0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x47,
+	0x0a, 0x14, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x09, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f,
+	0x6c, 0x69, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x52, 0x09, 0x70, 0x6f,
+	0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x22, 0xbf, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43,
+	0x61, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x08, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x43, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12,
+	0x1d, 0x0a, 0x0a, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x19,
+	0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x22, 0x86, 0x01, 0x0a, 0x06, 0x43, 0x61,
+	0x6e, 0x64, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6f, 0x70,
+	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6f, 0x70, 0x65, 0x6e, 0x12, 0x12,
+	0x0a, 0x04, 0x68, 0x69, 0x67, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x68, 0x69,
+	0x67, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x6f, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x03, 0x6c, 0x6f, 0x77, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x05, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x22, 0xdc, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6e, 0x64, 0x6c, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0a, 0x73, 0x65, 0x63,
+	0x75, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6e, 0x64, 0x6c, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69,
+	0x74, 0x79, 0x43, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x52, 0x0a, 0x73, 0x65, 0x63, 0x75, 0x72,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x1a, 0x7a, 0x0a, 0x0f, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
+	0x79, 0x43, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x68, 0x6f,
+	0x72, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x68,
+	0x6f, 0x72, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x63, 0x61, 0x6e, 0x64, 0x6c,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x2e, 0x43, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x07, 0x63, 0x61, 0x6e, 0x64, 0x6c, 0x65,
+	0x73, 0x22, 0x33, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x22, 0x49, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x65, 0x63,
+	0x75, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x30, 0x0a, 0x0a, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x53, 0x65, 0x63,
+	0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x22, 0x74, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
+	0x43, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08,
+	0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x22, 0x97, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x53,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x05, 0x63, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f,
+	0x73, 0x74, 0x52, 0x05, 0x63, 0x6f, 0x73, 0x74, 0x73, 0x1a, 0x36, 0x0a, 0x0c, 0x53, 0x65, 0x63,
+	0x75, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x63, 0x6f, 0x73,
+	0x74, 0x22, 0xb3, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x50, 0x72,
+	0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x3c, 0x0a, 0x0e, 0x70, 0x61, 0x69, 0x72, 0x69,
+	0x6e, 0x67, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x15, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67,
+	0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x0d, 0x70, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x53,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x73, 0x69, 0x6d, 0x75,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xa3, 0x02, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x4d,
+	0x6f, 0x64, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x0d, 0x70, 0x72, 0x6f,
+	0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x39, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64,
+	0x65, 0x6c, 0x50, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
+	0x50, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x0d, 0x70, 0x72, 0x6f,
+	0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x1a, 0xa0, 0x01, 0x0a, 0x13, 0x53,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
+	0x79, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x2b, 0x0a, 0x11, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x70, 0x72, 0x6f, 0x62, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x22, 0xfc, 0x01,
+	0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x0b, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x0a, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6c, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0a, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1f, 0x0a,
+	0x0b, 0x75, 0x70, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0a, 0x75, 0x70, 0x70, 0x65, 0x72, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x32,
+	0x0a, 0x15, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x63,
+	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
+	0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x22, 0x26, 0x0a, 0x14,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x23, 0x0a, 0x11, 0x4f, 0x70, 0x65, 0x6e, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x24, 0x0a, 0x12, 0x43, 0x6c, 0x6f,
+	0x73, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x23, 0x0a, 0x11, 0x48, 0x61, 0x6c, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x23, 0x0a, 0x11, 0x56, 0x6f, 0x69, 0x64, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x5b, 0x0a, 0x15, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x70, 0x65, 0x6e, 0x41, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63,
+	0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x6c, 0x6f, 0x73, 0x65, 0x41, 0x74, 0x22, 0x16, 0x0a, 0x14, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25,
+	0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xc6, 0x02, 0x0a, 0x14, 0x41, 0x64, 0x64, 0x53, 0x65, 0x63,
+	0x75, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x45, 0x0a, 0x0a, 0x73,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x41, 0x64, 0x64, 0x53, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x1a, 0xc9, 0x01, 0x0a, 0x08, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x12,
+	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x05, 0x52, 0x09, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c,
+	0x5f, 0x70, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x12, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x62, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x44,
+	0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x49, 0x64, 0x22, 0x85, 0x02, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x51, 0x0a, 0x0b, 0x72, 0x65,
+	0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2f, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x73, 0x1a, 0x49, 0x0a, 0x12, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73,
+	0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x63, 0x75, 0x72,
+	0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x69, 0x6e, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x77, 0x69, 0x6e, 0x73, 0x22, 0x17, 0x0a, 0x15,
+	0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9f, 0x01, 0x0a, 0x07, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e,
+	0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x6f, 0x6e, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4f, 0x6e, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x74, 0x77, 0x6f, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x54, 0x77, 0x6f, 0x12,
+	0x2a, 0x0a, 0x11, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x6f, 0x6e, 0x65, 0x5f, 0x72, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x4f, 0x6e, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x2a, 0x0a, 0x11, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x74, 0x77, 0x6f, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x54, 0x77,
+	0x6f, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x87, 0x01, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x75, 0x70, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x08, 0x70, 0x61, 0x69,
+	0x72, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x70, 0x61,
+	0x69, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f,
+	0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x41,
+	0x74, 0x22, 0x30, 0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68,
+	0x75, 0x70, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03,
+	0x69, 0x64, 0x73, 0x22, 0xe0, 0x01, 0x0a, 0x1c, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x75, 0x70, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x52,
+	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x75, 0x70, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x1a, 0x79, 0x0a, 0x06, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x6f, 0x6e, 0x65,
+	0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x4f, 0x6e, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x28, 0x0a, 0x10,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x74, 0x77, 0x6f, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x54, 0x77,
+	0x6f, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x22, 0xa0, 0x01, 0x0a, 0x0a, 0x47, 0x61, 0x6d, 0x65, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12,
+	0x21, 0x0a, 0x0c, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x63, 0x6f,
+	0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x70, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x5f, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6f, 0x70, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x65, 0x0a, 0x18, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x49, 0x64, 0x12, 0x2c, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x47, 0x61, 0x6d,
+	0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x22, 0x35, 0x0a, 0x19, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x22, 0xb5, 0x01, 0x0a, 0x0b, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x52, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x62, 0x72, 0x69, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x62, 0x72,
+	0x69, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x6f, 0x73, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x6c, 0x6f, 0x67, 0x4c, 0x6f, 0x73, 0x73, 0x12, 0x1f,
+	0x0a, 0x0b, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x64, 0x22,
+	0x35, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x63, 0x6f, 0x72,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x22, 0x46, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2b, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x22, 0x9c,
+	0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x5f, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x65, 0x67, 0x69, 0x6e,
+	0x44, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x86, 0x02,
+	0x0a, 0x10, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x5f,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x70, 0x6f, 0x72,
+	0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x73, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64,
+	0x5f, 0x70, 0x6e, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x72, 0x65, 0x61, 0x6c,
+	0x69, 0x7a, 0x65, 0x64, 0x50, 0x6e, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x75, 0x6e, 0x72, 0x65, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x70, 0x6e, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0d, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x50, 0x6e, 0x6c, 0x12, 0x26,
+	0x0a, 0x0f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x4f,
+	0x6e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x22, 0x62, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x32, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x34, 0x0a, 0x15, 0x47, 0x65,
+	0x74, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x64,
+	0x22, 0x46, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
+	0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0xa4, 0x02, 0x0a, 0x0b, 0x4c, 0x65, 0x64,
+	0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2b, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e,
+	0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4b, 0x69, 0x6e, 0x64, 0x52,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x72, 0x6f,
+	0x6d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x5f, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x12, 0x1b, 0x0a, 0x09,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x5f, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0d, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x22,
+	0x4c, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x5f, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x44, 0x61,
+	0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x22, 0x69, 0x0a,
+	0x11, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x4c, 0x65, 0x64,
+	0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6d, 0x69, 0x63,
+	0x72, 0x6f, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x22, 0x55, 0x0a, 0x12, 0x47, 0x72, 0x61, 0x6e,
+	0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0c, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x22,
+	0xd3, 0x01, 0x0a, 0x12, 0x49, 0x6e, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x56, 0x69, 0x6f,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x09, 0x69, 0x6e, 0x76, 0x61, 0x72, 0x69,
+	0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x2e, 0x49, 0x6e, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x52, 0x09, 0x69, 0x6e,
+	0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x63, 0x75, 0x72,
+	0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61,
+	0x63, 0x74, 0x75, 0x61, 0x6c, 0x22, 0x0e, 0x0a, 0x0c, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4b, 0x0a, 0x0d, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0a, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x2e, 0x49, 0x6e, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x56, 0x69, 0x6f,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2a, 0x40, 0x0a, 0x0a, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x0d, 0x0a, 0x09, 0x45, 0x58, 0x43, 0x4c, 0x55, 0x53, 0x49, 0x56, 0x45, 0x10, 0x00, 0x12,
+	0x0a, 0x0a, 0x06, 0x42, 0x49, 0x4e, 0x41, 0x52, 0x59, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x53,
+	0x43, 0x41, 0x4c, 0x41, 0x52, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x41, 0x4e, 0x4b, 0x49,
+	0x4e, 0x47, 0x10, 0x03, 0x2a, 0x3b, 0x0a, 0x0e, 0x43, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x0e, 0x0a, 0x0a, 0x4f, 0x4e, 0x45, 0x5f, 0x4d, 0x49,
+	0x4e, 0x55, 0x54, 0x45, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x4f, 0x4e, 0x45, 0x5f, 0x48, 0x4f,
+	0x55, 0x52, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x4f, 0x4e, 0x45, 0x5f, 0x44, 0x41, 0x59, 0x10,
+	0x02, 0x2a, 0x41, 0x0a, 0x0d, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x52, 0x4f, 0x42, 0x49,
+	0x4e, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x53, 0x57, 0x49, 0x53, 0x53, 0x10, 0x01, 0x12, 0x14,
+	0x0a, 0x10, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x4f, 0x46, 0x5f, 0x54, 0x48, 0x45, 0x5f, 0x48, 0x49,
+	0x4c, 0x4c, 0x10, 0x02, 0x2a, 0x48, 0x0a, 0x0f, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x11, 0x0a, 0x0d, 0x54, 0x49, 0x4d, 0x45, 0x5f,
+	0x57, 0x45, 0x49, 0x47, 0x48, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x4f, 0x50,
+	0x45, 0x4e, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x46, 0x49, 0x4e, 0x41, 0x4c, 0x5f, 0x44, 0x41,
+	0x59, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x10, 0x03, 0x2a, 0x56,
+	0x0a, 0x0f, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4b, 0x69, 0x6e,
+	0x64, 0x12, 0x09, 0x0a, 0x05, 0x47, 0x52, 0x41, 0x4e, 0x54, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05,
+	0x54, 0x52, 0x41, 0x44, 0x45, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x46, 0x45, 0x45, 0x10, 0x02,
+	0x12, 0x0a, 0x0a, 0x06, 0x50, 0x41, 0x59, 0x4f, 0x55, 0x54, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06,
+	0x52, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x54, 0x52, 0x41, 0x4e,
+	0x53, 0x46, 0x45, 0x52, 0x10, 0x05, 0x2a, 0x60, 0x0a, 0x09, 0x49, 0x6e, 0x76, 0x61, 0x72, 0x69,
+	0x61, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x48, 0x41, 0x52, 0x45, 0x53, 0x5f, 0x4f, 0x55,
+	0x54, 0x53, 0x54, 0x41, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x4c,
+	0x41, 0x53, 0x54, 0x5f, 0x50, 0x52, 0x49, 0x43, 0x45, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x54,
+	0x4f, 0x4b, 0x45, 0x4e, 0x5f, 0x42, 0x41, 0x4c, 0x41, 0x4e, 0x43, 0x45, 0x10, 0x02, 0x12, 0x18,
+	0x0a, 0x14, 0x4e, 0x4f, 0x5f, 0x4e, 0x45, 0x47, 0x41, 0x54, 0x49, 0x56, 0x45, 0x5f, 0x48, 0x4f,
+	0x4c, 0x44, 0x49, 0x4e, 0x47, 0x53, 0x10, 0x03, 0x32, 0x88, 0x08, 0x0a, 0x0d, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x46, 0x0a, 0x0c, 0x47, 0x65,
+	0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x12, 0x1b, 0x2e, 0x6d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x73, 0x12, 0x1d, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x47, 0x65,
+	0x74, 0x4f, 0x70, 0x65, 0x6e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x47, 0x65, 0x74,
+	0x4f, 0x70, 0x65, 0x6e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x42, 0x75, 0x79, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69,
+	0x74, 0x79, 0x12, 0x17, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x53, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0c, 0x53, 0x65, 0x6c,
+	0x6c, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x12, 0x17, 0x2e, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x49, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f,
+	0x12, 0x1b, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x72,
+	0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f,
+	0x6c, 0x69, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x73, 0x74, 0x73, 0x12,
+	0x1f, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x20, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x63,
+	0x75, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1d, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x43, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x12, 0x19,
+	0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6e, 0x64, 0x6c,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65,
+	0x6c, 0x50, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x24,
+	0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
+	0x50, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x47, 0x65,
+	0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0e, 0x47,
+	0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x1d, 0x2e,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12,
+	0x1e, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1f, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4f, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x12, 0x1d, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4c,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1e, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x40, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x12, 0x18,
+	0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x64, 0x67, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x32, 0x95, 0x09, 0x0a, 0x0c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x49, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x12, 0x1b, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1c, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x45, 0x0a, 0x0a, 0x4f, 0x70, 0x65, 0x6e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x19, 0x2e,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0b, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x1a, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x43,
+	0x6c, 0x6f, 0x73, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1c, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x45, 0x0a, 0x0a, 0x48, 0x61, 0x6c, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x19, 0x2e,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x48, 0x61, 0x6c, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x56, 0x6f, 0x69, 0x64, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x12, 0x19, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x56, 0x6f,
+	0x69, 0x64, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1c, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a,
+	0x0e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12,
+	0x1d, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0c,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x1b, 0x2e, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0d, 0x41, 0x64, 0x64, 0x53, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x2e, 0x41, 0x64, 0x64, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1d, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x12, 0x1c, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x52, 0x65,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x61, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68,
+	0x75, 0x70, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x23, 0x2e, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x75, 0x70,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24,
+	0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x75, 0x70, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x75, 0x70, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x24, 0x2e,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x75, 0x70, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x52, 0x65, 0x73,
+	0x6f, 0x6c, 0x76, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x58, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x47, 0x61, 0x6d, 0x65,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x20, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0b,
+	0x47, 0x72, 0x61, 0x6e, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x1a, 0x2e, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x05, 0x41, 0x75, 0x64, 0x69, 0x74, 0x12, 0x14,
+	0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x41, 0x75,
+	0x64, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x2c, 0x5a, 0x2a, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x6f, 0x6d, 0x69, 0x6e, 0x6f,
+	0x31, 0x34, 0x2f, 0x73, 0x63, 0x72, 0x61, 0x62, 0x66, 0x75, 0x74, 0x75, 0x72, 0x65, 0x73, 0x2f,
+	0x72, 0x70, 0x63, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_proto_market_proto_rawDescOnce sync.Once
+	file_proto_market_proto_rawDescData = file_proto_market_proto_rawDesc
+)
+
+func file_proto_market_proto_rawDescGZIP() []byte {
+	file_proto_market_proto_rawDescOnce.Do(func() {
+		file_proto_market_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_market_proto_rawDescData)
+	})
+	return file_proto_market_proto_rawDescData
+}
+
+var file_proto_market_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
+var file_proto_market_proto_msgTypes = make([]protoimpl.MessageInfo, 63)
+var file_proto_market_proto_goTypes = []interface{}{
+	(MarketType)(0),                                           // 0: market.MarketType
+	(CandleInterval)(0),                                       // 1: market.CandleInterval
+	(PairingSystem)(0),                                        // 2: market.PairingSystem
+	(ScoreCheckpoint)(0),                                      // 3: market.ScoreCheckpoint
+	(LedgerEntryKind)(0),                                      // 4: market.LedgerEntryKind
+	(Invariant)(0),                                            // 5: market.Invariant
+	(Market_Status)(0),                                        // 6: market.Market.Status
+	(GetOrderBookRequest_Side)(0),                             // 7: market.GetOrderBookRequest.Side
+	(GetOrderBookRequest_SortOrder)(0),                        // 8: market.GetOrderBookRequest.SortOrder
+	(SecurityRequest_BuyOrSell)(0),                            // 9: market.SecurityRequest.BuyOrSell
+	(*Market)(nil),                                            // 10: market.Market
+	(*Security)(nil),                                          // 11: market.Security
+	(*Order)(nil),                                             // 12: market.Order
+	(*Position)(nil),                                          // 13: market.Position
+	(*Portfolio)(nil),                                         // 14: market.Portfolio
+	(*GetOrderBookRequest)(nil),                               // 15: market.GetOrderBookRequest
+	(*OrderBookResponse)(nil),                                 // 16: market.OrderBookResponse
+	(*SecurityRequest)(nil),                                   // 17: market.SecurityRequest
+	(*MarketActionResponse)(nil),                              // 18: market.MarketActionResponse
+	(*GetOpenMarketsRequest)(nil),                             // 19: market.GetOpenMarketsRequest
+	(*GetOpenMarketsResponse)(nil),                            // 20: market.GetOpenMarketsResponse
+	(*GetPortfolioRequest)(nil),                               // 21: market.GetPortfolioRequest
+	(*GetPortfolioResponse)(nil),                              // 22: market.GetPortfolioResponse
+	(*GetCandlesRequest)(nil),                                 // 23: market.GetCandlesRequest
+	(*Candle)(nil),                                            // 24: market.Candle
+	(*GetCandlesResponse)(nil),                                // 25: market.GetCandlesResponse
+	(*GetSecuritiesRequest)(nil),                              // 26: market.GetSecuritiesRequest
+	(*GetSecuritiesResponse)(nil),                             // 27: market.GetSecuritiesResponse
+	(*GetSecurityCostsRequest)(nil),                           // 28: market.GetSecurityCostsRequest
+	(*GetSecurityCostsResponse)(nil),                          // 29: market.GetSecurityCostsResponse
+	(*GetModelProbabilitiesRequest)(nil),                      // 30: market.GetModelProbabilitiesRequest
+	(*GetModelProbabilitiesResponse)(nil),                     // 31: market.GetModelProbabilitiesResponse
+	(*CreateMarketRequest)(nil),                               // 32: market.CreateMarketRequest
+	(*CreateMarketResponse)(nil),                              // 33: market.CreateMarketResponse
+	(*OpenMarketRequest)(nil),                                 // 34: market.OpenMarketRequest
+	(*CloseMarketRequest)(nil),                                // 35: market.CloseMarketRequest
+	(*HaltMarketRequest)(nil),                                 // 36: market.HaltMarketRequest
+	(*VoidMarketRequest)(nil),                                 // 37: market.VoidMarketRequest
+	(*ScheduleMarketRequest)(nil),                             // 38: market.ScheduleMarketRequest
+	(*AdminServiceResponse)(nil),                              // 39: market.AdminServiceResponse
+	(*DeleteMarketRequest)(nil),                               // 40: market.DeleteMarketRequest
+	(*AddSecuritiesRequest)(nil),                              // 41: market.AddSecuritiesRequest
+	(*DeleteSecurityRequest)(nil),                             // 42: market.DeleteSecurityRequest
+	(*ResolveMarketRequest)(nil),                              // 43: market.ResolveMarketRequest
+	(*ResolveMarketResponse)(nil),                             // 44: market.ResolveMarketResponse
+	(*Pairing)(nil),                                           // 45: market.Pairing
+	(*CreateMatchupMarketsRequest)(nil),                       // 46: market.CreateMatchupMarketsRequest
+	(*CreateMatchupMarketsResponse)(nil),                      // 47: market.CreateMatchupMarketsResponse
+	(*ResolveMatchupMarketsRequest)(nil),                      // 48: market.ResolveMatchupMarketsRequest
+	(*GameResult)(nil),                                        // 49: market.GameResult
+	(*SubmitGameResultsRequest)(nil),                          // 50: market.SubmitGameResultsRequest
+	(*SubmitGameResultsResponse)(nil),                         // 51: market.SubmitGameResultsResponse
+	(*MarketScore)(nil),                                       // 52: market.MarketScore
+	(*GetMarketScoresRequest)(nil),                            // 53: market.GetMarketScoresRequest
+	(*GetMarketScoresResponse)(nil),                           // 54: market.GetMarketScoresResponse
+	(*GetLeaderboardRequest)(nil),                             // 55: market.GetLeaderboardRequest
+	(*LeaderboardEntry)(nil),                                  // 56: market.LeaderboardEntry
+	(*GetLeaderboardResponse)(nil),                            // 57: market.GetLeaderboardResponse
+	(*GetGameResultsRequest)(nil),                             // 58: market.GetGameResultsRequest
+	(*GetGameResultsResponse)(nil),                            // 59: market.GetGameResultsResponse
+	(*LedgerEntry)(nil),                                       // 60: market.LedgerEntry
+	(*GetLedgerRequest)(nil),                                  // 61: market.GetLedgerRequest
+	(*GetLedgerResponse)(nil),                                 // 62: market.GetLedgerResponse
+	(*GrantTokensRequest)(nil),                                // 63: market.GrantTokensRequest
+	(*InvariantViolation)(nil),                                // 64: market.InvariantViolation
+	(*AuditRequest)(nil),                                      // 65: market.AuditRequest
+	(*AuditResponse)(nil),                                     // 66: market.AuditResponse
+	(*GetCandlesResponse_SecurityCandles)(nil),                // 67: market.GetCandlesResponse.SecurityCandles
+	(*GetSecurityCostsResponse_SecurityCost)(nil),             // 68: market.GetSecurityCostsResponse.SecurityCost
+	(*GetModelProbabilitiesResponse_SecurityProbability)(nil), // 69: market.GetModelProbabilitiesResponse.SecurityProbability
+	(*AddSecuritiesRequest_Security)(nil),                     // 70: market.AddSecuritiesRequest.Security
+	(*ResolveMarketRequest_SecurityResolution)(nil),           // 71: market.ResolveMarketRequest.SecurityResolution
+	(*ResolveMatchupMarketsRequest_Result)(nil),               // 72: market.ResolveMatchupMarketsRequest.Result
+}
+var file_proto_market_proto_depIdxs = []int32{
+	0,  // 0: market.Market.market_type:type_name -> market.MarketType
+	11, // 1: market.Market.securities:type_name -> market.Security
+	6,  // 2: market.Market.status:type_name -> market.Market.Status
+	11, // 3: market.Position.security:type_name -> market.Security
+	11, // 4: market.Portfolio.securities:type_name -> market.Security
+	13, // 5: market.Portfolio.positions:type_name -> market.Position
+	8,  // 6: market.GetOrderBookRequest.sort_order:type_name -> market.GetOrderBookRequest.SortOrder
+	7,  // 7: market.GetOrderBookRequest.side:type_name -> market.GetOrderBookRequest.Side
+	12, // 8: market.OrderBookResponse.orders:type_name -> market.Order
+	9,  // 9: market.SecurityRequest.buy_or_sell:type_name -> market.SecurityRequest.BuyOrSell
+	6,  // 10: market.GetOpenMarketsRequest.statuses:type_name -> market.Market.Status
+	10, // 11: market.GetOpenMarketsResponse.markets:type_name -> market.Market
+	14, // 12: market.GetPortfolioResponse.portfolio:type_name -> market.Portfolio
+	1,  // 13: market.GetCandlesRequest.interval:type_name -> market.CandleInterval
+	67, // 14: market.GetCandlesResponse.securities:type_name -> market.GetCandlesResponse.SecurityCandles
+	11, // 15: market.GetSecuritiesResponse.securities:type_name -> market.Security
+	68, // 16: market.GetSecurityCostsResponse.costs:type_name -> market.GetSecurityCostsResponse.SecurityCost
+	2,  // 17: market.GetModelProbabilitiesRequest.pairing_system:type_name -> market.PairingSystem
+	69, // 18: market.GetModelProbabilitiesResponse.probabilities:type_name -> market.GetModelProbabilitiesResponse.SecurityProbability
+	0,  // 19: market.CreateMarketRequest.market_type:type_name -> market.MarketType
+	70, // 20: market.AddSecuritiesRequest.securities:type_name -> market.AddSecuritiesRequest.Security
+	71, // 21: market.ResolveMarketRequest.resolutions:type_name -> market.ResolveMarketRequest.SecurityResolution
+	45, // 22: market.CreateMatchupMarketsRequest.pairings:type_name -> market.Pairing
+	72, // 23: market.ResolveMatchupMarketsRequest.results:type_name -> market.ResolveMatchupMarketsRequest.Result
+	49, // 24: market.SubmitGameResultsRequest.results:type_name -> market.GameResult
+	3,  // 25: market.MarketScore.checkpoint:type_name -> market.ScoreCheckpoint
+	52, // 26: market.GetMarketScoresResponse.scores:type_name -> market.MarketScore
+	56, // 27: market.GetLeaderboardResponse.entries:type_name -> market.LeaderboardEntry
+	49, // 28: market.GetGameResultsResponse.results:type_name -> market.GameResult
+	4,  // 29: market.LedgerEntry.kind:type_name -> market.LedgerEntryKind
+	60, // 30: market.GetLedgerResponse.entries:type_name -> market.LedgerEntry
+	5,  // 31: market.InvariantViolation.invariant:type_name -> market.Invariant
+	64, // 32: market.AuditResponse.violations:type_name -> market.InvariantViolation
+	24, // 33: market.GetCandlesResponse.SecurityCandles.candles:type_name -> market.Candle
+	15, // 34: market.MarketService.GetOrderBook:input_type -> market.GetOrderBookRequest
+	19, // 35: market.MarketService.GetOpenMarkets:input_type -> market.GetOpenMarketsRequest
+	17, // 36: market.MarketService.BuySecurity:input_type -> market.SecurityRequest
+	17, // 37: market.MarketService.SellSecurity:input_type -> market.SecurityRequest
+	21, // 38: market.MarketService.GetPortfolio:input_type -> market.GetPortfolioRequest
+	28, // 39: market.MarketService.GetSecurityCosts:input_type -> market.GetSecurityCostsRequest
+	26, // 40: market.MarketService.GetSecurities:input_type -> market.GetSecuritiesRequest
+	23, // 41: market.MarketService.GetCandles:input_type -> market.GetCandlesRequest
+	30, // 42: market.MarketService.GetModelProbabilities:input_type -> market.GetModelProbabilitiesRequest
+	58, // 43: market.MarketService.GetGameResults:input_type -> market.GetGameResultsRequest
+	53, // 44: market.MarketService.GetMarketScores:input_type -> market.GetMarketScoresRequest
+	55, // 45: market.MarketService.GetLeaderboard:input_type -> market.GetLeaderboardRequest
+	61, // 46: market.MarketService.GetLedger:input_type -> market.GetLedgerRequest
+	32, // 47: market.AdminService.CreateMarket:input_type -> market.CreateMarketRequest
+	34, // 48: market.AdminService.OpenMarket:input_type -> market.OpenMarketRequest
+	35, // 49: market.AdminService.CloseMarket:input_type -> market.CloseMarketRequest
+	36, // 50: market.AdminService.HaltMarket:input_type -> market.HaltMarketRequest
+	37, // 51: market.AdminService.VoidMarket:input_type -> market.VoidMarketRequest
+	38, // 52: market.AdminService.ScheduleMarket:input_type -> market.ScheduleMarketRequest
+	40, // 53: market.AdminService.DeleteMarket:input_type -> market.DeleteMarketRequest
+	41, // 54: market.AdminService.AddSecurities:input_type -> market.AddSecuritiesRequest
+	42, // 55: market.AdminService.DeleteSecurity:input_type -> market.DeleteSecurityRequest
+	43, // 56: market.AdminService.ResolveMarket:input_type -> market.ResolveMarketRequest
+	46, // 57: market.AdminService.CreateMatchupMarkets:input_type -> market.CreateMatchupMarketsRequest
+	48, // 58: market.AdminService.ResolveMatchupMarkets:input_type -> market.ResolveMatchupMarketsRequest
+	50, // 59: market.AdminService.SubmitGameResults:input_type -> market.SubmitGameResultsRequest
+	63, // 60: market.AdminService.GrantTokens:input_type -> market.GrantTokensRequest
+	65, // 61: market.AdminService.Audit:input_type -> market.AuditRequest
+	16, // 62: market.MarketService.GetOrderBook:output_type -> market.OrderBookResponse
+	20, // 63: market.MarketService.GetOpenMarkets:output_type -> market.GetOpenMarketsResponse
+	18, // 64: market.MarketService.BuySecurity:output_type -> market.MarketActionResponse
+	18, // 65: market.MarketService.SellSecurity:output_type -> market.MarketActionResponse
+	22, // 66: market.MarketService.GetPortfolio:output_type -> market.GetPortfolioResponse
+	29, // 67: market.MarketService.GetSecurityCosts:output_type -> market.GetSecurityCostsResponse
+	27, // 68: market.MarketService.GetSecurities:output_type -> market.GetSecuritiesResponse
+	25, // 69: market.MarketService.GetCandles:output_type -> market.GetCandlesResponse
+	31, // 70: market.MarketService.GetModelProbabilities:output_type -> market.GetModelProbabilitiesResponse
+	59, // 71: market.MarketService.GetGameResults:output_type -> market.GetGameResultsResponse
+	54, // 72: market.MarketService.GetMarketScores:output_type -> market.GetMarketScoresResponse
+	57, // 73: market.MarketService.GetLeaderboard:output_type -> market.GetLeaderboardResponse
+	62, // 74: market.MarketService.GetLedger:output_type -> market.GetLedgerResponse
+	33, // 75: market.AdminService.CreateMarket:output_type -> market.CreateMarketResponse
+	39, // 76: market.AdminService.OpenMarket:output_type -> market.AdminServiceResponse
+	39, // 77: market.AdminService.CloseMarket:output_type -> market.AdminServiceResponse
+	39, // 78: market.AdminService.HaltMarket:output_type -> market.AdminServiceResponse
+	39, // 79: market.AdminService.VoidMarket:output_type -> market.AdminServiceResponse
+	39, // 80: market.AdminService.ScheduleMarket:output_type -> market.AdminServiceResponse
+	39, // 81: market.AdminService.DeleteMarket:output_type -> market.AdminServiceResponse
+	39, // 82: market.AdminService.AddSecurities:output_type -> market.AdminServiceResponse
+	39, // 83: market.AdminService.DeleteSecurity:output_type -> market.AdminServiceResponse
+	44, // 84: market.AdminService.ResolveMarket:output_type -> market.ResolveMarketResponse
+	47, // 85: market.AdminService.CreateMatchupMarkets:output_type -> market.CreateMatchupMarketsResponse
+	44, // 86: market.AdminService.ResolveMatchupMarkets:output_type -> market.ResolveMarketResponse
+	51, // 87: market.AdminService.SubmitGameResults:output_type -> market.SubmitGameResultsResponse
+	39, // 88: market.AdminService.GrantTokens:output_type -> market.AdminServiceResponse
+	66, // 89: market.AdminService.Audit:output_type -> market.AuditResponse
+	62, // [62:90] is the sub-list for method output_type
+	34, // [34:62] is the sub-list for method input_type
+	34, // [34:34] is the sub-list for extension type_name
+	34, // [34:34] is the sub-list for extension extendee
+	0,  // [0:34] is the sub-list for field type_name
+}
+
+func init() { file_proto_market_proto_init() }
+func file_proto_market_proto_init() {
+	if File_proto_market_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_market_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Market); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_market_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Security); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_market_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Order); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_market_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Position); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_market_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Portfolio); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_market_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOrderBookRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_market_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrderBookResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_market_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecurityRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_market_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketActionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_market_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOpenMarketsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_market_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOpenMarketsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_market_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPortfolioRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_market_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPortfolioResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_market_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCandlesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_market_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Candle); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_market_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCandlesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_market_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSecuritiesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_market_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSecuritiesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_market_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSecurityCostsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_market_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSecurityCostsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_market_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetModelProbabilitiesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_market_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetModelProbabilitiesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_market_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateMarketRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_market_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateMarketResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_market_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenMarketRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_market_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloseMarketRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_market_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HaltMarketRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_market_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VoidMarketRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_market_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduleMarketRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_market_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminServiceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_market_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteMarketRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ResolveMarketRequest_SecurityResolution.ProtoReflect.Descriptor instead.
-func (*ResolveMarketRequest_SecurityResolution) Descriptor() ([]byte, []int) {
-	return file_proto_market_proto_rawDescGZIP(), []int{21, 0}
-}
-
-func (x *ResolveMarketRequest_SecurityResolution) GetSecurityId() string {
-	if x != nil {
-		return x.SecurityId
-	}
-	return ""
-}
-
-func (x *ResolveMarketRequest_SecurityResolution) GetWins() bool {
-	if x != nil {
-		return x.Wins
-	}
-	return false
-}
-
-var File_proto_market_proto protoreflect.FileDescriptor
-
-var file_proto_market_proto_rawDesc = []byte{
-	0x0a, 0x12, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x22, 0x97, 0x01, 0x0a,
-	0x06, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x61, 0x74,
-	0x65, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x64, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
-	0x64, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x12, 0x17, 0x0a,
-	0x07, 0x69, 0x73, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
-	0x69, 0x73, 0x4f, 0x70, 0x65, 0x6e, 0x22, 0xe8, 0x01, 0x0a, 0x08, 0x53, 0x65, 0x63, 0x75, 0x72,
-	0x69, 0x74, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x65, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x61, 0x72, 0x6b, 0x65,
-	0x74, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x5f, 0x6f, 0x75,
-	0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x11, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69,
-	0x6e, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63,
-	0x65, 0x22, 0xd2, 0x01, 0x0a, 0x05, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75,
-	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75,
-	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x63, 0x75, 0x72,
-	0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65,
-	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x73, 0x65, 0x63, 0x75,
-	0x72, 0x69, 0x74, 0x79, 0x5f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x53, 0x68,
-	0x6f, 0x72, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x12, 0x0a, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x63,
-	0x6f, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x65, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x22, 0x71, 0x0a, 0x09, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f,
-	0x6c, 0x69, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x16, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x30, 0x0a, 0x0a, 0x73, 0x65, 0x63, 0x75, 0x72,
-	0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x61,
-	0x72, 0x6b, 0x65, 0x74, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x73,
-	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0xa4, 0x01, 0x0a, 0x13, 0x47, 0x65,
-	0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1f,
-	0x0a, 0x0b, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12,
-	0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
-	0x69, 0x6e, 0x63, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69,
-	0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
-	0x22, 0x3a, 0x0a, 0x11, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x4f,
-	0x72, 0x64, 0x65, 0x72, 0x52, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x22, 0xca, 0x01, 0x0a,
-	0x0f, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x41, 0x0a, 0x0b, 0x62, 0x75, 0x79, 0x5f, 0x6f, 0x72, 0x5f, 0x73, 0x65, 0x6c, 0x6c, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x53,
-	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x42,
-	0x75, 0x79, 0x4f, 0x72, 0x53, 0x65, 0x6c, 0x6c, 0x52, 0x09, 0x62, 0x75, 0x79, 0x4f, 0x72, 0x53,
-	0x65, 0x6c, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
-	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09,
-	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x22, 0x1e, 0x0a, 0x09, 0x42, 0x75, 0x79,
-	0x4f, 0x72, 0x53, 0x65, 0x6c, 0x6c, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x55, 0x59, 0x10, 0x00, 0x12,
-	0x08, 0x0a, 0x04, 0x53, 0x45, 0x4c, 0x4c, 0x10, 0x01, 0x22, 0x2a, 0x0a, 0x14, 0x4d, 0x61, 0x72,
-	0x6b, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x04, 0x63, 0x6f, 0x73, 0x74, 0x22, 0x17, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x6e,
-	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x42,
-	0x0a, 0x16, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x6d, 0x61, 0x72, 0x6b,
-	0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6d, 0x61, 0x72, 0x6b,
-	0x65, 0x74, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x07, 0x6d, 0x61, 0x72, 0x6b, 0x65,
-	0x74, 0x73, 0x22, 0x15, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c,
-	0x69, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x47, 0x0a, 0x14, 0x47, 0x65, 0x74,
-	0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x2f, 0x0a, 0x09, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x50, 0x6f,
-	0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x52, 0x09, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c,
-	0x69, 0x6f, 0x22, 0x74, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
-	0x79, 0x43, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
-	0x0b, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x1d,
-	0x0a, 0x0a, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a,
-	0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x22, 0x97, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74,
-	0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x05, 0x63, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x47, 0x65,
-	0x74, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x43,
-	0x6f, 0x73, 0x74, 0x52, 0x05, 0x63, 0x6f, 0x73, 0x74, 0x73, 0x1a, 0x36, 0x0a, 0x0c, 0x53, 0x65,
-	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
-	0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x63, 0x6f,
-	0x73, 0x74, 0x22, 0x37, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b,
-	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x26, 0x0a, 0x14, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x22, 0x23, 0x0a, 0x11, 0x4f, 0x70, 0x65, 0x6e, 0x4d, 0x61, 0x72, 0x6b, 0x65,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x16, 0x0a, 0x14, 0x41, 0x64, 0x6d, 0x69,
-	0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x25, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xc6, 0x01, 0x0a, 0x14, 0x41, 0x64, 0x64, 0x53,
-	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x45, 0x0a,
-	0x0a, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x25, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x41, 0x64, 0x64, 0x53, 0x65,
-	0x63, 0x75, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
-	0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69,
-	0x74, 0x69, 0x65, 0x73, 0x1a, 0x4a, 0x0a, 0x08, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
-	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x6e, 0x61, 0x6d, 0x65,
-	0x22, 0x44, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69,
-	0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x72,
-	0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x61,
-	0x72, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x22, 0xd1, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x6f, 0x6c,
-	0x76, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x51, 0x0a, 0x0b,
-	0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x2f, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c,
-	0x76, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
-	0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a,
-	0x49, 0x0a, 0x12, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x6c,
-	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
-	0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x63, 0x75,
-	0x72, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x69, 0x6e, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x77, 0x69, 0x6e, 0x73, 0x22, 0x17, 0x0a, 0x15, 0x52, 0x65,
-	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x32, 0xd7, 0x03, 0x0a, 0x0d, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x46, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x12, 0x1b, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x47,
-	0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a,
-	0x0e, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x12,
-	0x1d, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x6e,
-	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
-	0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x4d,
-	0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44,
-	0x0a, 0x0b, 0x42, 0x75, 0x79, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x12, 0x17, 0x2e,
-	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e,
-	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0c, 0x53, 0x65, 0x6c, 0x6c, 0x53, 0x65, 0x63, 0x75,
-	0x72, 0x69, 0x74, 0x79, 0x12, 0x17, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x53, 0x65,
-	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
-	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x41, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0c, 0x47,
-	0x65, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x12, 0x1b, 0x2e, 0x6d, 0x61,
-	0x72, 0x6b, 0x65, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69,
-	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65,
-	0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x65, 0x63,
-	0x75, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x1f, 0x2e, 0x6d, 0x61, 0x72,
-	0x6b, 0x65, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x43,
-	0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6d, 0x61,
-	0x72, 0x6b, 0x65, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
-	0x43, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xd5, 0x03,
-	0x0a, 0x0c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x49,
-	0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x1b,
-	0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x61,
-	0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6d, 0x61,
-	0x72, 0x6b, 0x65, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x4f, 0x70, 0x65,
-	0x6e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x19, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
-	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x41, 0x64, 0x6d, 0x69,
-	0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x49, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
-	0x12, 0x1b, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
-	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0d, 0x41,
-	0x64, 0x64, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x6d,
-	0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x41, 0x64, 0x64, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
-	0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6d, 0x61, 0x72,
-	0x6b, 0x65, 0x74, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1d, 0x2e, 0x6d, 0x61, 0x72,
-	0x6b, 0x65, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69,
-	0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6d, 0x61, 0x72, 0x6b,
-	0x65, 0x74, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x6f, 0x6c,
-	0x76, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x1c, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65,
-	0x74, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e,
-	0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x2c, 0x5a, 0x2a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x6f, 0x6d, 0x69, 0x6e, 0x6f, 0x31, 0x34, 0x2f, 0x73, 0x63, 0x72,
-	0x61, 0x62, 0x66, 0x75, 0x74, 0x75, 0x72, 0x65, 0x73, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_proto_market_proto_rawDescOnce sync.Once
-	file_proto_market_proto_rawDescData = file_proto_market_proto_rawDesc
-)
-
-func file_proto_market_proto_rawDescGZIP() []byte {
-	file_proto_market_proto_rawDescOnce.Do(func() {
-		file_proto_market_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_market_proto_rawDescData)
-	})
-	return file_proto_market_proto_rawDescData
-}
-
-var file_proto_market_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_proto_market_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
-var file_proto_market_proto_goTypes = []interface{}{
-	(SecurityRequest_BuyOrSell)(0),                  // 0: market.SecurityRequest.BuyOrSell
-	(*Market)(nil),                                  // 1: market.Market
-	(*Security)(nil),                                // 2: market.Security
-	(*Order)(nil),                                   // 3: market.Order
-	(*Portfolio)(nil),                               // 4: market.Portfolio
-	(*GetOrderBookRequest)(nil),                     // 5: market.GetOrderBookRequest
-	(*OrderBookResponse)(nil),                       // 6: market.OrderBookResponse
-	(*SecurityRequest)(nil),                         // 7: market.SecurityRequest
-	(*MarketActionResponse)(nil),                    // 8: market.MarketActionResponse
-	(*GetOpenMarketsRequest)(nil),                   // 9: market.GetOpenMarketsRequest
-	(*GetOpenMarketsResponse)(nil),                  // 10: market.GetOpenMarketsResponse
-	(*GetPortfolioRequest)(nil),                     // 11: market.GetPortfolioRequest
-	(*GetPortfolioResponse)(nil),                    // 12: market.GetPortfolioResponse
-	(*GetSecurityCostsRequest)(nil),                 // 13: market.GetSecurityCostsRequest
-	(*GetSecurityCostsResponse)(nil),                // 14: market.GetSecurityCostsResponse
-	(*CreateMarketRequest)(nil),                     // 15: market.CreateMarketRequest
-	(*CreateMarketResponse)(nil),                    // 16: market.CreateMarketResponse
-	(*OpenMarketRequest)(nil),                       // 17: market.OpenMarketRequest
-	(*AdminServiceResponse)(nil),                    // 18: market.AdminServiceResponse
-	(*DeleteMarketRequest)(nil),                     // 19: market.DeleteMarketRequest
-	(*AddSecuritiesRequest)(nil),                    // 20: market.AddSecuritiesRequest
-	(*DeleteSecurityRequest)(nil),                   // 21: market.DeleteSecurityRequest
-	(*ResolveMarketRequest)(nil),                    // 22: market.ResolveMarketRequest
-	(*ResolveMarketResponse)(nil),                   // 23: market.ResolveMarketResponse
-	(*GetSecurityCostsResponse_SecurityCost)(nil),   // 24: market.GetSecurityCostsResponse.SecurityCost
-	(*AddSecuritiesRequest_Security)(nil),           // 25: market.AddSecuritiesRequest.Security
-	(*ResolveMarketRequest_SecurityResolution)(nil), // 26: market.ResolveMarketRequest.SecurityResolution
-}
-var file_proto_market_proto_depIdxs = []int32{
-	2,  // 0: market.Portfolio.securities:type_name -> market.Security
-	3,  // 1: market.OrderBookResponse.orders:type_name -> market.Order
-	0,  // 2: market.SecurityRequest.buy_or_sell:type_name -> market.SecurityRequest.BuyOrSell
-	1,  // 3: market.GetOpenMarketsResponse.markets:type_name -> market.Market
-	4,  // 4: market.GetPortfolioResponse.portfolio:type_name -> market.Portfolio
-	24, // 5: market.GetSecurityCostsResponse.costs:type_name -> market.GetSecurityCostsResponse.SecurityCost
-	25, // 6: market.AddSecuritiesRequest.securities:type_name -> market.AddSecuritiesRequest.Security
-	26, // 7: market.ResolveMarketRequest.resolutions:type_name -> market.ResolveMarketRequest.SecurityResolution
-	5,  // 8: market.MarketService.GetOrderBook:input_type -> market.GetOrderBookRequest
-	9,  // 9: market.MarketService.GetOpenMarkets:input_type -> market.GetOpenMarketsRequest
-	7,  // 10: market.MarketService.BuySecurity:input_type -> market.SecurityRequest
-	7,  // 11: market.MarketService.SellSecurity:input_type -> market.SecurityRequest
-	11, // 12: market.MarketService.GetPortfolio:input_type -> market.GetPortfolioRequest
-	13, // 13: market.MarketService.GetSecurityCosts:input_type -> market.GetSecurityCostsRequest
-	15, // 14: market.AdminService.CreateMarket:input_type -> market.CreateMarketRequest
-	17, // 15: market.AdminService.OpenMarket:input_type -> market.OpenMarketRequest
-	19, // 16: market.AdminService.DeleteMarket:input_type -> market.DeleteMarketRequest
-	20, // 17: market.AdminService.AddSecurities:input_type -> market.AddSecuritiesRequest
-	21, // 18: market.AdminService.DeleteSecurity:input_type -> market.DeleteSecurityRequest
-	22, // 19: market.AdminService.ResolveMarket:input_type -> market.ResolveMarketRequest
-	6,  // 20: market.MarketService.GetOrderBook:output_type -> market.OrderBookResponse
-	10, // 21: market.MarketService.GetOpenMarkets:output_type -> market.GetOpenMarketsResponse
-	8,  // 22: market.MarketService.BuySecurity:output_type -> market.MarketActionResponse
-	8,  // 23: market.MarketService.SellSecurity:output_type -> market.MarketActionResponse
-	12, // 24: market.MarketService.GetPortfolio:output_type -> market.GetPortfolioResponse
-	14, // 25: market.MarketService.GetSecurityCosts:output_type -> market.GetSecurityCostsResponse
-	16, // 26: market.AdminService.CreateMarket:output_type -> market.CreateMarketResponse
-	18, // 27: market.AdminService.OpenMarket:output_type -> market.AdminServiceResponse
-	18, // 28: market.AdminService.DeleteMarket:output_type -> market.AdminServiceResponse
-	18, // 29: market.AdminService.AddSecurities:output_type -> market.AdminServiceResponse
-	18, // 30: market.AdminService.DeleteSecurity:output_type -> market.AdminServiceResponse
-	23, // 31: market.AdminService.ResolveMarket:output_type -> market.ResolveMarketResponse
-	20, // [20:32] is the sub-list for method output_type
-	8,  // [8:20] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
-}
-
-func init() { file_proto_market_proto_init() }
-func file_proto_market_proto_init() {
-	if File_proto_market_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_proto_market_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Market); i {
+		file_proto_market_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddSecuritiesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1834,8 +6037,8 @@ func file_proto_market_proto_init() {
 				return nil
 			}
 		}
-		file_proto_market_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Security); i {
+		file_proto_market_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteSecurityRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1846,8 +6049,8 @@ func file_proto_market_proto_init() {
 				return nil
 			}
 		}
-		file_proto_market_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Order); i {
+		file_proto_market_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolveMarketRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1858,8 +6061,8 @@ func file_proto_market_proto_init() {
 				return nil
 			}
 		}
-		file_proto_market_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Portfolio); i {
+		file_proto_market_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolveMarketResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1870,8 +6073,8 @@ func file_proto_market_proto_init() {
 				return nil
 			}
 		}
-		file_proto_market_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetOrderBookRequest); i {
+		file_proto_market_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pairing); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1882,8 +6085,8 @@ func file_proto_market_proto_init() {
 				return nil
 			}
 		}
-		file_proto_market_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OrderBookResponse); i {
+		file_proto_market_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateMatchupMarketsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1894,8 +6097,8 @@ func file_proto_market_proto_init() {
 				return nil
 			}
 		}
-		file_proto_market_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SecurityRequest); i {
+		file_proto_market_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateMatchupMarketsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1906,8 +6109,8 @@ func file_proto_market_proto_init() {
 				return nil
 			}
 		}
-		file_proto_market_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MarketActionResponse); i {
+		file_proto_market_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolveMatchupMarketsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1918,8 +6121,8 @@ func file_proto_market_proto_init() {
 				return nil
 			}
 		}
-		file_proto_market_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetOpenMarketsRequest); i {
+		file_proto_market_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1930,8 +6133,8 @@ func file_proto_market_proto_init() {
 				return nil
 			}
 		}
-		file_proto_market_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetOpenMarketsResponse); i {
+		file_proto_market_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitGameResultsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1942,8 +6145,8 @@ func file_proto_market_proto_init() {
 				return nil
 			}
 		}
-		file_proto_market_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPortfolioRequest); i {
+		file_proto_market_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitGameResultsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1954,8 +6157,8 @@ func file_proto_market_proto_init() {
 				return nil
 			}
 		}
-		file_proto_market_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPortfolioResponse); i {
+		file_proto_market_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketScore); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1966,8 +6169,8 @@ func file_proto_market_proto_init() {
 				return nil
 			}
 		}
-		file_proto_market_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSecurityCostsRequest); i {
+		file_proto_market_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMarketScoresRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1978,8 +6181,8 @@ func file_proto_market_proto_init() {
 				return nil
 			}
 		}
-		file_proto_market_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSecurityCostsResponse); i {
+		file_proto_market_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMarketScoresResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1990,8 +6193,8 @@ func file_proto_market_proto_init() {
 				return nil
 			}
 		}
-		file_proto_market_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateMarketRequest); i {
+		file_proto_market_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLeaderboardRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2002,8 +6205,8 @@ func file_proto_market_proto_init() {
 				return nil
 			}
 		}
-		file_proto_market_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateMarketResponse); i {
+		file_proto_market_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaderboardEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2014,8 +6217,8 @@ func file_proto_market_proto_init() {
 				return nil
 			}
 		}
-		file_proto_market_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OpenMarketRequest); i {
+		file_proto_market_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLeaderboardResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2026,8 +6229,8 @@ func file_proto_market_proto_init() {
 				return nil
 			}
 		}
-		file_proto_market_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminServiceResponse); i {
+		file_proto_market_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGameResultsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2038,8 +6241,8 @@ func file_proto_market_proto_init() {
 				return nil
 			}
 		}
-		file_proto_market_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteMarketRequest); i {
+		file_proto_market_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGameResultsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2050,8 +6253,8 @@ func file_proto_market_proto_init() {
 				return nil
 			}
 		}
-		file_proto_market_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddSecuritiesRequest); i {
+		file_proto_market_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LedgerEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2062,8 +6265,8 @@ func file_proto_market_proto_init() {
 				return nil
 			}
 		}
-		file_proto_market_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteSecurityRequest); i {
+		file_proto_market_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLedgerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2074,8 +6277,8 @@ func file_proto_market_proto_init() {
 				return nil
 			}
 		}
-		file_proto_market_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResolveMarketRequest); i {
+		file_proto_market_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLedgerResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2086,8 +6289,8 @@ func file_proto_market_proto_init() {
 				return nil
 			}
 		}
-		file_proto_market_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResolveMarketResponse); i {
+		file_proto_market_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GrantTokensRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2098,7 +6301,55 @@ func file_proto_market_proto_init() {
 				return nil
 			}
 		}
-		file_proto_market_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_market_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InvariantViolation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_market_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_market_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_market_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCandlesResponse_SecurityCandles); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_market_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetSecurityCostsResponse_SecurityCost); i {
 			case 0:
 				return &v.state
@@ -2110,7 +6361,19 @@ func file_proto_market_proto_init() {
 				return nil
 			}
 		}
-		file_proto_market_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_market_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetModelProbabilitiesResponse_SecurityProbability); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_market_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AddSecuritiesRequest_Security); i {
 			case 0:
 				return &v.state
@@ -2122,7 +6385,7 @@ func file_proto_market_proto_init() {
 				return nil
 			}
 		}
-		file_proto_market_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_market_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ResolveMarketRequest_SecurityResolution); i {
 			case 0:
 				return &v.state
@@ -2134,14 +6397,26 @@ func file_proto_market_proto_init() {
 				return nil
 			}
 		}
+		file_proto_market_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolveMatchupMarketsRequest_Result); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_market_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   26,
+			NumEnums:      10,
+			NumMessages:   63,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
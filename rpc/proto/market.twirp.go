@@ -44,6 +44,20 @@ type MarketService interface {
 	GetPortfolio(context.Context, *GetPortfolioRequest) (*GetPortfolioResponse, error)
 
 	GetSecurityCosts(context.Context, *GetSecurityCostsRequest) (*GetSecurityCostsResponse, error)
+
+	GetSecurities(context.Context, *GetSecuritiesRequest) (*GetSecuritiesResponse, error)
+
+	GetCandles(context.Context, *GetCandlesRequest) (*GetCandlesResponse, error)
+
+	GetModelProbabilities(context.Context, *GetModelProbabilitiesRequest) (*GetModelProbabilitiesResponse, error)
+
+	GetGameResults(context.Context, *GetGameResultsRequest) (*GetGameResultsResponse, error)
+
+	GetMarketScores(context.Context, *GetMarketScoresRequest) (*GetMarketScoresResponse, error)
+
+	GetLeaderboard(context.Context, *GetLeaderboardRequest) (*GetLeaderboardResponse, error)
+
+	GetLedger(context.Context, *GetLedgerRequest) (*GetLedgerResponse, error)
 }
 
 // =============================
@@ -52,7 +66,7 @@ type MarketService interface {
 
 type marketServiceProtobufClient struct {
 	client      HTTPClient
-	urls        [6]string
+	urls        [13]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
@@ -80,13 +94,20 @@ func NewMarketServiceProtobufClient(baseURL string, client HTTPClient, opts ...t
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
 	serviceURL += baseServicePath(pathPrefix, "market", "MarketService")
-	urls := [6]string{
+	urls := [13]string{
 		serviceURL + "GetOrderBook",
 		serviceURL + "GetOpenMarkets",
 		serviceURL + "BuySecurity",
 		serviceURL + "SellSecurity",
 		serviceURL + "GetPortfolio",
 		serviceURL + "GetSecurityCosts",
+		serviceURL + "GetSecurities",
+		serviceURL + "GetCandles",
+		serviceURL + "GetModelProbabilities",
+		serviceURL + "GetGameResults",
+		serviceURL + "GetMarketScores",
+		serviceURL + "GetLeaderboard",
+		serviceURL + "GetLedger",
 	}
 
 	return &marketServiceProtobufClient{
@@ -373,13 +394,335 @@ func (c *marketServiceProtobufClient) callGetSecurityCosts(ctx context.Context,
 	return out, nil
 }
 
+func (c *marketServiceProtobufClient) GetSecurities(ctx context.Context, in *GetSecuritiesRequest) (*GetSecuritiesResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "market")
+	ctx = ctxsetters.WithServiceName(ctx, "MarketService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetSecurities")
+	caller := c.callGetSecurities
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetSecuritiesRequest) (*GetSecuritiesResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetSecuritiesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetSecuritiesRequest) when calling interceptor")
+					}
+					return c.callGetSecurities(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetSecuritiesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetSecuritiesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *marketServiceProtobufClient) callGetSecurities(ctx context.Context, in *GetSecuritiesRequest) (*GetSecuritiesResponse, error) {
+	out := new(GetSecuritiesResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *marketServiceProtobufClient) GetCandles(ctx context.Context, in *GetCandlesRequest) (*GetCandlesResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "market")
+	ctx = ctxsetters.WithServiceName(ctx, "MarketService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetCandles")
+	caller := c.callGetCandles
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetCandlesRequest) (*GetCandlesResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetCandlesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetCandlesRequest) when calling interceptor")
+					}
+					return c.callGetCandles(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetCandlesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetCandlesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *marketServiceProtobufClient) callGetCandles(ctx context.Context, in *GetCandlesRequest) (*GetCandlesResponse, error) {
+	out := new(GetCandlesResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *marketServiceProtobufClient) GetModelProbabilities(ctx context.Context, in *GetModelProbabilitiesRequest) (*GetModelProbabilitiesResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "market")
+	ctx = ctxsetters.WithServiceName(ctx, "MarketService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetModelProbabilities")
+	caller := c.callGetModelProbabilities
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetModelProbabilitiesRequest) (*GetModelProbabilitiesResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetModelProbabilitiesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetModelProbabilitiesRequest) when calling interceptor")
+					}
+					return c.callGetModelProbabilities(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetModelProbabilitiesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetModelProbabilitiesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *marketServiceProtobufClient) callGetModelProbabilities(ctx context.Context, in *GetModelProbabilitiesRequest) (*GetModelProbabilitiesResponse, error) {
+	out := new(GetModelProbabilitiesResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[8], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *marketServiceProtobufClient) GetGameResults(ctx context.Context, in *GetGameResultsRequest) (*GetGameResultsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "market")
+	ctx = ctxsetters.WithServiceName(ctx, "MarketService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetGameResults")
+	caller := c.callGetGameResults
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetGameResultsRequest) (*GetGameResultsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetGameResultsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetGameResultsRequest) when calling interceptor")
+					}
+					return c.callGetGameResults(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetGameResultsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetGameResultsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *marketServiceProtobufClient) callGetGameResults(ctx context.Context, in *GetGameResultsRequest) (*GetGameResultsResponse, error) {
+	out := new(GetGameResultsResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[9], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *marketServiceProtobufClient) GetMarketScores(ctx context.Context, in *GetMarketScoresRequest) (*GetMarketScoresResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "market")
+	ctx = ctxsetters.WithServiceName(ctx, "MarketService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetMarketScores")
+	caller := c.callGetMarketScores
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetMarketScoresRequest) (*GetMarketScoresResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetMarketScoresRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetMarketScoresRequest) when calling interceptor")
+					}
+					return c.callGetMarketScores(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetMarketScoresResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetMarketScoresResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *marketServiceProtobufClient) callGetMarketScores(ctx context.Context, in *GetMarketScoresRequest) (*GetMarketScoresResponse, error) {
+	out := new(GetMarketScoresResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[10], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *marketServiceProtobufClient) GetLeaderboard(ctx context.Context, in *GetLeaderboardRequest) (*GetLeaderboardResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "market")
+	ctx = ctxsetters.WithServiceName(ctx, "MarketService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetLeaderboard")
+	caller := c.callGetLeaderboard
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetLeaderboardRequest) (*GetLeaderboardResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetLeaderboardRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetLeaderboardRequest) when calling interceptor")
+					}
+					return c.callGetLeaderboard(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetLeaderboardResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetLeaderboardResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *marketServiceProtobufClient) callGetLeaderboard(ctx context.Context, in *GetLeaderboardRequest) (*GetLeaderboardResponse, error) {
+	out := new(GetLeaderboardResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[11], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *marketServiceProtobufClient) GetLedger(ctx context.Context, in *GetLedgerRequest) (*GetLedgerResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "market")
+	ctx = ctxsetters.WithServiceName(ctx, "MarketService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetLedger")
+	caller := c.callGetLedger
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetLedgerRequest) (*GetLedgerResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetLedgerRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetLedgerRequest) when calling interceptor")
+					}
+					return c.callGetLedger(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetLedgerResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetLedgerResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *marketServiceProtobufClient) callGetLedger(ctx context.Context, in *GetLedgerRequest) (*GetLedgerResponse, error) {
+	out := new(GetLedgerResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[12], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
 // =========================
 // MarketService JSON Client
 // =========================
 
 type marketServiceJSONClient struct {
 	client      HTTPClient
-	urls        [6]string
+	urls        [13]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
@@ -407,13 +750,20 @@ func NewMarketServiceJSONClient(baseURL string, client HTTPClient, opts ...twirp
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
 	serviceURL += baseServicePath(pathPrefix, "market", "MarketService")
-	urls := [6]string{
+	urls := [13]string{
 		serviceURL + "GetOrderBook",
 		serviceURL + "GetOpenMarkets",
 		serviceURL + "BuySecurity",
 		serviceURL + "SellSecurity",
 		serviceURL + "GetPortfolio",
 		serviceURL + "GetSecurityCosts",
+		serviceURL + "GetSecurities",
+		serviceURL + "GetCandles",
+		serviceURL + "GetModelProbabilities",
+		serviceURL + "GetGameResults",
+		serviceURL + "GetMarketScores",
+		serviceURL + "GetLeaderboard",
+		serviceURL + "GetLedger",
 	}
 
 	return &marketServiceJSONClient{
@@ -700,17 +1050,339 @@ func (c *marketServiceJSONClient) callGetSecurityCosts(ctx context.Context, in *
 	return out, nil
 }
 
-// ============================
-// MarketService Server Handler
-// ============================
-
-type marketServiceServer struct {
-	MarketService
-	interceptor      twirp.Interceptor
-	hooks            *twirp.ServerHooks
-	pathPrefix       string // prefix for routing
-	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
-	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
+func (c *marketServiceJSONClient) GetSecurities(ctx context.Context, in *GetSecuritiesRequest) (*GetSecuritiesResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "market")
+	ctx = ctxsetters.WithServiceName(ctx, "MarketService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetSecurities")
+	caller := c.callGetSecurities
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetSecuritiesRequest) (*GetSecuritiesResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetSecuritiesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetSecuritiesRequest) when calling interceptor")
+					}
+					return c.callGetSecurities(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetSecuritiesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetSecuritiesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *marketServiceJSONClient) callGetSecurities(ctx context.Context, in *GetSecuritiesRequest) (*GetSecuritiesResponse, error) {
+	out := new(GetSecuritiesResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *marketServiceJSONClient) GetCandles(ctx context.Context, in *GetCandlesRequest) (*GetCandlesResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "market")
+	ctx = ctxsetters.WithServiceName(ctx, "MarketService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetCandles")
+	caller := c.callGetCandles
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetCandlesRequest) (*GetCandlesResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetCandlesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetCandlesRequest) when calling interceptor")
+					}
+					return c.callGetCandles(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetCandlesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetCandlesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *marketServiceJSONClient) callGetCandles(ctx context.Context, in *GetCandlesRequest) (*GetCandlesResponse, error) {
+	out := new(GetCandlesResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *marketServiceJSONClient) GetModelProbabilities(ctx context.Context, in *GetModelProbabilitiesRequest) (*GetModelProbabilitiesResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "market")
+	ctx = ctxsetters.WithServiceName(ctx, "MarketService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetModelProbabilities")
+	caller := c.callGetModelProbabilities
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetModelProbabilitiesRequest) (*GetModelProbabilitiesResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetModelProbabilitiesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetModelProbabilitiesRequest) when calling interceptor")
+					}
+					return c.callGetModelProbabilities(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetModelProbabilitiesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetModelProbabilitiesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *marketServiceJSONClient) callGetModelProbabilities(ctx context.Context, in *GetModelProbabilitiesRequest) (*GetModelProbabilitiesResponse, error) {
+	out := new(GetModelProbabilitiesResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[8], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *marketServiceJSONClient) GetGameResults(ctx context.Context, in *GetGameResultsRequest) (*GetGameResultsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "market")
+	ctx = ctxsetters.WithServiceName(ctx, "MarketService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetGameResults")
+	caller := c.callGetGameResults
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetGameResultsRequest) (*GetGameResultsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetGameResultsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetGameResultsRequest) when calling interceptor")
+					}
+					return c.callGetGameResults(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetGameResultsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetGameResultsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *marketServiceJSONClient) callGetGameResults(ctx context.Context, in *GetGameResultsRequest) (*GetGameResultsResponse, error) {
+	out := new(GetGameResultsResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[9], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *marketServiceJSONClient) GetMarketScores(ctx context.Context, in *GetMarketScoresRequest) (*GetMarketScoresResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "market")
+	ctx = ctxsetters.WithServiceName(ctx, "MarketService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetMarketScores")
+	caller := c.callGetMarketScores
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetMarketScoresRequest) (*GetMarketScoresResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetMarketScoresRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetMarketScoresRequest) when calling interceptor")
+					}
+					return c.callGetMarketScores(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetMarketScoresResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetMarketScoresResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *marketServiceJSONClient) callGetMarketScores(ctx context.Context, in *GetMarketScoresRequest) (*GetMarketScoresResponse, error) {
+	out := new(GetMarketScoresResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[10], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *marketServiceJSONClient) GetLeaderboard(ctx context.Context, in *GetLeaderboardRequest) (*GetLeaderboardResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "market")
+	ctx = ctxsetters.WithServiceName(ctx, "MarketService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetLeaderboard")
+	caller := c.callGetLeaderboard
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetLeaderboardRequest) (*GetLeaderboardResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetLeaderboardRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetLeaderboardRequest) when calling interceptor")
+					}
+					return c.callGetLeaderboard(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetLeaderboardResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetLeaderboardResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *marketServiceJSONClient) callGetLeaderboard(ctx context.Context, in *GetLeaderboardRequest) (*GetLeaderboardResponse, error) {
+	out := new(GetLeaderboardResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[11], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *marketServiceJSONClient) GetLedger(ctx context.Context, in *GetLedgerRequest) (*GetLedgerResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "market")
+	ctx = ctxsetters.WithServiceName(ctx, "MarketService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetLedger")
+	caller := c.callGetLedger
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetLedgerRequest) (*GetLedgerResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetLedgerRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetLedgerRequest) when calling interceptor")
+					}
+					return c.callGetLedger(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetLedgerResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetLedgerResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *marketServiceJSONClient) callGetLedger(ctx context.Context, in *GetLedgerRequest) (*GetLedgerResponse, error) {
+	out := new(GetLedgerResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[12], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ============================
+// MarketService Server Handler
+// ============================
+
+type marketServiceServer struct {
+	MarketService
+	interceptor      twirp.Interceptor
+	hooks            *twirp.ServerHooks
+	pathPrefix       string // prefix for routing
+	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
+	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
 }
 
 // NewMarketServiceServer builds a TwirpServer that can be used as an http.Handler to handle
@@ -815,6 +1487,27 @@ func (s *marketServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Requ
 	case "GetSecurityCosts":
 		s.serveGetSecurityCosts(ctx, resp, req)
 		return
+	case "GetSecurities":
+		s.serveGetSecurities(ctx, resp, req)
+		return
+	case "GetCandles":
+		s.serveGetCandles(ctx, resp, req)
+		return
+	case "GetModelProbabilities":
+		s.serveGetModelProbabilities(ctx, resp, req)
+		return
+	case "GetGameResults":
+		s.serveGetGameResults(ctx, resp, req)
+		return
+	case "GetMarketScores":
+		s.serveGetMarketScores(ctx, resp, req)
+		return
+	case "GetLeaderboard":
+		s.serveGetLeaderboard(ctx, resp, req)
+		return
+	case "GetLedger":
+		s.serveGetLedger(ctx, resp, req)
+		return
 	default:
 		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
 		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
@@ -1902,819 +2595,4604 @@ func (s *marketServiceServer) serveGetSecurityCostsProtobuf(ctx context.Context,
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *marketServiceServer) ServiceDescriptor() ([]byte, int) {
-	return twirpFileDescriptor0, 0
-}
-
-func (s *marketServiceServer) ProtocGenTwirpVersion() string {
-	return "v8.1.2"
-}
-
-// PathPrefix returns the base service path, in the form: "/<prefix>/<package>.<Service>/"
-// that is everything in a Twirp route except for the <Method>. This can be used for routing,
-// for example to identify the requests that are targeted to this service in a mux.
-func (s *marketServiceServer) PathPrefix() string {
-	return baseServicePath(s.pathPrefix, "market", "MarketService")
-}
-
-// ======================
-// AdminService Interface
-// ======================
-
-type AdminService interface {
-	// Only admins can create markets, securities, etc. Maybe thsi can be extended
-	// to other players.
-	CreateMarket(context.Context, *CreateMarketRequest) (*CreateMarketResponse, error)
-
-	OpenMarket(context.Context, *OpenMarketRequest) (*AdminServiceResponse, error)
-
-	DeleteMarket(context.Context, *DeleteMarketRequest) (*AdminServiceResponse, error)
-
-	AddSecurities(context.Context, *AddSecuritiesRequest) (*AdminServiceResponse, error)
-
-	DeleteSecurity(context.Context, *DeleteSecurityRequest) (*AdminServiceResponse, error)
-
-	// This one will involve a big transaction:
-	ResolveMarket(context.Context, *ResolveMarketRequest) (*ResolveMarketResponse, error)
-}
-
-// ============================
-// AdminService Protobuf Client
-// ============================
-
-type adminServiceProtobufClient struct {
-	client      HTTPClient
-	urls        [6]string
-	interceptor twirp.Interceptor
-	opts        twirp.ClientOptions
-}
-
-// NewAdminServiceProtobufClient creates a Protobuf client that implements the AdminService interface.
-// It communicates using Protobuf and can be configured with a custom HTTPClient.
-func NewAdminServiceProtobufClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) AdminService {
-	if c, ok := client.(*http.Client); ok {
-		client = withoutRedirects(c)
+func (s *marketServiceServer) serveGetSecurities(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
 	}
-
-	clientOpts := twirp.ClientOptions{}
-	for _, o := range opts {
-		o(&clientOpts)
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetSecuritiesJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetSecuritiesProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
 	}
+}
 
-	// Using ReadOpt allows backwards and forwads compatibility with new options in the future
-	literalURLs := false
-	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
-	var pathPrefix string
-	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
-		pathPrefix = "/twirp" // default prefix
+func (s *marketServiceServer) serveGetSecuritiesJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetSecurities")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
 	}
 
-	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
-	serviceURL := sanitizeBaseURL(baseURL)
-	serviceURL += baseServicePath(pathPrefix, "market", "AdminService")
-	urls := [6]string{
-		serviceURL + "CreateMarket",
-		serviceURL + "OpenMarket",
-		serviceURL + "DeleteMarket",
-		serviceURL + "AddSecurities",
-		serviceURL + "DeleteSecurity",
-		serviceURL + "ResolveMarket",
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
 	}
-
-	return &adminServiceProtobufClient{
-		client:      client,
-		urls:        urls,
-		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
-		opts:        clientOpts,
+	reqContent := new(GetSecuritiesRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
 	}
-}
 
-func (c *adminServiceProtobufClient) CreateMarket(ctx context.Context, in *CreateMarketRequest) (*CreateMarketResponse, error) {
-	ctx = ctxsetters.WithPackageName(ctx, "market")
-	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
-	ctx = ctxsetters.WithMethodName(ctx, "CreateMarket")
-	caller := c.callCreateMarket
-	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *CreateMarketRequest) (*CreateMarketResponse, error) {
-			resp, err := c.interceptor(
+	handler := s.MarketService.GetSecurities
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetSecuritiesRequest) (*GetSecuritiesResponse, error) {
+			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*CreateMarketRequest)
+					typedReq, ok := req.(*GetSecuritiesRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*CreateMarketRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*GetSecuritiesRequest) when calling interceptor")
 					}
-					return c.callCreateMarket(ctx, typedReq)
+					return s.MarketService.GetSecurities(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*CreateMarketResponse)
+				typedResp, ok := resp.(*GetSecuritiesResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*CreateMarketResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*GetSecuritiesResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
 			return nil, err
 		}
 	}
-	return caller(ctx, in)
-}
 
-func (c *adminServiceProtobufClient) callCreateMarket(ctx context.Context, in *CreateMarketRequest) (*CreateMarketResponse, error) {
-	out := new(CreateMarketResponse)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	// Call service method
+	var respContent *GetSecuritiesResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
 	if err != nil {
-		twerr, ok := err.(twirp.Error)
-		if !ok {
-			twerr = twirp.InternalErrorWith(err)
-		}
-		callClientError(ctx, c.opts.Hooks, twerr)
-		return nil, err
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetSecuritiesResponse and nil error while calling GetSecurities. nil responses are not supported"))
+		return
 	}
 
-	callClientResponseReceived(ctx, c.opts.Hooks)
+	ctx = callResponsePrepared(ctx, s.hooks)
 
-	return out, nil
-}
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
 
-func (c *adminServiceProtobufClient) OpenMarket(ctx context.Context, in *OpenMarketRequest) (*AdminServiceResponse, error) {
-	ctx = ctxsetters.WithPackageName(ctx, "market")
-	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
-	ctx = ctxsetters.WithMethodName(ctx, "OpenMarket")
-	caller := c.callOpenMarket
-	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *OpenMarketRequest) (*AdminServiceResponse, error) {
-			resp, err := c.interceptor(
-				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*OpenMarketRequest)
-					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*OpenMarketRequest) when calling interceptor")
-					}
-					return c.callOpenMarket(ctx, typedReq)
-				},
-			)(ctx, req)
-			if resp != nil {
-				typedResp, ok := resp.(*AdminServiceResponse)
-				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*AdminServiceResponse) when calling interceptor")
-				}
-				return typedResp, err
-			}
-			return nil, err
-		}
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
 	}
-	return caller(ctx, in)
+	callResponseSent(ctx, s.hooks)
 }
 
-func (c *adminServiceProtobufClient) callOpenMarket(ctx context.Context, in *OpenMarketRequest) (*AdminServiceResponse, error) {
-	out := new(AdminServiceResponse)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+func (s *marketServiceServer) serveGetSecuritiesProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetSecurities")
+	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
-		twerr, ok := err.(twirp.Error)
-		if !ok {
-			twerr = twirp.InternalErrorWith(err)
-		}
-		callClientError(ctx, c.opts.Hooks, twerr)
-		return nil, err
+		s.writeError(ctx, resp, err)
+		return
 	}
 
-	callClientResponseReceived(ctx, c.opts.Hooks)
-
-	return out, nil
-}
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(GetSecuritiesRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
 
-func (c *adminServiceProtobufClient) DeleteMarket(ctx context.Context, in *DeleteMarketRequest) (*AdminServiceResponse, error) {
-	ctx = ctxsetters.WithPackageName(ctx, "market")
-	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
-	ctx = ctxsetters.WithMethodName(ctx, "DeleteMarket")
-	caller := c.callDeleteMarket
-	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *DeleteMarketRequest) (*AdminServiceResponse, error) {
-			resp, err := c.interceptor(
+	handler := s.MarketService.GetSecurities
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetSecuritiesRequest) (*GetSecuritiesResponse, error) {
+			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*DeleteMarketRequest)
+					typedReq, ok := req.(*GetSecuritiesRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*DeleteMarketRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*GetSecuritiesRequest) when calling interceptor")
 					}
-					return c.callDeleteMarket(ctx, typedReq)
+					return s.MarketService.GetSecurities(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*AdminServiceResponse)
+				typedResp, ok := resp.(*GetSecuritiesResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*AdminServiceResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*GetSecuritiesResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
 			return nil, err
 		}
 	}
-	return caller(ctx, in)
-}
 
-func (c *adminServiceProtobufClient) callDeleteMarket(ctx context.Context, in *DeleteMarketRequest) (*AdminServiceResponse, error) {
-	out := new(AdminServiceResponse)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	// Call service method
+	var respContent *GetSecuritiesResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
 	if err != nil {
-		twerr, ok := err.(twirp.Error)
-		if !ok {
-			twerr = twirp.InternalErrorWith(err)
-		}
-		callClientError(ctx, c.opts.Hooks, twerr)
-		return nil, err
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetSecuritiesResponse and nil error while calling GetSecurities. nil responses are not supported"))
+		return
 	}
 
-	callClientResponseReceived(ctx, c.opts.Hooks)
-
-	return out, nil
-}
+	ctx = callResponsePrepared(ctx, s.hooks)
 
-func (c *adminServiceProtobufClient) AddSecurities(ctx context.Context, in *AddSecuritiesRequest) (*AdminServiceResponse, error) {
-	ctx = ctxsetters.WithPackageName(ctx, "market")
-	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
-	ctx = ctxsetters.WithMethodName(ctx, "AddSecurities")
-	caller := c.callAddSecurities
-	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *AddSecuritiesRequest) (*AdminServiceResponse, error) {
-			resp, err := c.interceptor(
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *marketServiceServer) serveGetCandles(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetCandlesJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetCandlesProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *marketServiceServer) serveGetCandlesJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetCandles")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(GetCandlesRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.MarketService.GetCandles
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetCandlesRequest) (*GetCandlesResponse, error) {
+			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*AddSecuritiesRequest)
+					typedReq, ok := req.(*GetCandlesRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*AddSecuritiesRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*GetCandlesRequest) when calling interceptor")
 					}
-					return c.callAddSecurities(ctx, typedReq)
+					return s.MarketService.GetCandles(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*AdminServiceResponse)
+				typedResp, ok := resp.(*GetCandlesResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*AdminServiceResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*GetCandlesResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
 			return nil, err
 		}
 	}
-	return caller(ctx, in)
-}
 
-func (c *adminServiceProtobufClient) callAddSecurities(ctx context.Context, in *AddSecuritiesRequest) (*AdminServiceResponse, error) {
-	out := new(AdminServiceResponse)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	// Call service method
+	var respContent *GetCandlesResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
 	if err != nil {
-		twerr, ok := err.(twirp.Error)
-		if !ok {
-			twerr = twirp.InternalErrorWith(err)
-		}
-		callClientError(ctx, c.opts.Hooks, twerr)
-		return nil, err
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetCandlesResponse and nil error while calling GetCandles. nil responses are not supported"))
+		return
 	}
 
-	callClientResponseReceived(ctx, c.opts.Hooks)
+	ctx = callResponsePrepared(ctx, s.hooks)
 
-	return out, nil
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
 }
 
-func (c *adminServiceProtobufClient) DeleteSecurity(ctx context.Context, in *DeleteSecurityRequest) (*AdminServiceResponse, error) {
-	ctx = ctxsetters.WithPackageName(ctx, "market")
-	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
-	ctx = ctxsetters.WithMethodName(ctx, "DeleteSecurity")
-	caller := c.callDeleteSecurity
-	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *DeleteSecurityRequest) (*AdminServiceResponse, error) {
-			resp, err := c.interceptor(
+func (s *marketServiceServer) serveGetCandlesProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetCandles")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(GetCandlesRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.MarketService.GetCandles
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetCandlesRequest) (*GetCandlesResponse, error) {
+			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*DeleteSecurityRequest)
+					typedReq, ok := req.(*GetCandlesRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*DeleteSecurityRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*GetCandlesRequest) when calling interceptor")
 					}
-					return c.callDeleteSecurity(ctx, typedReq)
+					return s.MarketService.GetCandles(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*AdminServiceResponse)
+				typedResp, ok := resp.(*GetCandlesResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*AdminServiceResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*GetCandlesResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
 			return nil, err
 		}
 	}
-	return caller(ctx, in)
-}
 
-func (c *adminServiceProtobufClient) callDeleteSecurity(ctx context.Context, in *DeleteSecurityRequest) (*AdminServiceResponse, error) {
-	out := new(AdminServiceResponse)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	// Call service method
+	var respContent *GetCandlesResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
 	if err != nil {
-		twerr, ok := err.(twirp.Error)
-		if !ok {
-			twerr = twirp.InternalErrorWith(err)
-		}
-		callClientError(ctx, c.opts.Hooks, twerr)
-		return nil, err
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetCandlesResponse and nil error while calling GetCandles. nil responses are not supported"))
+		return
 	}
 
-	callClientResponseReceived(ctx, c.opts.Hooks)
+	ctx = callResponsePrepared(ctx, s.hooks)
 
-	return out, nil
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
 }
 
-func (c *adminServiceProtobufClient) ResolveMarket(ctx context.Context, in *ResolveMarketRequest) (*ResolveMarketResponse, error) {
-	ctx = ctxsetters.WithPackageName(ctx, "market")
-	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
-	ctx = ctxsetters.WithMethodName(ctx, "ResolveMarket")
-	caller := c.callResolveMarket
-	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *ResolveMarketRequest) (*ResolveMarketResponse, error) {
-			resp, err := c.interceptor(
+func (s *marketServiceServer) serveGetModelProbabilities(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetModelProbabilitiesJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetModelProbabilitiesProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *marketServiceServer) serveGetModelProbabilitiesJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetModelProbabilities")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(GetModelProbabilitiesRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.MarketService.GetModelProbabilities
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetModelProbabilitiesRequest) (*GetModelProbabilitiesResponse, error) {
+			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*ResolveMarketRequest)
+					typedReq, ok := req.(*GetModelProbabilitiesRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*ResolveMarketRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*GetModelProbabilitiesRequest) when calling interceptor")
 					}
-					return c.callResolveMarket(ctx, typedReq)
+					return s.MarketService.GetModelProbabilities(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*ResolveMarketResponse)
+				typedResp, ok := resp.(*GetModelProbabilitiesResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*ResolveMarketResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*GetModelProbabilitiesResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
 			return nil, err
 		}
 	}
-	return caller(ctx, in)
-}
 
-func (c *adminServiceProtobufClient) callResolveMarket(ctx context.Context, in *ResolveMarketRequest) (*ResolveMarketResponse, error) {
-	out := new(ResolveMarketResponse)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	// Call service method
+	var respContent *GetModelProbabilitiesResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
 	if err != nil {
-		twerr, ok := err.(twirp.Error)
-		if !ok {
-			twerr = twirp.InternalErrorWith(err)
-		}
-		callClientError(ctx, c.opts.Hooks, twerr)
-		return nil, err
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetModelProbabilitiesResponse and nil error while calling GetModelProbabilities. nil responses are not supported"))
+		return
 	}
 
-	callClientResponseReceived(ctx, c.opts.Hooks)
+	ctx = callResponsePrepared(ctx, s.hooks)
 
-	return out, nil
-}
-
-// ========================
-// AdminService JSON Client
-// ========================
-
-type adminServiceJSONClient struct {
-	client      HTTPClient
-	urls        [6]string
-	interceptor twirp.Interceptor
-	opts        twirp.ClientOptions
-}
-
-// NewAdminServiceJSONClient creates a JSON client that implements the AdminService interface.
-// It communicates using JSON and can be configured with a custom HTTPClient.
-func NewAdminServiceJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) AdminService {
-	if c, ok := client.(*http.Client); ok {
-		client = withoutRedirects(c)
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
 	}
 
-	clientOpts := twirp.ClientOptions{}
-	for _, o := range opts {
-		o(&clientOpts)
-	}
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
 
-	// Using ReadOpt allows backwards and forwads compatibility with new options in the future
-	literalURLs := false
-	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
-	var pathPrefix string
-	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
-		pathPrefix = "/twirp" // default prefix
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
 	}
+	callResponseSent(ctx, s.hooks)
+}
 
-	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
-	serviceURL := sanitizeBaseURL(baseURL)
-	serviceURL += baseServicePath(pathPrefix, "market", "AdminService")
-	urls := [6]string{
-		serviceURL + "CreateMarket",
-		serviceURL + "OpenMarket",
-		serviceURL + "DeleteMarket",
-		serviceURL + "AddSecurities",
-		serviceURL + "DeleteSecurity",
-		serviceURL + "ResolveMarket",
+func (s *marketServiceServer) serveGetModelProbabilitiesProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetModelProbabilities")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
 	}
 
-	return &adminServiceJSONClient{
-		client:      client,
-		urls:        urls,
-		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
-		opts:        clientOpts,
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(GetModelProbabilitiesRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
 	}
-}
 
-func (c *adminServiceJSONClient) CreateMarket(ctx context.Context, in *CreateMarketRequest) (*CreateMarketResponse, error) {
-	ctx = ctxsetters.WithPackageName(ctx, "market")
-	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
-	ctx = ctxsetters.WithMethodName(ctx, "CreateMarket")
-	caller := c.callCreateMarket
-	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *CreateMarketRequest) (*CreateMarketResponse, error) {
-			resp, err := c.interceptor(
+	handler := s.MarketService.GetModelProbabilities
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetModelProbabilitiesRequest) (*GetModelProbabilitiesResponse, error) {
+			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*CreateMarketRequest)
+					typedReq, ok := req.(*GetModelProbabilitiesRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*CreateMarketRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*GetModelProbabilitiesRequest) when calling interceptor")
 					}
-					return c.callCreateMarket(ctx, typedReq)
+					return s.MarketService.GetModelProbabilities(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*CreateMarketResponse)
+				typedResp, ok := resp.(*GetModelProbabilitiesResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*CreateMarketResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*GetModelProbabilitiesResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
 			return nil, err
 		}
 	}
-	return caller(ctx, in)
-}
 
-func (c *adminServiceJSONClient) callCreateMarket(ctx context.Context, in *CreateMarketRequest) (*CreateMarketResponse, error) {
-	out := new(CreateMarketResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	// Call service method
+	var respContent *GetModelProbabilitiesResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
 	if err != nil {
-		twerr, ok := err.(twirp.Error)
-		if !ok {
-			twerr = twirp.InternalErrorWith(err)
-		}
-		callClientError(ctx, c.opts.Hooks, twerr)
-		return nil, err
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetModelProbabilitiesResponse and nil error while calling GetModelProbabilities. nil responses are not supported"))
+		return
 	}
 
-	callClientResponseReceived(ctx, c.opts.Hooks)
+	ctx = callResponsePrepared(ctx, s.hooks)
 
-	return out, nil
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
 }
 
-func (c *adminServiceJSONClient) OpenMarket(ctx context.Context, in *OpenMarketRequest) (*AdminServiceResponse, error) {
-	ctx = ctxsetters.WithPackageName(ctx, "market")
-	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
-	ctx = ctxsetters.WithMethodName(ctx, "OpenMarket")
-	caller := c.callOpenMarket
-	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *OpenMarketRequest) (*AdminServiceResponse, error) {
-			resp, err := c.interceptor(
+func (s *marketServiceServer) serveGetGameResults(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetGameResultsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetGameResultsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *marketServiceServer) serveGetGameResultsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetGameResults")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(GetGameResultsRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.MarketService.GetGameResults
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetGameResultsRequest) (*GetGameResultsResponse, error) {
+			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*OpenMarketRequest)
+					typedReq, ok := req.(*GetGameResultsRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*OpenMarketRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*GetGameResultsRequest) when calling interceptor")
 					}
-					return c.callOpenMarket(ctx, typedReq)
+					return s.MarketService.GetGameResults(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*AdminServiceResponse)
+				typedResp, ok := resp.(*GetGameResultsResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*AdminServiceResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*GetGameResultsResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
 			return nil, err
 		}
 	}
-	return caller(ctx, in)
-}
 
-func (c *adminServiceJSONClient) callOpenMarket(ctx context.Context, in *OpenMarketRequest) (*AdminServiceResponse, error) {
-	out := new(AdminServiceResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	// Call service method
+	var respContent *GetGameResultsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
 	if err != nil {
-		twerr, ok := err.(twirp.Error)
-		if !ok {
-			twerr = twirp.InternalErrorWith(err)
-		}
-		callClientError(ctx, c.opts.Hooks, twerr)
-		return nil, err
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetGameResultsResponse and nil error while calling GetGameResults. nil responses are not supported"))
+		return
 	}
 
-	callClientResponseReceived(ctx, c.opts.Hooks)
+	ctx = callResponsePrepared(ctx, s.hooks)
 
-	return out, nil
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
 }
 
-func (c *adminServiceJSONClient) DeleteMarket(ctx context.Context, in *DeleteMarketRequest) (*AdminServiceResponse, error) {
-	ctx = ctxsetters.WithPackageName(ctx, "market")
-	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
-	ctx = ctxsetters.WithMethodName(ctx, "DeleteMarket")
-	caller := c.callDeleteMarket
-	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *DeleteMarketRequest) (*AdminServiceResponse, error) {
-			resp, err := c.interceptor(
+func (s *marketServiceServer) serveGetGameResultsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetGameResults")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(GetGameResultsRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.MarketService.GetGameResults
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetGameResultsRequest) (*GetGameResultsResponse, error) {
+			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*DeleteMarketRequest)
+					typedReq, ok := req.(*GetGameResultsRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*DeleteMarketRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*GetGameResultsRequest) when calling interceptor")
 					}
-					return c.callDeleteMarket(ctx, typedReq)
+					return s.MarketService.GetGameResults(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*AdminServiceResponse)
+				typedResp, ok := resp.(*GetGameResultsResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*AdminServiceResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*GetGameResultsResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
 			return nil, err
 		}
 	}
-	return caller(ctx, in)
-}
 
-func (c *adminServiceJSONClient) callDeleteMarket(ctx context.Context, in *DeleteMarketRequest) (*AdminServiceResponse, error) {
-	out := new(AdminServiceResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
-	if err != nil {
-		twerr, ok := err.(twirp.Error)
-		if !ok {
-			twerr = twirp.InternalErrorWith(err)
-		}
-		callClientError(ctx, c.opts.Hooks, twerr)
-		return nil, err
+	// Call service method
+	var respContent *GetGameResultsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetGameResultsResponse and nil error while calling GetGameResults. nil responses are not supported"))
+		return
 	}
 
-	callClientResponseReceived(ctx, c.opts.Hooks)
+	ctx = callResponsePrepared(ctx, s.hooks)
 
-	return out, nil
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
 }
 
-func (c *adminServiceJSONClient) AddSecurities(ctx context.Context, in *AddSecuritiesRequest) (*AdminServiceResponse, error) {
-	ctx = ctxsetters.WithPackageName(ctx, "market")
-	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
-	ctx = ctxsetters.WithMethodName(ctx, "AddSecurities")
-	caller := c.callAddSecurities
-	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *AddSecuritiesRequest) (*AdminServiceResponse, error) {
-			resp, err := c.interceptor(
+func (s *marketServiceServer) serveGetMarketScores(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetMarketScoresJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetMarketScoresProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *marketServiceServer) serveGetMarketScoresJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetMarketScores")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(GetMarketScoresRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.MarketService.GetMarketScores
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetMarketScoresRequest) (*GetMarketScoresResponse, error) {
+			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*AddSecuritiesRequest)
+					typedReq, ok := req.(*GetMarketScoresRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*AddSecuritiesRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*GetMarketScoresRequest) when calling interceptor")
 					}
-					return c.callAddSecurities(ctx, typedReq)
+					return s.MarketService.GetMarketScores(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*AdminServiceResponse)
+				typedResp, ok := resp.(*GetMarketScoresResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*AdminServiceResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*GetMarketScoresResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
 			return nil, err
 		}
 	}
-	return caller(ctx, in)
-}
 
-func (c *adminServiceJSONClient) callAddSecurities(ctx context.Context, in *AddSecuritiesRequest) (*AdminServiceResponse, error) {
-	out := new(AdminServiceResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	// Call service method
+	var respContent *GetMarketScoresResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
 	if err != nil {
-		twerr, ok := err.(twirp.Error)
-		if !ok {
-			twerr = twirp.InternalErrorWith(err)
-		}
-		callClientError(ctx, c.opts.Hooks, twerr)
-		return nil, err
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetMarketScoresResponse and nil error while calling GetMarketScores. nil responses are not supported"))
+		return
 	}
 
-	callClientResponseReceived(ctx, c.opts.Hooks)
+	ctx = callResponsePrepared(ctx, s.hooks)
 
-	return out, nil
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
 }
 
-func (c *adminServiceJSONClient) DeleteSecurity(ctx context.Context, in *DeleteSecurityRequest) (*AdminServiceResponse, error) {
-	ctx = ctxsetters.WithPackageName(ctx, "market")
-	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
-	ctx = ctxsetters.WithMethodName(ctx, "DeleteSecurity")
-	caller := c.callDeleteSecurity
-	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *DeleteSecurityRequest) (*AdminServiceResponse, error) {
-			resp, err := c.interceptor(
+func (s *marketServiceServer) serveGetMarketScoresProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetMarketScores")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(GetMarketScoresRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.MarketService.GetMarketScores
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetMarketScoresRequest) (*GetMarketScoresResponse, error) {
+			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*DeleteSecurityRequest)
+					typedReq, ok := req.(*GetMarketScoresRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*DeleteSecurityRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*GetMarketScoresRequest) when calling interceptor")
 					}
-					return c.callDeleteSecurity(ctx, typedReq)
+					return s.MarketService.GetMarketScores(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*AdminServiceResponse)
+				typedResp, ok := resp.(*GetMarketScoresResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*AdminServiceResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*GetMarketScoresResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
 			return nil, err
 		}
 	}
-	return caller(ctx, in)
-}
 
-func (c *adminServiceJSONClient) callDeleteSecurity(ctx context.Context, in *DeleteSecurityRequest) (*AdminServiceResponse, error) {
-	out := new(AdminServiceResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	// Call service method
+	var respContent *GetMarketScoresResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
 	if err != nil {
-		twerr, ok := err.(twirp.Error)
-		if !ok {
-			twerr = twirp.InternalErrorWith(err)
-		}
-		callClientError(ctx, c.opts.Hooks, twerr)
-		return nil, err
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetMarketScoresResponse and nil error while calling GetMarketScores. nil responses are not supported"))
+		return
 	}
 
-	callClientResponseReceived(ctx, c.opts.Hooks)
+	ctx = callResponsePrepared(ctx, s.hooks)
 
-	return out, nil
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
 }
 
-func (c *adminServiceJSONClient) ResolveMarket(ctx context.Context, in *ResolveMarketRequest) (*ResolveMarketResponse, error) {
-	ctx = ctxsetters.WithPackageName(ctx, "market")
-	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
-	ctx = ctxsetters.WithMethodName(ctx, "ResolveMarket")
-	caller := c.callResolveMarket
-	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *ResolveMarketRequest) (*ResolveMarketResponse, error) {
-			resp, err := c.interceptor(
+func (s *marketServiceServer) serveGetLeaderboard(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetLeaderboardJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetLeaderboardProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *marketServiceServer) serveGetLeaderboardJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetLeaderboard")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(GetLeaderboardRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.MarketService.GetLeaderboard
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetLeaderboardRequest) (*GetLeaderboardResponse, error) {
+			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*ResolveMarketRequest)
+					typedReq, ok := req.(*GetLeaderboardRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*ResolveMarketRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*GetLeaderboardRequest) when calling interceptor")
 					}
-					return c.callResolveMarket(ctx, typedReq)
+					return s.MarketService.GetLeaderboard(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*ResolveMarketResponse)
+				typedResp, ok := resp.(*GetLeaderboardResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*ResolveMarketResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*GetLeaderboardResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
 			return nil, err
 		}
 	}
-	return caller(ctx, in)
-}
 
-func (c *adminServiceJSONClient) callResolveMarket(ctx context.Context, in *ResolveMarketRequest) (*ResolveMarketResponse, error) {
-	out := new(ResolveMarketResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
-	if err != nil {
-		twerr, ok := err.(twirp.Error)
-		if !ok {
-			twerr = twirp.InternalErrorWith(err)
-		}
-		callClientError(ctx, c.opts.Hooks, twerr)
-		return nil, err
+	// Call service method
+	var respContent *GetLeaderboardResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetLeaderboardResponse and nil error while calling GetLeaderboard. nil responses are not supported"))
+		return
 	}
 
-	callClientResponseReceived(ctx, c.opts.Hooks)
+	ctx = callResponsePrepared(ctx, s.hooks)
 
-	return out, nil
-}
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
 
-// ===========================
-// AdminService Server Handler
-// ===========================
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
 
-type adminServiceServer struct {
-	AdminService
-	interceptor      twirp.Interceptor
-	hooks            *twirp.ServerHooks
-	pathPrefix       string // prefix for routing
-	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
-	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
 }
 
-// NewAdminServiceServer builds a TwirpServer that can be used as an http.Handler to handle
-// HTTP requests that are routed to the right method in the provided svc implementation.
-// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
-func NewAdminServiceServer(svc AdminService, opts ...interface{}) TwirpServer {
-	serverOpts := newServerOpts(opts)
+func (s *marketServiceServer) serveGetLeaderboardProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetLeaderboard")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
 
-	// Using ReadOpt allows backwards and forwads compatibility with new options in the future
-	jsonSkipDefaults := false
-	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
-	jsonCamelCase := false
-	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
-	var pathPrefix string
-	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
-		pathPrefix = "/twirp" // default prefix
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(GetLeaderboardRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
 	}
 
-	return &adminServiceServer{
-		AdminService:     svc,
-		hooks:            serverOpts.Hooks,
-		interceptor:      twirp.ChainInterceptors(serverOpts.Interceptors...),
-		pathPrefix:       pathPrefix,
-		jsonSkipDefaults: jsonSkipDefaults,
-		jsonCamelCase:    jsonCamelCase,
+	handler := s.MarketService.GetLeaderboard
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetLeaderboardRequest) (*GetLeaderboardResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetLeaderboardRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetLeaderboardRequest) when calling interceptor")
+					}
+					return s.MarketService.GetLeaderboard(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetLeaderboardResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetLeaderboardResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetLeaderboardResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetLeaderboardResponse and nil error while calling GetLeaderboard. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
 	}
+	callResponseSent(ctx, s.hooks)
 }
 
-// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
-// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
-func (s *adminServiceServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
-	writeError(ctx, resp, err, s.hooks)
+func (s *marketServiceServer) serveGetLedger(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetLedgerJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetLedgerProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
 }
 
-// handleRequestBodyError is used to handle error when the twirp server cannot read request
-func (s *adminServiceServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
-	if context.Canceled == ctx.Err() {
-		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
+func (s *marketServiceServer) serveGetLedgerJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetLedger")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
 		return
 	}
-	if context.DeadlineExceeded == ctx.Err() {
-		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
+	reqContent := new(GetLedgerRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.MarketService.GetLedger
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetLedgerRequest) (*GetLedgerResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetLedgerRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetLedgerRequest) when calling interceptor")
+					}
+					return s.MarketService.GetLedger(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetLedgerResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetLedgerResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetLedgerResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetLedgerResponse and nil error while calling GetLedger. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
 }
 
-// AdminServicePathPrefix is a convenience constant that may identify URL paths.
-// Should be used with caution, it only matches routes generated by Twirp Go clients,
-// with the default "/twirp" prefix and default CamelCase service and method names.
-// More info: https://twitchtv.github.io/twirp/docs/routing.html
-const AdminServicePathPrefix = "/twirp/market.AdminService/"
+func (s *marketServiceServer) serveGetLedgerProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetLedger")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
 
-func (s *adminServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
-	ctx := req.Context()
-	ctx = ctxsetters.WithPackageName(ctx, "market")
-	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
-	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(GetLedgerRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.MarketService.GetLedger
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetLedgerRequest) (*GetLedgerResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetLedgerRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetLedgerRequest) when calling interceptor")
+					}
+					return s.MarketService.GetLedger(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetLedgerResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetLedgerResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetLedgerResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetLedgerResponse and nil error while calling GetLedger. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *marketServiceServer) ServiceDescriptor() ([]byte, int) {
+	return twirpFileDescriptor0, 0
+}
+
+func (s *marketServiceServer) ProtocGenTwirpVersion() string {
+	return "v8.1.2"
+}
+
+// PathPrefix returns the base service path, in the form: "/<prefix>/<package>.<Service>/"
+// that is everything in a Twirp route except for the <Method>. This can be used for routing,
+// for example to identify the requests that are targeted to this service in a mux.
+func (s *marketServiceServer) PathPrefix() string {
+	return baseServicePath(s.pathPrefix, "market", "MarketService")
+}
+
+// ======================
+// AdminService Interface
+// ======================
+
+type AdminService interface {
+	// Only admins can create markets, securities, etc. Maybe thsi can be extended
+	// to other players.
+	CreateMarket(context.Context, *CreateMarketRequest) (*CreateMarketResponse, error)
+
+	// Opens a draft or scheduled market, or reopens a halted or closed one.
+	OpenMarket(context.Context, *OpenMarketRequest) (*AdminServiceResponse, error)
+
+	CloseMarket(context.Context, *CloseMarketRequest) (*AdminServiceResponse, error)
+
+	// Pauses trading in an open market. OpenMarket resumes it.
+	HaltMarket(context.Context, *HaltMarketRequest) (*AdminServiceResponse, error)
+
+	// Cancels a market that hasn't been resolved, refunding every trader what
+	// they paid for their shares, and voids the markets conditional on it.
+	VoidMarket(context.Context, *VoidMarketRequest) (*AdminServiceResponse, error)
+
+	// The server opens and closes scheduled markets on time.
+	ScheduleMarket(context.Context, *ScheduleMarketRequest) (*AdminServiceResponse, error)
+
+	DeleteMarket(context.Context, *DeleteMarketRequest) (*AdminServiceResponse, error)
+
+	AddSecurities(context.Context, *AddSecuritiesRequest) (*AdminServiceResponse, error)
+
+	DeleteSecurity(context.Context, *DeleteSecurityRequest) (*AdminServiceResponse, error)
+
+	// This one will involve a big transaction:
+	ResolveMarket(context.Context, *ResolveMarketRequest) (*ResolveMarketResponse, error)
+
+	// Creates and opens one market per game in a round, with one security for
+	// each player.
+	CreateMatchupMarkets(context.Context, *CreateMatchupMarketsRequest) (*CreateMatchupMarketsResponse, error)
+
+	// Resolves a round's markets from its scores. A tied game voids its
+	// market. Markets that are already resolved are skipped, so a round that
+	// was only partly resolved can be sent again.
+	ResolveMatchupMarkets(context.Context, *ResolveMatchupMarketsRequest) (*ResolveMarketResponse, error)
+
+	// Records results as rounds finish, and tells anyone listening about the
+	// new ones.
+	SubmitGameResults(context.Context, *SubmitGameResultsRequest) (*SubmitGameResultsResponse, error)
+
+	// Gives a user tokens from the house.
+	GrantTokens(context.Context, *GrantTokensRequest) (*AdminServiceResponse, error)
+
+	// Checks the books, and reports every row that doesn't add up.
+	Audit(context.Context, *AuditRequest) (*AuditResponse, error)
+}
+
+// ============================
+// AdminService Protobuf Client
+// ============================
+
+type adminServiceProtobufClient struct {
+	client      HTTPClient
+	urls        [15]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewAdminServiceProtobufClient creates a Protobuf client that implements the AdminService interface.
+// It communicates using Protobuf and can be configured with a custom HTTPClient.
+func NewAdminServiceProtobufClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) AdminService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwads compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "market", "AdminService")
+	urls := [15]string{
+		serviceURL + "CreateMarket",
+		serviceURL + "OpenMarket",
+		serviceURL + "CloseMarket",
+		serviceURL + "HaltMarket",
+		serviceURL + "VoidMarket",
+		serviceURL + "ScheduleMarket",
+		serviceURL + "DeleteMarket",
+		serviceURL + "AddSecurities",
+		serviceURL + "DeleteSecurity",
+		serviceURL + "ResolveMarket",
+		serviceURL + "CreateMatchupMarkets",
+		serviceURL + "ResolveMatchupMarkets",
+		serviceURL + "SubmitGameResults",
+		serviceURL + "GrantTokens",
+		serviceURL + "Audit",
+	}
+
+	return &adminServiceProtobufClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *adminServiceProtobufClient) CreateMarket(ctx context.Context, in *CreateMarketRequest) (*CreateMarketResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "market")
+	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "CreateMarket")
+	caller := c.callCreateMarket
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CreateMarketRequest) (*CreateMarketResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateMarketRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateMarketRequest) when calling interceptor")
+					}
+					return c.callCreateMarket(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateMarketResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateMarketResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *adminServiceProtobufClient) callCreateMarket(ctx context.Context, in *CreateMarketRequest) (*CreateMarketResponse, error) {
+	out := new(CreateMarketResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *adminServiceProtobufClient) OpenMarket(ctx context.Context, in *OpenMarketRequest) (*AdminServiceResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "market")
+	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "OpenMarket")
+	caller := c.callOpenMarket
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *OpenMarketRequest) (*AdminServiceResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*OpenMarketRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*OpenMarketRequest) when calling interceptor")
+					}
+					return c.callOpenMarket(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AdminServiceResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AdminServiceResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *adminServiceProtobufClient) callOpenMarket(ctx context.Context, in *OpenMarketRequest) (*AdminServiceResponse, error) {
+	out := new(AdminServiceResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *adminServiceProtobufClient) CloseMarket(ctx context.Context, in *CloseMarketRequest) (*AdminServiceResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "market")
+	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "CloseMarket")
+	caller := c.callCloseMarket
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CloseMarketRequest) (*AdminServiceResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CloseMarketRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CloseMarketRequest) when calling interceptor")
+					}
+					return c.callCloseMarket(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AdminServiceResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AdminServiceResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *adminServiceProtobufClient) callCloseMarket(ctx context.Context, in *CloseMarketRequest) (*AdminServiceResponse, error) {
+	out := new(AdminServiceResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *adminServiceProtobufClient) HaltMarket(ctx context.Context, in *HaltMarketRequest) (*AdminServiceResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "market")
+	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "HaltMarket")
+	caller := c.callHaltMarket
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *HaltMarketRequest) (*AdminServiceResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*HaltMarketRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*HaltMarketRequest) when calling interceptor")
+					}
+					return c.callHaltMarket(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AdminServiceResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AdminServiceResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *adminServiceProtobufClient) callHaltMarket(ctx context.Context, in *HaltMarketRequest) (*AdminServiceResponse, error) {
+	out := new(AdminServiceResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *adminServiceProtobufClient) VoidMarket(ctx context.Context, in *VoidMarketRequest) (*AdminServiceResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "market")
+	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "VoidMarket")
+	caller := c.callVoidMarket
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *VoidMarketRequest) (*AdminServiceResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*VoidMarketRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*VoidMarketRequest) when calling interceptor")
+					}
+					return c.callVoidMarket(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AdminServiceResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AdminServiceResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *adminServiceProtobufClient) callVoidMarket(ctx context.Context, in *VoidMarketRequest) (*AdminServiceResponse, error) {
+	out := new(AdminServiceResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *adminServiceProtobufClient) ScheduleMarket(ctx context.Context, in *ScheduleMarketRequest) (*AdminServiceResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "market")
+	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "ScheduleMarket")
+	caller := c.callScheduleMarket
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ScheduleMarketRequest) (*AdminServiceResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ScheduleMarketRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ScheduleMarketRequest) when calling interceptor")
+					}
+					return c.callScheduleMarket(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AdminServiceResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AdminServiceResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *adminServiceProtobufClient) callScheduleMarket(ctx context.Context, in *ScheduleMarketRequest) (*AdminServiceResponse, error) {
+	out := new(AdminServiceResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *adminServiceProtobufClient) DeleteMarket(ctx context.Context, in *DeleteMarketRequest) (*AdminServiceResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "market")
+	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteMarket")
+	caller := c.callDeleteMarket
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *DeleteMarketRequest) (*AdminServiceResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteMarketRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteMarketRequest) when calling interceptor")
+					}
+					return c.callDeleteMarket(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AdminServiceResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AdminServiceResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *adminServiceProtobufClient) callDeleteMarket(ctx context.Context, in *DeleteMarketRequest) (*AdminServiceResponse, error) {
+	out := new(AdminServiceResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *adminServiceProtobufClient) AddSecurities(ctx context.Context, in *AddSecuritiesRequest) (*AdminServiceResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "market")
+	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "AddSecurities")
+	caller := c.callAddSecurities
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *AddSecuritiesRequest) (*AdminServiceResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AddSecuritiesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AddSecuritiesRequest) when calling interceptor")
+					}
+					return c.callAddSecurities(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AdminServiceResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AdminServiceResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *adminServiceProtobufClient) callAddSecurities(ctx context.Context, in *AddSecuritiesRequest) (*AdminServiceResponse, error) {
+	out := new(AdminServiceResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *adminServiceProtobufClient) DeleteSecurity(ctx context.Context, in *DeleteSecurityRequest) (*AdminServiceResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "market")
+	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteSecurity")
+	caller := c.callDeleteSecurity
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *DeleteSecurityRequest) (*AdminServiceResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteSecurityRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteSecurityRequest) when calling interceptor")
+					}
+					return c.callDeleteSecurity(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AdminServiceResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AdminServiceResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *adminServiceProtobufClient) callDeleteSecurity(ctx context.Context, in *DeleteSecurityRequest) (*AdminServiceResponse, error) {
+	out := new(AdminServiceResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[8], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *adminServiceProtobufClient) ResolveMarket(ctx context.Context, in *ResolveMarketRequest) (*ResolveMarketResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "market")
+	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "ResolveMarket")
+	caller := c.callResolveMarket
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ResolveMarketRequest) (*ResolveMarketResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ResolveMarketRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ResolveMarketRequest) when calling interceptor")
+					}
+					return c.callResolveMarket(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ResolveMarketResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ResolveMarketResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *adminServiceProtobufClient) callResolveMarket(ctx context.Context, in *ResolveMarketRequest) (*ResolveMarketResponse, error) {
+	out := new(ResolveMarketResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[9], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *adminServiceProtobufClient) CreateMatchupMarkets(ctx context.Context, in *CreateMatchupMarketsRequest) (*CreateMatchupMarketsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "market")
+	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "CreateMatchupMarkets")
+	caller := c.callCreateMatchupMarkets
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CreateMatchupMarketsRequest) (*CreateMatchupMarketsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateMatchupMarketsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateMatchupMarketsRequest) when calling interceptor")
+					}
+					return c.callCreateMatchupMarkets(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateMatchupMarketsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateMatchupMarketsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *adminServiceProtobufClient) callCreateMatchupMarkets(ctx context.Context, in *CreateMatchupMarketsRequest) (*CreateMatchupMarketsResponse, error) {
+	out := new(CreateMatchupMarketsResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[10], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *adminServiceProtobufClient) ResolveMatchupMarkets(ctx context.Context, in *ResolveMatchupMarketsRequest) (*ResolveMarketResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "market")
+	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "ResolveMatchupMarkets")
+	caller := c.callResolveMatchupMarkets
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ResolveMatchupMarketsRequest) (*ResolveMarketResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ResolveMatchupMarketsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ResolveMatchupMarketsRequest) when calling interceptor")
+					}
+					return c.callResolveMatchupMarkets(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ResolveMarketResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ResolveMarketResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *adminServiceProtobufClient) callResolveMatchupMarkets(ctx context.Context, in *ResolveMatchupMarketsRequest) (*ResolveMarketResponse, error) {
+	out := new(ResolveMarketResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[11], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *adminServiceProtobufClient) SubmitGameResults(ctx context.Context, in *SubmitGameResultsRequest) (*SubmitGameResultsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "market")
+	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "SubmitGameResults")
+	caller := c.callSubmitGameResults
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SubmitGameResultsRequest) (*SubmitGameResultsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SubmitGameResultsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SubmitGameResultsRequest) when calling interceptor")
+					}
+					return c.callSubmitGameResults(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SubmitGameResultsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SubmitGameResultsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *adminServiceProtobufClient) callSubmitGameResults(ctx context.Context, in *SubmitGameResultsRequest) (*SubmitGameResultsResponse, error) {
+	out := new(SubmitGameResultsResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[12], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *adminServiceProtobufClient) GrantTokens(ctx context.Context, in *GrantTokensRequest) (*AdminServiceResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "market")
+	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "GrantTokens")
+	caller := c.callGrantTokens
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GrantTokensRequest) (*AdminServiceResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GrantTokensRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GrantTokensRequest) when calling interceptor")
+					}
+					return c.callGrantTokens(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AdminServiceResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AdminServiceResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *adminServiceProtobufClient) callGrantTokens(ctx context.Context, in *GrantTokensRequest) (*AdminServiceResponse, error) {
+	out := new(AdminServiceResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[13], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *adminServiceProtobufClient) Audit(ctx context.Context, in *AuditRequest) (*AuditResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "market")
+	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "Audit")
+	caller := c.callAudit
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *AuditRequest) (*AuditResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AuditRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AuditRequest) when calling interceptor")
+					}
+					return c.callAudit(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AuditResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AuditResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *adminServiceProtobufClient) callAudit(ctx context.Context, in *AuditRequest) (*AuditResponse, error) {
+	out := new(AuditResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[14], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ========================
+// AdminService JSON Client
+// ========================
+
+type adminServiceJSONClient struct {
+	client      HTTPClient
+	urls        [15]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewAdminServiceJSONClient creates a JSON client that implements the AdminService interface.
+// It communicates using JSON and can be configured with a custom HTTPClient.
+func NewAdminServiceJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) AdminService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwads compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "market", "AdminService")
+	urls := [15]string{
+		serviceURL + "CreateMarket",
+		serviceURL + "OpenMarket",
+		serviceURL + "CloseMarket",
+		serviceURL + "HaltMarket",
+		serviceURL + "VoidMarket",
+		serviceURL + "ScheduleMarket",
+		serviceURL + "DeleteMarket",
+		serviceURL + "AddSecurities",
+		serviceURL + "DeleteSecurity",
+		serviceURL + "ResolveMarket",
+		serviceURL + "CreateMatchupMarkets",
+		serviceURL + "ResolveMatchupMarkets",
+		serviceURL + "SubmitGameResults",
+		serviceURL + "GrantTokens",
+		serviceURL + "Audit",
+	}
+
+	return &adminServiceJSONClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *adminServiceJSONClient) CreateMarket(ctx context.Context, in *CreateMarketRequest) (*CreateMarketResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "market")
+	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "CreateMarket")
+	caller := c.callCreateMarket
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CreateMarketRequest) (*CreateMarketResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateMarketRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateMarketRequest) when calling interceptor")
+					}
+					return c.callCreateMarket(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateMarketResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateMarketResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *adminServiceJSONClient) callCreateMarket(ctx context.Context, in *CreateMarketRequest) (*CreateMarketResponse, error) {
+	out := new(CreateMarketResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *adminServiceJSONClient) OpenMarket(ctx context.Context, in *OpenMarketRequest) (*AdminServiceResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "market")
+	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "OpenMarket")
+	caller := c.callOpenMarket
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *OpenMarketRequest) (*AdminServiceResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*OpenMarketRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*OpenMarketRequest) when calling interceptor")
+					}
+					return c.callOpenMarket(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AdminServiceResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AdminServiceResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *adminServiceJSONClient) callOpenMarket(ctx context.Context, in *OpenMarketRequest) (*AdminServiceResponse, error) {
+	out := new(AdminServiceResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *adminServiceJSONClient) CloseMarket(ctx context.Context, in *CloseMarketRequest) (*AdminServiceResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "market")
+	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "CloseMarket")
+	caller := c.callCloseMarket
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CloseMarketRequest) (*AdminServiceResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CloseMarketRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CloseMarketRequest) when calling interceptor")
+					}
+					return c.callCloseMarket(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AdminServiceResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AdminServiceResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *adminServiceJSONClient) callCloseMarket(ctx context.Context, in *CloseMarketRequest) (*AdminServiceResponse, error) {
+	out := new(AdminServiceResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *adminServiceJSONClient) HaltMarket(ctx context.Context, in *HaltMarketRequest) (*AdminServiceResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "market")
+	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "HaltMarket")
+	caller := c.callHaltMarket
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *HaltMarketRequest) (*AdminServiceResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*HaltMarketRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*HaltMarketRequest) when calling interceptor")
+					}
+					return c.callHaltMarket(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AdminServiceResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AdminServiceResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *adminServiceJSONClient) callHaltMarket(ctx context.Context, in *HaltMarketRequest) (*AdminServiceResponse, error) {
+	out := new(AdminServiceResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *adminServiceJSONClient) VoidMarket(ctx context.Context, in *VoidMarketRequest) (*AdminServiceResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "market")
+	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "VoidMarket")
+	caller := c.callVoidMarket
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *VoidMarketRequest) (*AdminServiceResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*VoidMarketRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*VoidMarketRequest) when calling interceptor")
+					}
+					return c.callVoidMarket(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AdminServiceResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AdminServiceResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *adminServiceJSONClient) callVoidMarket(ctx context.Context, in *VoidMarketRequest) (*AdminServiceResponse, error) {
+	out := new(AdminServiceResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *adminServiceJSONClient) ScheduleMarket(ctx context.Context, in *ScheduleMarketRequest) (*AdminServiceResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "market")
+	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "ScheduleMarket")
+	caller := c.callScheduleMarket
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ScheduleMarketRequest) (*AdminServiceResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ScheduleMarketRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ScheduleMarketRequest) when calling interceptor")
+					}
+					return c.callScheduleMarket(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AdminServiceResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AdminServiceResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *adminServiceJSONClient) callScheduleMarket(ctx context.Context, in *ScheduleMarketRequest) (*AdminServiceResponse, error) {
+	out := new(AdminServiceResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *adminServiceJSONClient) DeleteMarket(ctx context.Context, in *DeleteMarketRequest) (*AdminServiceResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "market")
+	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteMarket")
+	caller := c.callDeleteMarket
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *DeleteMarketRequest) (*AdminServiceResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteMarketRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteMarketRequest) when calling interceptor")
+					}
+					return c.callDeleteMarket(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AdminServiceResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AdminServiceResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *adminServiceJSONClient) callDeleteMarket(ctx context.Context, in *DeleteMarketRequest) (*AdminServiceResponse, error) {
+	out := new(AdminServiceResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *adminServiceJSONClient) AddSecurities(ctx context.Context, in *AddSecuritiesRequest) (*AdminServiceResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "market")
+	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "AddSecurities")
+	caller := c.callAddSecurities
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *AddSecuritiesRequest) (*AdminServiceResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AddSecuritiesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AddSecuritiesRequest) when calling interceptor")
+					}
+					return c.callAddSecurities(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AdminServiceResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AdminServiceResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *adminServiceJSONClient) callAddSecurities(ctx context.Context, in *AddSecuritiesRequest) (*AdminServiceResponse, error) {
+	out := new(AdminServiceResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *adminServiceJSONClient) DeleteSecurity(ctx context.Context, in *DeleteSecurityRequest) (*AdminServiceResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "market")
+	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteSecurity")
+	caller := c.callDeleteSecurity
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *DeleteSecurityRequest) (*AdminServiceResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteSecurityRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteSecurityRequest) when calling interceptor")
+					}
+					return c.callDeleteSecurity(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AdminServiceResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AdminServiceResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *adminServiceJSONClient) callDeleteSecurity(ctx context.Context, in *DeleteSecurityRequest) (*AdminServiceResponse, error) {
+	out := new(AdminServiceResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[8], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *adminServiceJSONClient) ResolveMarket(ctx context.Context, in *ResolveMarketRequest) (*ResolveMarketResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "market")
+	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "ResolveMarket")
+	caller := c.callResolveMarket
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ResolveMarketRequest) (*ResolveMarketResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ResolveMarketRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ResolveMarketRequest) when calling interceptor")
+					}
+					return c.callResolveMarket(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ResolveMarketResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ResolveMarketResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *adminServiceJSONClient) callResolveMarket(ctx context.Context, in *ResolveMarketRequest) (*ResolveMarketResponse, error) {
+	out := new(ResolveMarketResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[9], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *adminServiceJSONClient) CreateMatchupMarkets(ctx context.Context, in *CreateMatchupMarketsRequest) (*CreateMatchupMarketsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "market")
+	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "CreateMatchupMarkets")
+	caller := c.callCreateMatchupMarkets
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CreateMatchupMarketsRequest) (*CreateMatchupMarketsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateMatchupMarketsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateMatchupMarketsRequest) when calling interceptor")
+					}
+					return c.callCreateMatchupMarkets(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateMatchupMarketsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateMatchupMarketsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *adminServiceJSONClient) callCreateMatchupMarkets(ctx context.Context, in *CreateMatchupMarketsRequest) (*CreateMatchupMarketsResponse, error) {
+	out := new(CreateMatchupMarketsResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[10], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *adminServiceJSONClient) ResolveMatchupMarkets(ctx context.Context, in *ResolveMatchupMarketsRequest) (*ResolveMarketResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "market")
+	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "ResolveMatchupMarkets")
+	caller := c.callResolveMatchupMarkets
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ResolveMatchupMarketsRequest) (*ResolveMarketResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ResolveMatchupMarketsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ResolveMatchupMarketsRequest) when calling interceptor")
+					}
+					return c.callResolveMatchupMarkets(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ResolveMarketResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ResolveMarketResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *adminServiceJSONClient) callResolveMatchupMarkets(ctx context.Context, in *ResolveMatchupMarketsRequest) (*ResolveMarketResponse, error) {
+	out := new(ResolveMarketResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[11], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *adminServiceJSONClient) SubmitGameResults(ctx context.Context, in *SubmitGameResultsRequest) (*SubmitGameResultsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "market")
+	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "SubmitGameResults")
+	caller := c.callSubmitGameResults
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SubmitGameResultsRequest) (*SubmitGameResultsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SubmitGameResultsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SubmitGameResultsRequest) when calling interceptor")
+					}
+					return c.callSubmitGameResults(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SubmitGameResultsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SubmitGameResultsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *adminServiceJSONClient) callSubmitGameResults(ctx context.Context, in *SubmitGameResultsRequest) (*SubmitGameResultsResponse, error) {
+	out := new(SubmitGameResultsResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[12], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *adminServiceJSONClient) GrantTokens(ctx context.Context, in *GrantTokensRequest) (*AdminServiceResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "market")
+	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "GrantTokens")
+	caller := c.callGrantTokens
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GrantTokensRequest) (*AdminServiceResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GrantTokensRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GrantTokensRequest) when calling interceptor")
+					}
+					return c.callGrantTokens(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AdminServiceResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AdminServiceResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *adminServiceJSONClient) callGrantTokens(ctx context.Context, in *GrantTokensRequest) (*AdminServiceResponse, error) {
+	out := new(AdminServiceResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[13], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *adminServiceJSONClient) Audit(ctx context.Context, in *AuditRequest) (*AuditResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "market")
+	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "Audit")
+	caller := c.callAudit
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *AuditRequest) (*AuditResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AuditRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AuditRequest) when calling interceptor")
+					}
+					return c.callAudit(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AuditResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AuditResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *adminServiceJSONClient) callAudit(ctx context.Context, in *AuditRequest) (*AuditResponse, error) {
+	out := new(AuditResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[14], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ===========================
+// AdminService Server Handler
+// ===========================
+
+type adminServiceServer struct {
+	AdminService
+	interceptor      twirp.Interceptor
+	hooks            *twirp.ServerHooks
+	pathPrefix       string // prefix for routing
+	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
+	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
+}
+
+// NewAdminServiceServer builds a TwirpServer that can be used as an http.Handler to handle
+// HTTP requests that are routed to the right method in the provided svc implementation.
+// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
+func NewAdminServiceServer(svc AdminService, opts ...interface{}) TwirpServer {
+	serverOpts := newServerOpts(opts)
+
+	// Using ReadOpt allows backwards and forwads compatibility with new options in the future
+	jsonSkipDefaults := false
+	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
+	jsonCamelCase := false
+	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
+	var pathPrefix string
+	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	return &adminServiceServer{
+		AdminService:     svc,
+		hooks:            serverOpts.Hooks,
+		interceptor:      twirp.ChainInterceptors(serverOpts.Interceptors...),
+		pathPrefix:       pathPrefix,
+		jsonSkipDefaults: jsonSkipDefaults,
+		jsonCamelCase:    jsonCamelCase,
+	}
+}
+
+// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
+// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
+func (s *adminServiceServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
+	writeError(ctx, resp, err, s.hooks)
+}
+
+// handleRequestBodyError is used to handle error when the twirp server cannot read request
+func (s *adminServiceServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
+	if context.Canceled == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
+		return
+	}
+	if context.DeadlineExceeded == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
+		return
+	}
+	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
+}
+
+// AdminServicePathPrefix is a convenience constant that may identify URL paths.
+// Should be used with caution, it only matches routes generated by Twirp Go clients,
+// with the default "/twirp" prefix and default CamelCase service and method names.
+// More info: https://twitchtv.github.io/twirp/docs/routing.html
+const AdminServicePathPrefix = "/twirp/market.AdminService/"
+
+func (s *adminServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	ctx = ctxsetters.WithPackageName(ctx, "market")
+	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
+	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+
+	var err error
+	ctx, err = callRequestReceived(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	if req.Method != "POST" {
+		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
+	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
+	if pkgService != "market.AdminService" {
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+	if prefix != s.pathPrefix {
+		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	switch method {
+	case "CreateMarket":
+		s.serveCreateMarket(ctx, resp, req)
+		return
+	case "OpenMarket":
+		s.serveOpenMarket(ctx, resp, req)
+		return
+	case "CloseMarket":
+		s.serveCloseMarket(ctx, resp, req)
+		return
+	case "HaltMarket":
+		s.serveHaltMarket(ctx, resp, req)
+		return
+	case "VoidMarket":
+		s.serveVoidMarket(ctx, resp, req)
+		return
+	case "ScheduleMarket":
+		s.serveScheduleMarket(ctx, resp, req)
+		return
+	case "DeleteMarket":
+		s.serveDeleteMarket(ctx, resp, req)
+		return
+	case "AddSecurities":
+		s.serveAddSecurities(ctx, resp, req)
+		return
+	case "DeleteSecurity":
+		s.serveDeleteSecurity(ctx, resp, req)
+		return
+	case "ResolveMarket":
+		s.serveResolveMarket(ctx, resp, req)
+		return
+	case "CreateMatchupMarkets":
+		s.serveCreateMatchupMarkets(ctx, resp, req)
+		return
+	case "ResolveMatchupMarkets":
+		s.serveResolveMatchupMarkets(ctx, resp, req)
+		return
+	case "SubmitGameResults":
+		s.serveSubmitGameResults(ctx, resp, req)
+		return
+	case "GrantTokens":
+		s.serveGrantTokens(ctx, resp, req)
+		return
+	case "Audit":
+		s.serveAudit(ctx, resp, req)
+		return
+	default:
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+}
+
+func (s *adminServiceServer) serveCreateMarket(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveCreateMarketJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveCreateMarketProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *adminServiceServer) serveCreateMarketJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CreateMarket")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(CreateMarketRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.AdminService.CreateMarket
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CreateMarketRequest) (*CreateMarketResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateMarketRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateMarketRequest) when calling interceptor")
+					}
+					return s.AdminService.CreateMarket(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateMarketResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateMarketResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CreateMarketResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateMarketResponse and nil error while calling CreateMarket. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *adminServiceServer) serveCreateMarketProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CreateMarket")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(CreateMarketRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.AdminService.CreateMarket
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CreateMarketRequest) (*CreateMarketResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateMarketRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateMarketRequest) when calling interceptor")
+					}
+					return s.AdminService.CreateMarket(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateMarketResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateMarketResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CreateMarketResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateMarketResponse and nil error while calling CreateMarket. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *adminServiceServer) serveOpenMarket(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveOpenMarketJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveOpenMarketProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *adminServiceServer) serveOpenMarketJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "OpenMarket")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(OpenMarketRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.AdminService.OpenMarket
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *OpenMarketRequest) (*AdminServiceResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*OpenMarketRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*OpenMarketRequest) when calling interceptor")
+					}
+					return s.AdminService.OpenMarket(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AdminServiceResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AdminServiceResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *AdminServiceResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *AdminServiceResponse and nil error while calling OpenMarket. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *adminServiceServer) serveOpenMarketProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "OpenMarket")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(OpenMarketRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.AdminService.OpenMarket
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *OpenMarketRequest) (*AdminServiceResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*OpenMarketRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*OpenMarketRequest) when calling interceptor")
+					}
+					return s.AdminService.OpenMarket(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AdminServiceResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AdminServiceResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *AdminServiceResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *AdminServiceResponse and nil error while calling OpenMarket. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *adminServiceServer) serveCloseMarket(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveCloseMarketJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveCloseMarketProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *adminServiceServer) serveCloseMarketJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CloseMarket")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(CloseMarketRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.AdminService.CloseMarket
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CloseMarketRequest) (*AdminServiceResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CloseMarketRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CloseMarketRequest) when calling interceptor")
+					}
+					return s.AdminService.CloseMarket(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AdminServiceResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AdminServiceResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *AdminServiceResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *AdminServiceResponse and nil error while calling CloseMarket. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *adminServiceServer) serveCloseMarketProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CloseMarket")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(CloseMarketRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.AdminService.CloseMarket
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CloseMarketRequest) (*AdminServiceResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CloseMarketRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CloseMarketRequest) when calling interceptor")
+					}
+					return s.AdminService.CloseMarket(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AdminServiceResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AdminServiceResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *AdminServiceResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *AdminServiceResponse and nil error while calling CloseMarket. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *adminServiceServer) serveHaltMarket(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveHaltMarketJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveHaltMarketProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *adminServiceServer) serveHaltMarketJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "HaltMarket")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(HaltMarketRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.AdminService.HaltMarket
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *HaltMarketRequest) (*AdminServiceResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*HaltMarketRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*HaltMarketRequest) when calling interceptor")
+					}
+					return s.AdminService.HaltMarket(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AdminServiceResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AdminServiceResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *AdminServiceResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *AdminServiceResponse and nil error while calling HaltMarket. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *adminServiceServer) serveHaltMarketProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "HaltMarket")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(HaltMarketRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.AdminService.HaltMarket
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *HaltMarketRequest) (*AdminServiceResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*HaltMarketRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*HaltMarketRequest) when calling interceptor")
+					}
+					return s.AdminService.HaltMarket(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AdminServiceResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AdminServiceResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *AdminServiceResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *AdminServiceResponse and nil error while calling HaltMarket. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *adminServiceServer) serveVoidMarket(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveVoidMarketJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveVoidMarketProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *adminServiceServer) serveVoidMarketJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "VoidMarket")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(VoidMarketRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.AdminService.VoidMarket
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *VoidMarketRequest) (*AdminServiceResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*VoidMarketRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*VoidMarketRequest) when calling interceptor")
+					}
+					return s.AdminService.VoidMarket(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AdminServiceResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AdminServiceResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *AdminServiceResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *AdminServiceResponse and nil error while calling VoidMarket. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *adminServiceServer) serveVoidMarketProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "VoidMarket")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(VoidMarketRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.AdminService.VoidMarket
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *VoidMarketRequest) (*AdminServiceResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*VoidMarketRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*VoidMarketRequest) when calling interceptor")
+					}
+					return s.AdminService.VoidMarket(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AdminServiceResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AdminServiceResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *AdminServiceResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *AdminServiceResponse and nil error while calling VoidMarket. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *adminServiceServer) serveScheduleMarket(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveScheduleMarketJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveScheduleMarketProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *adminServiceServer) serveScheduleMarketJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ScheduleMarket")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ScheduleMarketRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.AdminService.ScheduleMarket
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ScheduleMarketRequest) (*AdminServiceResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ScheduleMarketRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ScheduleMarketRequest) when calling interceptor")
+					}
+					return s.AdminService.ScheduleMarket(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AdminServiceResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AdminServiceResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *AdminServiceResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *AdminServiceResponse and nil error while calling ScheduleMarket. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *adminServiceServer) serveScheduleMarketProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ScheduleMarket")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ScheduleMarketRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.AdminService.ScheduleMarket
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ScheduleMarketRequest) (*AdminServiceResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ScheduleMarketRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ScheduleMarketRequest) when calling interceptor")
+					}
+					return s.AdminService.ScheduleMarket(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AdminServiceResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AdminServiceResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *AdminServiceResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *AdminServiceResponse and nil error while calling ScheduleMarket. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *adminServiceServer) serveDeleteMarket(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveDeleteMarketJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveDeleteMarketProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *adminServiceServer) serveDeleteMarketJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteMarket")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(DeleteMarketRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.AdminService.DeleteMarket
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *DeleteMarketRequest) (*AdminServiceResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteMarketRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteMarketRequest) when calling interceptor")
+					}
+					return s.AdminService.DeleteMarket(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AdminServiceResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AdminServiceResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *AdminServiceResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *AdminServiceResponse and nil error while calling DeleteMarket. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *adminServiceServer) serveDeleteMarketProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteMarket")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(DeleteMarketRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.AdminService.DeleteMarket
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *DeleteMarketRequest) (*AdminServiceResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteMarketRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteMarketRequest) when calling interceptor")
+					}
+					return s.AdminService.DeleteMarket(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AdminServiceResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AdminServiceResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *AdminServiceResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *AdminServiceResponse and nil error while calling DeleteMarket. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *adminServiceServer) serveAddSecurities(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveAddSecuritiesJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveAddSecuritiesProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *adminServiceServer) serveAddSecuritiesJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "AddSecurities")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(AddSecuritiesRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.AdminService.AddSecurities
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *AddSecuritiesRequest) (*AdminServiceResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AddSecuritiesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AddSecuritiesRequest) when calling interceptor")
+					}
+					return s.AdminService.AddSecurities(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AdminServiceResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AdminServiceResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *AdminServiceResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *AdminServiceResponse and nil error while calling AddSecurities. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *adminServiceServer) serveAddSecuritiesProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "AddSecurities")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(AddSecuritiesRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.AdminService.AddSecurities
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *AddSecuritiesRequest) (*AdminServiceResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AddSecuritiesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AddSecuritiesRequest) when calling interceptor")
+					}
+					return s.AdminService.AddSecurities(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AdminServiceResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AdminServiceResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *AdminServiceResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *AdminServiceResponse and nil error while calling AddSecurities. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *adminServiceServer) serveDeleteSecurity(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveDeleteSecurityJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveDeleteSecurityProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *adminServiceServer) serveDeleteSecurityJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteSecurity")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(DeleteSecurityRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.AdminService.DeleteSecurity
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *DeleteSecurityRequest) (*AdminServiceResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteSecurityRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteSecurityRequest) when calling interceptor")
+					}
+					return s.AdminService.DeleteSecurity(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AdminServiceResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AdminServiceResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *AdminServiceResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *AdminServiceResponse and nil error while calling DeleteSecurity. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *adminServiceServer) serveDeleteSecurityProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteSecurity")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(DeleteSecurityRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.AdminService.DeleteSecurity
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *DeleteSecurityRequest) (*AdminServiceResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteSecurityRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteSecurityRequest) when calling interceptor")
+					}
+					return s.AdminService.DeleteSecurity(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AdminServiceResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AdminServiceResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *AdminServiceResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
 
-	var err error
-	ctx, err = callRequestReceived(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
 		return
 	}
-
-	if req.Method != "POST" {
-		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *AdminServiceResponse and nil error while calling DeleteSecurity. nil responses are not supported"))
 		return
 	}
 
-	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
-	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
-	if pkgService != "market.AdminService" {
-		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
-		return
-	}
-	if prefix != s.pathPrefix {
-		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
 		return
 	}
 
-	switch method {
-	case "CreateMarket":
-		s.serveCreateMarket(ctx, resp, req)
-		return
-	case "OpenMarket":
-		s.serveOpenMarket(ctx, resp, req)
-		return
-	case "DeleteMarket":
-		s.serveDeleteMarket(ctx, resp, req)
-		return
-	case "AddSecurities":
-		s.serveAddSecurities(ctx, resp, req)
-		return
-	case "DeleteSecurity":
-		s.serveDeleteSecurity(ctx, resp, req)
-		return
-	case "ResolveMarket":
-		s.serveResolveMarket(ctx, resp, req)
-		return
-	default:
-		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
-		return
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
 	}
+	callResponseSent(ctx, s.hooks)
 }
 
-func (s *adminServiceServer) serveCreateMarket(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *adminServiceServer) serveResolveMarket(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -2722,9 +7200,9 @@ func (s *adminServiceServer) serveCreateMarket(ctx context.Context, resp http.Re
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveCreateMarketJSON(ctx, resp, req)
+		s.serveResolveMarketJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveCreateMarketProtobuf(ctx, resp, req)
+		s.serveResolveMarketProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -2732,9 +7210,9 @@ func (s *adminServiceServer) serveCreateMarket(ctx context.Context, resp http.Re
 	}
 }
 
-func (s *adminServiceServer) serveCreateMarketJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *adminServiceServer) serveResolveMarketJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "CreateMarket")
+	ctx = ctxsetters.WithMethodName(ctx, "ResolveMarket")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -2747,29 +7225,29 @@ func (s *adminServiceServer) serveCreateMarketJSON(ctx context.Context, resp htt
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(CreateMarketRequest)
+	reqContent := new(ResolveMarketRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.AdminService.CreateMarket
+	handler := s.AdminService.ResolveMarket
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *CreateMarketRequest) (*CreateMarketResponse, error) {
+		handler = func(ctx context.Context, req *ResolveMarketRequest) (*ResolveMarketResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*CreateMarketRequest)
+					typedReq, ok := req.(*ResolveMarketRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*CreateMarketRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ResolveMarketRequest) when calling interceptor")
 					}
-					return s.AdminService.CreateMarket(ctx, typedReq)
+					return s.AdminService.ResolveMarket(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*CreateMarketResponse)
+				typedResp, ok := resp.(*ResolveMarketResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*CreateMarketResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ResolveMarketResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -2778,7 +7256,7 @@ func (s *adminServiceServer) serveCreateMarketJSON(ctx context.Context, resp htt
 	}
 
 	// Call service method
-	var respContent *CreateMarketResponse
+	var respContent *ResolveMarketResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -2789,7 +7267,7 @@ func (s *adminServiceServer) serveCreateMarketJSON(ctx context.Context, resp htt
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateMarketResponse and nil error while calling CreateMarket. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ResolveMarketResponse and nil error while calling ResolveMarket. nil responses are not supported"))
 		return
 	}
 
@@ -2815,9 +7293,9 @@ func (s *adminServiceServer) serveCreateMarketJSON(ctx context.Context, resp htt
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *adminServiceServer) serveCreateMarketProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *adminServiceServer) serveResolveMarketProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "CreateMarket")
+	ctx = ctxsetters.WithMethodName(ctx, "ResolveMarket")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -2829,28 +7307,28 @@ func (s *adminServiceServer) serveCreateMarketProtobuf(ctx context.Context, resp
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(CreateMarketRequest)
+	reqContent := new(ResolveMarketRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.AdminService.CreateMarket
+	handler := s.AdminService.ResolveMarket
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *CreateMarketRequest) (*CreateMarketResponse, error) {
+		handler = func(ctx context.Context, req *ResolveMarketRequest) (*ResolveMarketResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*CreateMarketRequest)
+					typedReq, ok := req.(*ResolveMarketRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*CreateMarketRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ResolveMarketRequest) when calling interceptor")
 					}
-					return s.AdminService.CreateMarket(ctx, typedReq)
+					return s.AdminService.ResolveMarket(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*CreateMarketResponse)
+				typedResp, ok := resp.(*ResolveMarketResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*CreateMarketResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ResolveMarketResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -2859,7 +7337,7 @@ func (s *adminServiceServer) serveCreateMarketProtobuf(ctx context.Context, resp
 	}
 
 	// Call service method
-	var respContent *CreateMarketResponse
+	var respContent *ResolveMarketResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -2870,7 +7348,7 @@ func (s *adminServiceServer) serveCreateMarketProtobuf(ctx context.Context, resp
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateMarketResponse and nil error while calling CreateMarket. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ResolveMarketResponse and nil error while calling ResolveMarket. nil responses are not supported"))
 		return
 	}
 
@@ -2894,7 +7372,7 @@ func (s *adminServiceServer) serveCreateMarketProtobuf(ctx context.Context, resp
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *adminServiceServer) serveOpenMarket(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *adminServiceServer) serveCreateMatchupMarkets(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -2902,9 +7380,9 @@ func (s *adminServiceServer) serveOpenMarket(ctx context.Context, resp http.Resp
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveOpenMarketJSON(ctx, resp, req)
+		s.serveCreateMatchupMarketsJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveOpenMarketProtobuf(ctx, resp, req)
+		s.serveCreateMatchupMarketsProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -2912,9 +7390,9 @@ func (s *adminServiceServer) serveOpenMarket(ctx context.Context, resp http.Resp
 	}
 }
 
-func (s *adminServiceServer) serveOpenMarketJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *adminServiceServer) serveCreateMatchupMarketsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "OpenMarket")
+	ctx = ctxsetters.WithMethodName(ctx, "CreateMatchupMarkets")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -2927,29 +7405,29 @@ func (s *adminServiceServer) serveOpenMarketJSON(ctx context.Context, resp http.
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(OpenMarketRequest)
+	reqContent := new(CreateMatchupMarketsRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.AdminService.OpenMarket
+	handler := s.AdminService.CreateMatchupMarkets
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *OpenMarketRequest) (*AdminServiceResponse, error) {
+		handler = func(ctx context.Context, req *CreateMatchupMarketsRequest) (*CreateMatchupMarketsResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*OpenMarketRequest)
+					typedReq, ok := req.(*CreateMatchupMarketsRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*OpenMarketRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*CreateMatchupMarketsRequest) when calling interceptor")
 					}
-					return s.AdminService.OpenMarket(ctx, typedReq)
+					return s.AdminService.CreateMatchupMarkets(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*AdminServiceResponse)
+				typedResp, ok := resp.(*CreateMatchupMarketsResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*AdminServiceResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateMatchupMarketsResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -2958,7 +7436,7 @@ func (s *adminServiceServer) serveOpenMarketJSON(ctx context.Context, resp http.
 	}
 
 	// Call service method
-	var respContent *AdminServiceResponse
+	var respContent *CreateMatchupMarketsResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -2969,7 +7447,7 @@ func (s *adminServiceServer) serveOpenMarketJSON(ctx context.Context, resp http.
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *AdminServiceResponse and nil error while calling OpenMarket. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateMatchupMarketsResponse and nil error while calling CreateMatchupMarkets. nil responses are not supported"))
 		return
 	}
 
@@ -2995,9 +7473,9 @@ func (s *adminServiceServer) serveOpenMarketJSON(ctx context.Context, resp http.
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *adminServiceServer) serveOpenMarketProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *adminServiceServer) serveCreateMatchupMarketsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "OpenMarket")
+	ctx = ctxsetters.WithMethodName(ctx, "CreateMatchupMarkets")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -3009,28 +7487,28 @@ func (s *adminServiceServer) serveOpenMarketProtobuf(ctx context.Context, resp h
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(OpenMarketRequest)
+	reqContent := new(CreateMatchupMarketsRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.AdminService.OpenMarket
+	handler := s.AdminService.CreateMatchupMarkets
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *OpenMarketRequest) (*AdminServiceResponse, error) {
+		handler = func(ctx context.Context, req *CreateMatchupMarketsRequest) (*CreateMatchupMarketsResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*OpenMarketRequest)
+					typedReq, ok := req.(*CreateMatchupMarketsRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*OpenMarketRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*CreateMatchupMarketsRequest) when calling interceptor")
 					}
-					return s.AdminService.OpenMarket(ctx, typedReq)
+					return s.AdminService.CreateMatchupMarkets(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*AdminServiceResponse)
+				typedResp, ok := resp.(*CreateMatchupMarketsResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*AdminServiceResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateMatchupMarketsResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -3039,7 +7517,7 @@ func (s *adminServiceServer) serveOpenMarketProtobuf(ctx context.Context, resp h
 	}
 
 	// Call service method
-	var respContent *AdminServiceResponse
+	var respContent *CreateMatchupMarketsResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -3050,7 +7528,7 @@ func (s *adminServiceServer) serveOpenMarketProtobuf(ctx context.Context, resp h
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *AdminServiceResponse and nil error while calling OpenMarket. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateMatchupMarketsResponse and nil error while calling CreateMatchupMarkets. nil responses are not supported"))
 		return
 	}
 
@@ -3074,7 +7552,7 @@ func (s *adminServiceServer) serveOpenMarketProtobuf(ctx context.Context, resp h
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *adminServiceServer) serveDeleteMarket(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *adminServiceServer) serveResolveMatchupMarkets(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -3082,9 +7560,9 @@ func (s *adminServiceServer) serveDeleteMarket(ctx context.Context, resp http.Re
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveDeleteMarketJSON(ctx, resp, req)
+		s.serveResolveMatchupMarketsJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveDeleteMarketProtobuf(ctx, resp, req)
+		s.serveResolveMatchupMarketsProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -3092,9 +7570,9 @@ func (s *adminServiceServer) serveDeleteMarket(ctx context.Context, resp http.Re
 	}
 }
 
-func (s *adminServiceServer) serveDeleteMarketJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *adminServiceServer) serveResolveMatchupMarketsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "DeleteMarket")
+	ctx = ctxsetters.WithMethodName(ctx, "ResolveMatchupMarkets")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -3107,29 +7585,29 @@ func (s *adminServiceServer) serveDeleteMarketJSON(ctx context.Context, resp htt
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(DeleteMarketRequest)
+	reqContent := new(ResolveMatchupMarketsRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.AdminService.DeleteMarket
+	handler := s.AdminService.ResolveMatchupMarkets
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *DeleteMarketRequest) (*AdminServiceResponse, error) {
+		handler = func(ctx context.Context, req *ResolveMatchupMarketsRequest) (*ResolveMarketResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*DeleteMarketRequest)
+					typedReq, ok := req.(*ResolveMatchupMarketsRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*DeleteMarketRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ResolveMatchupMarketsRequest) when calling interceptor")
 					}
-					return s.AdminService.DeleteMarket(ctx, typedReq)
+					return s.AdminService.ResolveMatchupMarkets(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*AdminServiceResponse)
+				typedResp, ok := resp.(*ResolveMarketResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*AdminServiceResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ResolveMarketResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -3138,7 +7616,7 @@ func (s *adminServiceServer) serveDeleteMarketJSON(ctx context.Context, resp htt
 	}
 
 	// Call service method
-	var respContent *AdminServiceResponse
+	var respContent *ResolveMarketResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -3149,7 +7627,7 @@ func (s *adminServiceServer) serveDeleteMarketJSON(ctx context.Context, resp htt
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *AdminServiceResponse and nil error while calling DeleteMarket. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ResolveMarketResponse and nil error while calling ResolveMatchupMarkets. nil responses are not supported"))
 		return
 	}
 
@@ -3175,9 +7653,9 @@ func (s *adminServiceServer) serveDeleteMarketJSON(ctx context.Context, resp htt
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *adminServiceServer) serveDeleteMarketProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *adminServiceServer) serveResolveMatchupMarketsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "DeleteMarket")
+	ctx = ctxsetters.WithMethodName(ctx, "ResolveMatchupMarkets")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -3189,28 +7667,28 @@ func (s *adminServiceServer) serveDeleteMarketProtobuf(ctx context.Context, resp
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(DeleteMarketRequest)
+	reqContent := new(ResolveMatchupMarketsRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.AdminService.DeleteMarket
+	handler := s.AdminService.ResolveMatchupMarkets
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *DeleteMarketRequest) (*AdminServiceResponse, error) {
+		handler = func(ctx context.Context, req *ResolveMatchupMarketsRequest) (*ResolveMarketResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*DeleteMarketRequest)
+					typedReq, ok := req.(*ResolveMatchupMarketsRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*DeleteMarketRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ResolveMatchupMarketsRequest) when calling interceptor")
 					}
-					return s.AdminService.DeleteMarket(ctx, typedReq)
+					return s.AdminService.ResolveMatchupMarkets(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*AdminServiceResponse)
+				typedResp, ok := resp.(*ResolveMarketResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*AdminServiceResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ResolveMarketResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -3219,7 +7697,7 @@ func (s *adminServiceServer) serveDeleteMarketProtobuf(ctx context.Context, resp
 	}
 
 	// Call service method
-	var respContent *AdminServiceResponse
+	var respContent *ResolveMarketResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -3230,7 +7708,7 @@ func (s *adminServiceServer) serveDeleteMarketProtobuf(ctx context.Context, resp
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *AdminServiceResponse and nil error while calling DeleteMarket. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ResolveMarketResponse and nil error while calling ResolveMatchupMarkets. nil responses are not supported"))
 		return
 	}
 
@@ -3254,7 +7732,7 @@ func (s *adminServiceServer) serveDeleteMarketProtobuf(ctx context.Context, resp
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *adminServiceServer) serveAddSecurities(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *adminServiceServer) serveSubmitGameResults(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -3262,9 +7740,9 @@ func (s *adminServiceServer) serveAddSecurities(ctx context.Context, resp http.R
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveAddSecuritiesJSON(ctx, resp, req)
+		s.serveSubmitGameResultsJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveAddSecuritiesProtobuf(ctx, resp, req)
+		s.serveSubmitGameResultsProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -3272,9 +7750,9 @@ func (s *adminServiceServer) serveAddSecurities(ctx context.Context, resp http.R
 	}
 }
 
-func (s *adminServiceServer) serveAddSecuritiesJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *adminServiceServer) serveSubmitGameResultsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "AddSecurities")
+	ctx = ctxsetters.WithMethodName(ctx, "SubmitGameResults")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -3287,29 +7765,29 @@ func (s *adminServiceServer) serveAddSecuritiesJSON(ctx context.Context, resp ht
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(AddSecuritiesRequest)
+	reqContent := new(SubmitGameResultsRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.AdminService.AddSecurities
+	handler := s.AdminService.SubmitGameResults
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *AddSecuritiesRequest) (*AdminServiceResponse, error) {
+		handler = func(ctx context.Context, req *SubmitGameResultsRequest) (*SubmitGameResultsResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*AddSecuritiesRequest)
+					typedReq, ok := req.(*SubmitGameResultsRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*AddSecuritiesRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*SubmitGameResultsRequest) when calling interceptor")
 					}
-					return s.AdminService.AddSecurities(ctx, typedReq)
+					return s.AdminService.SubmitGameResults(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*AdminServiceResponse)
+				typedResp, ok := resp.(*SubmitGameResultsResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*AdminServiceResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*SubmitGameResultsResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -3318,7 +7796,7 @@ func (s *adminServiceServer) serveAddSecuritiesJSON(ctx context.Context, resp ht
 	}
 
 	// Call service method
-	var respContent *AdminServiceResponse
+	var respContent *SubmitGameResultsResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -3329,7 +7807,7 @@ func (s *adminServiceServer) serveAddSecuritiesJSON(ctx context.Context, resp ht
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *AdminServiceResponse and nil error while calling AddSecurities. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SubmitGameResultsResponse and nil error while calling SubmitGameResults. nil responses are not supported"))
 		return
 	}
 
@@ -3355,9 +7833,9 @@ func (s *adminServiceServer) serveAddSecuritiesJSON(ctx context.Context, resp ht
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *adminServiceServer) serveAddSecuritiesProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *adminServiceServer) serveSubmitGameResultsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "AddSecurities")
+	ctx = ctxsetters.WithMethodName(ctx, "SubmitGameResults")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -3369,28 +7847,28 @@ func (s *adminServiceServer) serveAddSecuritiesProtobuf(ctx context.Context, res
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(AddSecuritiesRequest)
+	reqContent := new(SubmitGameResultsRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.AdminService.AddSecurities
+	handler := s.AdminService.SubmitGameResults
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *AddSecuritiesRequest) (*AdminServiceResponse, error) {
+		handler = func(ctx context.Context, req *SubmitGameResultsRequest) (*SubmitGameResultsResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*AddSecuritiesRequest)
+					typedReq, ok := req.(*SubmitGameResultsRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*AddSecuritiesRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*SubmitGameResultsRequest) when calling interceptor")
 					}
-					return s.AdminService.AddSecurities(ctx, typedReq)
+					return s.AdminService.SubmitGameResults(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*AdminServiceResponse)
+				typedResp, ok := resp.(*SubmitGameResultsResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*AdminServiceResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*SubmitGameResultsResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -3399,7 +7877,7 @@ func (s *adminServiceServer) serveAddSecuritiesProtobuf(ctx context.Context, res
 	}
 
 	// Call service method
-	var respContent *AdminServiceResponse
+	var respContent *SubmitGameResultsResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -3410,7 +7888,7 @@ func (s *adminServiceServer) serveAddSecuritiesProtobuf(ctx context.Context, res
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *AdminServiceResponse and nil error while calling AddSecurities. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SubmitGameResultsResponse and nil error while calling SubmitGameResults. nil responses are not supported"))
 		return
 	}
 
@@ -3434,7 +7912,7 @@ func (s *adminServiceServer) serveAddSecuritiesProtobuf(ctx context.Context, res
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *adminServiceServer) serveDeleteSecurity(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *adminServiceServer) serveGrantTokens(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -3442,9 +7920,9 @@ func (s *adminServiceServer) serveDeleteSecurity(ctx context.Context, resp http.
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveDeleteSecurityJSON(ctx, resp, req)
+		s.serveGrantTokensJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveDeleteSecurityProtobuf(ctx, resp, req)
+		s.serveGrantTokensProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -3452,9 +7930,9 @@ func (s *adminServiceServer) serveDeleteSecurity(ctx context.Context, resp http.
 	}
 }
 
-func (s *adminServiceServer) serveDeleteSecurityJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *adminServiceServer) serveGrantTokensJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "DeleteSecurity")
+	ctx = ctxsetters.WithMethodName(ctx, "GrantTokens")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -3467,23 +7945,23 @@ func (s *adminServiceServer) serveDeleteSecurityJSON(ctx context.Context, resp h
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(DeleteSecurityRequest)
+	reqContent := new(GrantTokensRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.AdminService.DeleteSecurity
+	handler := s.AdminService.GrantTokens
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *DeleteSecurityRequest) (*AdminServiceResponse, error) {
+		handler = func(ctx context.Context, req *GrantTokensRequest) (*AdminServiceResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*DeleteSecurityRequest)
+					typedReq, ok := req.(*GrantTokensRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*DeleteSecurityRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*GrantTokensRequest) when calling interceptor")
 					}
-					return s.AdminService.DeleteSecurity(ctx, typedReq)
+					return s.AdminService.GrantTokens(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
@@ -3509,7 +7987,7 @@ func (s *adminServiceServer) serveDeleteSecurityJSON(ctx context.Context, resp h
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *AdminServiceResponse and nil error while calling DeleteSecurity. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *AdminServiceResponse and nil error while calling GrantTokens. nil responses are not supported"))
 		return
 	}
 
@@ -3535,9 +8013,9 @@ func (s *adminServiceServer) serveDeleteSecurityJSON(ctx context.Context, resp h
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *adminServiceServer) serveDeleteSecurityProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *adminServiceServer) serveGrantTokensProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "DeleteSecurity")
+	ctx = ctxsetters.WithMethodName(ctx, "GrantTokens")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -3549,22 +8027,22 @@ func (s *adminServiceServer) serveDeleteSecurityProtobuf(ctx context.Context, re
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(DeleteSecurityRequest)
+	reqContent := new(GrantTokensRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.AdminService.DeleteSecurity
+	handler := s.AdminService.GrantTokens
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *DeleteSecurityRequest) (*AdminServiceResponse, error) {
+		handler = func(ctx context.Context, req *GrantTokensRequest) (*AdminServiceResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*DeleteSecurityRequest)
+					typedReq, ok := req.(*GrantTokensRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*DeleteSecurityRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*GrantTokensRequest) when calling interceptor")
 					}
-					return s.AdminService.DeleteSecurity(ctx, typedReq)
+					return s.AdminService.GrantTokens(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
@@ -3590,7 +8068,7 @@ func (s *adminServiceServer) serveDeleteSecurityProtobuf(ctx context.Context, re
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *AdminServiceResponse and nil error while calling DeleteSecurity. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *AdminServiceResponse and nil error while calling GrantTokens. nil responses are not supported"))
 		return
 	}
 
@@ -3614,7 +8092,7 @@ func (s *adminServiceServer) serveDeleteSecurityProtobuf(ctx context.Context, re
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *adminServiceServer) serveResolveMarket(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *adminServiceServer) serveAudit(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -3622,9 +8100,9 @@ func (s *adminServiceServer) serveResolveMarket(ctx context.Context, resp http.R
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveResolveMarketJSON(ctx, resp, req)
+		s.serveAuditJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveResolveMarketProtobuf(ctx, resp, req)
+		s.serveAuditProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -3632,9 +8110,9 @@ func (s *adminServiceServer) serveResolveMarket(ctx context.Context, resp http.R
 	}
 }
 
-func (s *adminServiceServer) serveResolveMarketJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *adminServiceServer) serveAuditJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "ResolveMarket")
+	ctx = ctxsetters.WithMethodName(ctx, "Audit")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -3647,29 +8125,29 @@ func (s *adminServiceServer) serveResolveMarketJSON(ctx context.Context, resp ht
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(ResolveMarketRequest)
+	reqContent := new(AuditRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.AdminService.ResolveMarket
+	handler := s.AdminService.Audit
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *ResolveMarketRequest) (*ResolveMarketResponse, error) {
+		handler = func(ctx context.Context, req *AuditRequest) (*AuditResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*ResolveMarketRequest)
+					typedReq, ok := req.(*AuditRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*ResolveMarketRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*AuditRequest) when calling interceptor")
 					}
-					return s.AdminService.ResolveMarket(ctx, typedReq)
+					return s.AdminService.Audit(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*ResolveMarketResponse)
+				typedResp, ok := resp.(*AuditResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*ResolveMarketResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*AuditResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -3678,7 +8156,7 @@ func (s *adminServiceServer) serveResolveMarketJSON(ctx context.Context, resp ht
 	}
 
 	// Call service method
-	var respContent *ResolveMarketResponse
+	var respContent *AuditResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -3689,7 +8167,7 @@ func (s *adminServiceServer) serveResolveMarketJSON(ctx context.Context, resp ht
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *ResolveMarketResponse and nil error while calling ResolveMarket. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *AuditResponse and nil error while calling Audit. nil responses are not supported"))
 		return
 	}
 
@@ -3715,9 +8193,9 @@ func (s *adminServiceServer) serveResolveMarketJSON(ctx context.Context, resp ht
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *adminServiceServer) serveResolveMarketProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *adminServiceServer) serveAuditProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "ResolveMarket")
+	ctx = ctxsetters.WithMethodName(ctx, "Audit")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -3729,28 +8207,28 @@ func (s *adminServiceServer) serveResolveMarketProtobuf(ctx context.Context, res
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(ResolveMarketRequest)
+	reqContent := new(AuditRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.AdminService.ResolveMarket
+	handler := s.AdminService.Audit
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *ResolveMarketRequest) (*ResolveMarketResponse, error) {
+		handler = func(ctx context.Context, req *AuditRequest) (*AuditResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*ResolveMarketRequest)
+					typedReq, ok := req.(*AuditRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*ResolveMarketRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*AuditRequest) when calling interceptor")
 					}
-					return s.AdminService.ResolveMarket(ctx, typedReq)
+					return s.AdminService.Audit(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*ResolveMarketResponse)
+				typedResp, ok := resp.(*AuditResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*ResolveMarketResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*AuditResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -3759,7 +8237,7 @@ func (s *adminServiceServer) serveResolveMarketProtobuf(ctx context.Context, res
 	}
 
 	// Call service method
-	var respContent *ResolveMarketResponse
+	var respContent *AuditResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -3770,7 +8248,7 @@ func (s *adminServiceServer) serveResolveMarketProtobuf(ctx context.Context, res
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *ResolveMarketResponse and nil error while calling ResolveMarket. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *AuditResponse and nil error while calling Audit. nil responses are not supported"))
 		return
 	}
 
@@ -3932,9 +8410,12 @@ func sanitizeBaseURL(baseURL string) string {
 
 // baseServicePath composes the path prefix for the service (without <Method>).
 // e.g.: baseServicePath("/twirp", "my.pkg", "MyService")
-//       returns => "/twirp/my.pkg.MyService/"
+//
+//	returns => "/twirp/my.pkg.MyService/"
+//
 // e.g.: baseServicePath("", "", "MyService")
-//       returns => "/MyService/"
+//
+//	returns => "/MyService/"
 func baseServicePath(prefix, pkg, service string) string {
 	fullServiceName := service
 	if pkg != "" {
@@ -4372,76 +8853,232 @@ func callClientError(ctx context.Context, h *twirp.ClientHooks, err twirp.Error)
 }
 
 var twirpFileDescriptor0 = []byte{
-	// 1131 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0xdd, 0x6e, 0x1b, 0x45,
-	0x14, 0x66, 0x6c, 0xc7, 0x3f, 0xc7, 0x49, 0x48, 0x26, 0x4e, 0xe2, 0xba, 0x49, 0xeb, 0x2e, 0x0a,
-	0x8a, 0x2a, 0x1a, 0x43, 0x40, 0x20, 0x71, 0x17, 0x27, 0x21, 0x0a, 0xa4, 0x4a, 0x59, 0xab, 0x17,
-	0x70, 0x63, 0xad, 0x77, 0xa7, 0xc9, 0x28, 0xeb, 0x1d, 0x77, 0x67, 0xb6, 0x28, 0x4f, 0xc2, 0x0b,
-	0xf0, 0x2c, 0x5c, 0x70, 0x07, 0x12, 0xe2, 0x15, 0x78, 0x0c, 0xb4, 0xf3, 0xe3, 0xdd, 0x9d, 0x75,
-	0x6a, 0x04, 0x57, 0xdd, 0x39, 0x73, 0xe6, 0xf8, 0xfb, 0xbe, 0x73, 0xe6, 0x9b, 0x06, 0xf0, 0x2c,
-	0x66, 0x82, 0x0d, 0xa6, 0x5e, 0x7c, 0x47, 0xc4, 0x91, 0x5c, 0xe0, 0xba, 0x5a, 0x39, 0x3f, 0x23,
-	0xa8, 0xbf, 0x94, 0x9f, 0x78, 0x1d, 0x2a, 0x34, 0xe8, 0xa2, 0x3e, 0x3a, 0x6c, 0xb9, 0x15, 0x1a,
-	0xe0, 0x3e, 0xb4, 0x03, 0xc2, 0xfd, 0x98, 0xce, 0x04, 0x65, 0x51, 0xb7, 0x22, 0x37, 0xf2, 0x21,
-	0xfc, 0x0c, 0x56, 0x03, 0x4f, 0x90, 0xb1, 0x1f, 0x13, 0x4f, 0x90, 0xa0, 0x5b, 0xd5, 0x29, 0x9e,
-	0x20, 0xa7, 0x2a, 0x84, 0x9f, 0x42, 0x5b, 0xa5, 0x84, 0x8c, 0x93, 0xa0, 0x5b, 0x93, 0x19, 0x20,
-	0x33, 0x64, 0x04, 0xef, 0x42, 0x83, 0xf2, 0x31, 0x9b, 0x91, 0xa8, 0xbb, 0xd2, 0x47, 0x87, 0x4d,
-	0xb7, 0x4e, 0xf9, 0xf5, 0x8c, 0x44, 0xce, 0xdf, 0x08, 0x9a, 0x23, 0xe2, 0x27, 0x31, 0x15, 0xf7,
-	0xff, 0x01, 0xdb, 0x1e, 0xb4, 0xf8, 0x2d, 0x8b, 0x45, 0xe4, 0x4d, 0x89, 0x06, 0x96, 0x05, 0x4a,
-	0xc8, 0x6b, 0x65, 0xe4, 0x8f, 0xa1, 0xa5, 0x34, 0x1a, 0xd3, 0x40, 0x42, 0x6b, 0xb9, 0x4d, 0x15,
-	0xb8, 0x0c, 0xf0, 0x0b, 0xc0, 0xfc, 0xd6, 0x8b, 0x09, 0x1f, 0xb3, 0x44, 0x70, 0xe1, 0x45, 0x01,
-	0x8d, 0x6e, 0xba, 0xf5, 0x3e, 0x3a, 0x44, 0xee, 0xa6, 0xda, 0xb9, 0xce, 0x36, 0xf0, 0x3e, 0x40,
-	0xe8, 0x71, 0x31, 0x9e, 0xc5, 0xd4, 0x27, 0xdd, 0x86, 0x4c, 0x6b, 0xa5, 0x91, 0x57, 0x69, 0xc0,
-	0xf9, 0x03, 0xc1, 0xca, 0x75, 0x1c, 0x90, 0xb8, 0xc4, 0xb3, 0x07, 0xcd, 0x84, 0x93, 0x58, 0x92,
-	0x50, 0x24, 0xe7, 0xeb, 0x54, 0x5a, 0xae, 0xf5, 0x49, 0x21, 0x2a, 0x8e, 0x60, 0x42, 0x1a, 0xa4,
-	0x49, 0xc8, 0xb4, 0x50, 0x54, 0x37, 0xcd, 0xce, 0x68, 0xae, 0xc9, 0x0e, 0xd4, 0xbd, 0x29, 0x4b,
-	0x22, 0x21, 0xd9, 0x22, 0x57, 0xaf, 0x30, 0x86, 0x9a, 0xcf, 0xb8, 0xd0, 0xec, 0xe4, 0x77, 0x49,
-	0xbf, 0x46, 0x49, 0x3f, 0xe7, 0x2d, 0xb4, 0x5e, 0xb1, 0x58, 0xbc, 0x61, 0x21, 0x65, 0x05, 0x1e,
-	0xc8, 0xe2, 0xb1, 0x03, 0x75, 0xc1, 0xee, 0x48, 0xc4, 0x25, 0x43, 0xe4, 0xea, 0x15, 0xfe, 0x14,
-	0x0c, 0x19, 0x4a, 0x78, 0xb7, 0xda, 0xaf, 0x1e, 0xb6, 0x8f, 0x37, 0x8e, 0xf4, 0x14, 0x9b, 0xc9,
-	0x70, 0x73, 0x39, 0xce, 0x2f, 0x08, 0xb6, 0x2e, 0x88, 0x90, 0x52, 0x0e, 0x19, 0xbb, 0x73, 0xc9,
-	0xdb, 0x84, 0x70, 0x51, 0x6c, 0x25, 0xb2, 0x5a, 0x69, 0xc9, 0x58, 0x29, 0xc9, 0x98, 0xc7, 0x5e,
-	0xb5, 0xb0, 0xef, 0x03, 0x70, 0x1a, 0xf9, 0x64, 0x9c, 0x32, 0xd7, 0xd2, 0xb6, 0x64, 0xe4, 0xcc,
-	0x13, 0x04, 0x77, 0x60, 0x25, 0xa4, 0x53, 0xaa, 0x14, 0x5d, 0x71, 0xd5, 0xc2, 0xf9, 0x1a, 0x36,
-	0x73, 0x10, 0xf9, 0x8c, 0x45, 0x9c, 0xe0, 0x03, 0xa8, 0xb3, 0x34, 0xc8, 0xbb, 0x48, 0x32, 0x5d,
-	0x33, 0x4c, 0x65, 0xaa, 0xab, 0x37, 0x9d, 0xdf, 0x10, 0x7c, 0x38, 0xe7, 0xae, 0xe9, 0x9d, 0x40,
-	0x7b, 0x92, 0xdc, 0x8f, 0x59, 0x3c, 0xe6, 0x24, 0x0c, 0x25, 0xc1, 0xf5, 0xe3, 0x67, 0x25, 0xa5,
-	0x54, 0xf6, 0xd1, 0x30, 0xb9, 0xbf, 0x8e, 0x47, 0x24, 0x0c, 0xdd, 0xd6, 0xc4, 0x7c, 0xe6, 0x7a,
-	0x5f, 0x29, 0xf4, 0x7e, 0xe9, 0x8c, 0x15, 0xa4, 0xad, 0x15, 0xa5, 0x75, 0x9e, 0x40, 0x6b, 0xfe,
-	0x6b, 0xb8, 0x01, 0xd5, 0xe1, 0xeb, 0x1f, 0x36, 0x3e, 0xc0, 0x4d, 0xa8, 0x8d, 0xce, 0xaf, 0xae,
-	0x36, 0x90, 0xf3, 0x1c, 0x3a, 0xca, 0x7b, 0x4e, 0xfc, 0xf4, 0xce, 0xce, 0xb5, 0x30, 0x13, 0x87,
-	0xb2, 0x89, 0x73, 0x76, 0x61, 0x3b, 0x6d, 0xed, 0x8c, 0x44, 0xea, 0x08, 0xd7, 0x7c, 0x9c, 0x21,
-	0xec, 0xd8, 0x1b, 0xba, 0xcc, 0x21, 0x34, 0x14, 0x14, 0x2e, 0x2b, 0xb5, 0x8f, 0xd7, 0x8d, 0x26,
-	0x2a, 0xd3, 0x35, 0xdb, 0xce, 0xb6, 0x9c, 0x9b, 0xf9, 0xb8, 0x9a, 0xd2, 0x17, 0xd0, 0x29, 0x86,
-	0x75, 0xe1, 0x01, 0xb4, 0x66, 0x26, 0xa8, 0x4b, 0x6f, 0x9a, 0xd2, 0x59, 0x76, 0x96, 0xe3, 0x08,
-	0xd8, 0xbd, 0x20, 0xc2, 0x74, 0xe2, 0x94, 0xf1, 0x39, 0x7c, 0x5b, 0x61, 0x54, 0x52, 0x78, 0x1f,
-	0x60, 0x42, 0x6e, 0x68, 0xa4, 0x46, 0x4c, 0x8d, 0x67, 0x4b, 0x46, 0xe4, 0x88, 0x3d, 0x82, 0x26,
-	0x89, 0x02, 0xb5, 0xa9, 0xda, 0xd3, 0x20, 0x51, 0x90, 0x6e, 0xa5, 0xde, 0xde, 0x2d, 0xff, 0xac,
-	0xe6, 0x70, 0x0a, 0x2b, 0xa9, 0xae, 0x66, 0xdc, 0x5e, 0x18, 0xfc, 0x0f, 0x1d, 0x38, 0xca, 0x47,
-	0x5d, 0x75, 0xb6, 0xf7, 0x25, 0xac, 0xe6, 0xc3, 0x69, 0xe3, 0x24, 0x10, 0xc5, 0x42, 0x7e, 0xcf,
-	0x9b, 0x59, 0xc9, 0x35, 0xf3, 0x2b, 0xd8, 0x52, 0x36, 0xa1, 0x1b, 0xa1, 0xb5, 0xb0, 0x5c, 0x1d,
-	0x95, 0x5c, 0xdd, 0xf9, 0x18, 0x3a, 0xc5, 0x83, 0x9a, 0x8d, 0xe5, 0x9b, 0xce, 0x47, 0xb0, 0x99,
-	0x4d, 0x84, 0x29, 0x6f, 0x27, 0xed, 0x40, 0xe7, 0x24, 0x98, 0xd2, 0x68, 0x44, 0xe2, 0x77, 0xd4,
-	0x27, 0xa6, 0x98, 0x73, 0x00, 0x5b, 0x67, 0x24, 0x24, 0x36, 0x3a, 0xfb, 0xf8, 0xaf, 0x28, 0x3d,
-	0x1f, 0x8c, 0xe6, 0xfe, 0xf3, 0xaf, 0xec, 0xe6, 0xbc, 0xe0, 0x6a, 0x15, 0x29, 0xfe, 0x81, 0x11,
-	0x7f, 0x51, 0xb9, 0x85, 0x56, 0xd7, 0xfb, 0x36, 0xf7, 0x38, 0x2e, 0x95, 0xad, 0xf8, 0x18, 0x56,
-	0xac, 0xc7, 0xd0, 0x39, 0x83, 0x6d, 0xc5, 0xd7, 0x36, 0x16, 0xfb, 0x35, 0x2a, 0x10, 0xab, 0x58,
-	0x97, 0xfd, 0x77, 0x04, 0x1d, 0x97, 0x70, 0x16, 0xbe, 0xb3, 0x74, 0x7b, 0xaf, 0x1c, 0xdf, 0x43,
-	0x3b, 0x4e, 0x0f, 0x25, 0x29, 0x4e, 0xa3, 0xc7, 0xc0, 0xe8, 0xb1, 0xa8, 0x5e, 0xce, 0xd0, 0xcc,
-	0x39, 0x37, 0x5f, 0xa3, 0x77, 0x09, 0xb8, 0x9c, 0xb2, 0xfc, 0x9e, 0x61, 0xa8, 0xfd, 0x44, 0xf5,
-	0x23, 0xd4, 0x74, 0xe5, 0x77, 0x6a, 0x3a, 0x16, 0x04, 0x35, 0x22, 0xc7, 0x7f, 0x55, 0x61, 0x4d,
-	0x85, 0xf4, 0xf0, 0xe0, 0x6f, 0x60, 0x35, 0xff, 0xf4, 0xe0, 0xc7, 0xb9, 0x0b, 0x65, 0x3f, 0x48,
-	0xbd, 0x47, 0x05, 0x73, 0x2f, 0xbc, 0x03, 0xd7, 0xb0, 0x5e, 0xb4, 0x33, 0xbc, 0x9f, 0xaf, 0x54,
-	0xf2, 0xbf, 0xde, 0x93, 0x87, 0xb6, 0x75, 0xc1, 0x33, 0x68, 0x0f, 0x93, 0xfb, 0xf9, 0xb0, 0xec,
-	0x3e, 0xf0, 0x2e, 0xf4, 0xf6, 0x8a, 0xe6, 0x68, 0x59, 0xf2, 0x79, 0x7a, 0xd3, 0xc3, 0xf0, 0xff,
-	0x96, 0xb9, 0x94, 0x2a, 0x65, 0xff, 0x2f, 0xc8, 0xab, 0x64, 0xdb, 0x6f, 0x56, 0x6a, 0xa1, 0x09,
-	0xbf, 0x86, 0x0d, 0xdb, 0xab, 0xf0, 0xd3, 0x87, 0x5d, 0x4c, 0x95, 0xec, 0x2f, 0xb3, 0xb9, 0xe3,
-	0x3f, 0xab, 0xb0, 0x9a, 0x77, 0x85, 0x14, 0x72, 0xde, 0x72, 0x32, 0xc8, 0x0b, 0x1c, 0x2c, 0x83,
-	0xbc, 0xd0, 0xa5, 0xce, 0x01, 0xb2, 0x0e, 0xe1, 0x6c, 0x08, 0x6c, 0xa7, 0xca, 0xca, 0x2c, 0xf2,
-	0xa7, 0x14, 0x51, 0xde, 0x9f, 0x32, 0x44, 0x0b, 0x5c, 0x6b, 0x49, 0xa9, 0xef, 0x60, 0xad, 0xe0,
-	0x39, 0x78, 0xef, 0x7d, 0x56, 0xb4, 0xa4, 0xd8, 0x4b, 0x58, 0x2f, 0xfa, 0x48, 0x36, 0xba, 0x0b,
-	0xfd, 0x65, 0x49, 0xb9, 0x2b, 0x58, 0x2b, 0x5c, 0xbe, 0x0c, 0xdb, 0x22, 0x5b, 0xe8, 0xed, 0x3f,
-	0xb0, 0xab, 0xaa, 0x0d, 0x3f, 0xf9, 0xf1, 0xf9, 0x0d, 0x15, 0xb7, 0xc9, 0xe4, 0xc8, 0x67, 0xd3,
-	0x41, 0xc0, 0xa6, 0x34, 0x62, 0x9f, 0x7d, 0x31, 0xe0, 0x7e, 0xec, 0x4d, 0xde, 0x24, 0x22, 0x89,
-	0x09, 0x1f, 0xc4, 0x33, 0x7f, 0x20, 0xff, 0x3c, 0x9a, 0xd4, 0xe5, 0x3f, 0x9f, 0xff, 0x13, 0x00,
-	0x00, 0xff, 0xff, 0x84, 0x90, 0x6c, 0xa6, 0x3b, 0x0d, 0x00, 0x00,
+	// 3630 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x5a, 0xcd, 0x73, 0xdb, 0x48,
+	0x76, 0x1f, 0x80, 0xdf, 0x4f, 0x22, 0x05, 0xb5, 0x25, 0x9b, 0xe6, 0xd8, 0x33, 0x1a, 0x78, 0x3c,
+	0xa3, 0x92, 0x67, 0xac, 0x19, 0x8d, 0x37, 0xa9, 0x6c, 0x72, 0x58, 0x4a, 0xa4, 0x24, 0xc6, 0x34,
+	0xa9, 0x80, 0x94, 0x67, 0x9d, 0xa4, 0x82, 0x40, 0x44, 0x5b, 0x42, 0x19, 0x04, 0xb8, 0x00, 0x28,
+	0x2d, 0xf7, 0x9e, 0x4d, 0x6e, 0xa9, 0x4a, 0x55, 0x2a, 0x97, 0xad, 0xca, 0xa4, 0x92, 0xff, 0x20,
+	0x95, 0x73, 0x6e, 0xa9, 0xca, 0x25, 0x97, 0x5c, 0x73, 0xc8, 0x5f, 0x91, 0x53, 0x0e, 0x5b, 0xfd,
+	0x01, 0xa0, 0xf1, 0x41, 0x52, 0xbb, 0x7b, 0x12, 0xfb, 0xbd, 0x87, 0xd7, 0xaf, 0xdf, 0x7b, 0xfd,
+	0xfa, 0xf5, 0xaf, 0x05, 0x68, 0xe6, 0xb9, 0x81, 0x7b, 0x38, 0x35, 0xbc, 0x0f, 0x38, 0x78, 0x49,
+	0x07, 0xa8, 0xcc, 0x46, 0xea, 0xdf, 0x95, 0xa0, 0xfc, 0x86, 0xfe, 0x44, 0x0d, 0x90, 0x2d, 0xb3,
+	0x29, 0xed, 0x49, 0xfb, 0x35, 0x4d, 0xb6, 0x4c, 0xb4, 0x07, 0x1b, 0x26, 0xf6, 0x27, 0x9e, 0x35,
+	0x0b, 0x2c, 0xd7, 0x69, 0xca, 0x94, 0x21, 0x92, 0xd0, 0x67, 0xb0, 0x69, 0x1a, 0x01, 0xd6, 0x27,
+	0x1e, 0x36, 0x02, 0x6c, 0x36, 0x0b, 0x5c, 0xc4, 0x08, 0xf0, 0x09, 0x23, 0xa1, 0x4f, 0x61, 0x83,
+	0x89, 0xd8, 0xae, 0x8f, 0xcd, 0x66, 0x91, 0x4a, 0x00, 0x95, 0xa0, 0x14, 0xf4, 0x31, 0x54, 0x2c,
+	0x5f, 0x77, 0x67, 0xd8, 0x69, 0x96, 0xf6, 0xa4, 0xfd, 0xea, 0xb1, 0xdc, 0x94, 0xb4, 0xb2, 0xe5,
+	0x0f, 0x67, 0xd8, 0x41, 0xdf, 0xc1, 0x06, 0xb3, 0x53, 0x0f, 0x16, 0x33, 0xdc, 0x2c, 0xef, 0x49,
+	0xfb, 0x8d, 0x23, 0xf4, 0x92, 0xaf, 0x84, 0xd9, 0x3d, 0x5e, 0xcc, 0xb0, 0x06, 0xd3, 0xe8, 0x37,
+	0x7a, 0x06, 0x75, 0x3a, 0xa5, 0x87, 0x7d, 0xd7, 0xbe, 0xc5, 0x66, 0xb3, 0x42, 0x27, 0xa5, 0xa6,
+	0x6a, 0x9c, 0x46, 0xec, 0xb2, 0xdd, 0x3b, 0xec, 0xe9, 0x57, 0xee, 0xdc, 0x31, 0x9b, 0xd5, 0x3d,
+	0x69, 0x5f, 0xd2, 0x80, 0x92, 0x8e, 0x09, 0x85, 0x08, 0xcc, 0x67, 0xb3, 0x48, 0xa0, 0xc6, 0x04,
+	0x28, 0x89, 0x09, 0x1c, 0xc1, 0xee, 0xc4, 0x75, 0x4c, 0x8b, 0x78, 0x42, 0xf7, 0xf1, 0x64, 0xee,
+	0x59, 0xc1, 0x42, 0xb7, 0xcc, 0x26, 0xd0, 0xe9, 0x1e, 0x44, 0xcc, 0x11, 0xe7, 0xf5, 0x4c, 0xf4,
+	0x10, 0xca, 0xb7, 0xae, 0x65, 0x62, 0xb3, 0xb9, 0x41, 0xd6, 0xaa, 0xf1, 0x11, 0x6a, 0x42, 0x65,
+	0x66, 0x1b, 0x0b, 0xec, 0xf9, 0xcd, 0xcd, 0xbd, 0xc2, 0x7e, 0x4d, 0x0b, 0x87, 0xe8, 0x1b, 0x00,
+	0xae, 0xdb, 0xc2, 0x7e, 0xb3, 0xbe, 0x57, 0xd8, 0xdf, 0x38, 0x52, 0x42, 0x07, 0x84, 0x9a, 0x35,
+	0x41, 0x06, 0x7d, 0x0d, 0x65, 0x3f, 0x30, 0x82, 0xb9, 0xdf, 0x6c, 0x50, 0x77, 0xed, 0x26, 0xdd,
+	0xf5, 0x72, 0x44, 0x99, 0x1a, 0x17, 0x42, 0x8f, 0xa0, 0x42, 0x9c, 0xaf, 0x1b, 0x41, 0x73, 0x8b,
+	0x1a, 0x5e, 0x26, 0xc3, 0x76, 0x80, 0x1e, 0x43, 0x95, 0x06, 0x8d, 0x70, 0x14, 0xca, 0xa9, 0xd0,
+	0x71, 0x3b, 0x50, 0xff, 0x02, 0xca, 0x4c, 0x0b, 0xaa, 0x41, 0xa9, 0xa3, 0xb5, 0x4f, 0xc7, 0xca,
+	0x47, 0xa8, 0x0a, 0xc5, 0xe1, 0x45, 0x77, 0xa0, 0x48, 0x08, 0xa0, 0x7c, 0xd2, 0x1f, 0x8e, 0xba,
+	0x1d, 0x45, 0x46, 0x9b, 0x50, 0xd5, 0xba, 0xa3, 0x61, 0xff, 0x6d, 0xb7, 0xa3, 0x14, 0x50, 0x1d,
+	0x6a, 0xa3, 0x93, 0xf3, 0x6e, 0xe7, 0xb2, 0xdf, 0xed, 0x28, 0x45, 0x22, 0x78, 0xde, 0xee, 0x8f,
+	0xbb, 0x1d, 0xa5, 0x44, 0x7e, 0xbf, 0x1d, 0xf6, 0x3a, 0xdd, 0x8e, 0x52, 0x56, 0xff, 0x4f, 0x86,
+	0x6a, 0xb8, 0xb6, 0xdf, 0x22, 0x2d, 0x9f, 0x40, 0xcd, 0xbf, 0x71, 0xbd, 0xc0, 0x31, 0xa6, 0x98,
+	0xe7, 0x64, 0x4c, 0xc8, 0x24, 0x6d, 0x31, 0x9b, 0xb4, 0x1f, 0x43, 0x8d, 0xa7, 0x9d, 0x65, 0xd2,
+	0xac, 0xac, 0x69, 0x55, 0x46, 0xe8, 0x99, 0xe8, 0x5b, 0x40, 0xfe, 0x8d, 0xe1, 0x61, 0x5f, 0x77,
+	0xe7, 0x81, 0x1f, 0x18, 0x8e, 0x69, 0x39, 0xd7, 0x34, 0x35, 0x25, 0x9a, 0xbb, 0xdb, 0x8c, 0x3b,
+	0x8c, 0x99, 0xe8, 0x29, 0x80, 0x6d, 0xf8, 0x81, 0x3e, 0xf3, 0xac, 0x09, 0xa6, 0xe9, 0x28, 0x69,
+	0x35, 0x42, 0xb9, 0x20, 0x04, 0x92, 0x15, 0x2c, 0xdc, 0x34, 0x0d, 0x6b, 0x1a, 0x1f, 0x91, 0x75,
+	0xcc, 0x5c, 0x9f, 0xe6, 0x90, 0xdf, 0xac, 0xed, 0x15, 0xf6, 0x4b, 0x5a, 0x4c, 0x20, 0x5f, 0x79,
+	0x46, 0x40, 0xe6, 0x06, 0xaa, 0x90, 0x8f, 0xd0, 0x8f, 0xe1, 0x71, 0xd6, 0x3e, 0x7d, 0x6a, 0x4d,
+	0x3c, 0xd7, 0xa7, 0x69, 0x57, 0xd0, 0x1e, 0x65, 0x4c, 0x7c, 0x43, 0xd9, 0xea, 0x0f, 0x32, 0x94,
+	0x86, 0x9e, 0x89, 0xbd, 0x8c, 0xd7, 0x5b, 0x50, 0x9d, 0xfb, 0xd8, 0xa3, 0x2e, 0x65, 0x2e, 0x8f,
+	0xc6, 0x64, 0xab, 0x88, 0xf9, 0xcf, 0x3c, 0x0e, 0x7e, 0x9c, 0xf6, 0x5f, 0x03, 0x8a, 0x04, 0xe2,
+	0xc8, 0x30, 0xc7, 0x6f, 0x87, 0x9c, 0x51, 0x14, 0xa1, 0x16, 0x94, 0x8d, 0xa9, 0x3b, 0x77, 0x82,
+	0x66, 0x29, 0xf2, 0x2a, 0xa7, 0xa0, 0x87, 0x50, 0x9c, 0xb8, 0x7e, 0x20, 0xf8, 0x9b, 0x8e, 0x33,
+	0x51, 0xad, 0x64, 0xa3, 0xfa, 0x0c, 0xea, 0x4c, 0x49, 0xe8, 0x8c, 0x2a, 0x75, 0xc6, 0x26, 0x23,
+	0x32, 0x0f, 0x90, 0xb5, 0x10, 0x7d, 0xa1, 0x48, 0x8d, 0x8a, 0x00, 0x21, 0x71, 0x17, 0xfd, 0xad,
+	0x0c, 0xd5, 0x0b, 0x1e, 0x04, 0xf4, 0x15, 0x54, 0x43, 0xf3, 0xa9, 0xaf, 0xf2, 0xf6, 0x66, 0x24,
+	0x21, 0xac, 0x4b, 0xce, 0xac, 0xeb, 0x33, 0xd8, 0x34, 0x6e, 0xb1, 0x67, 0x5c, 0x63, 0x9d, 0xae,
+	0xaf, 0x40, 0x63, 0xba, 0xc1, 0x69, 0x27, 0x64, 0x89, 0x4f, 0x81, 0x56, 0x39, 0xfd, 0xd6, 0xb0,
+	0xe7, 0xcc, 0x7b, 0x92, 0x46, 0xf3, 0xf4, 0x2d, 0x21, 0xa0, 0x17, 0xb0, 0x6d, 0x5b, 0x3f, 0x9b,
+	0x5b, 0xa6, 0x41, 0x2b, 0x12, 0x93, 0xa2, 0x0e, 0xd4, 0x14, 0x81, 0xc1, 0x84, 0x9f, 0x43, 0x63,
+	0xee, 0x78, 0xd8, 0xb0, 0xad, 0x5f, 0x60, 0x53, 0x9f, 0x39, 0x36, 0x73, 0xa8, 0x56, 0x8f, 0xa9,
+	0x17, 0x8e, 0x9d, 0x75, 0x59, 0x25, 0xeb, 0x32, 0xf5, 0xbf, 0x24, 0xa8, 0x5d, 0xb8, 0x5e, 0xf0,
+	0xde, 0xb5, 0x2d, 0x37, 0x91, 0x28, 0x52, 0x2a, 0x51, 0x5a, 0x50, 0x0e, 0xdc, 0x0f, 0xd8, 0xf1,
+	0x45, 0x07, 0x30, 0x0a, 0x7a, 0x95, 0x28, 0x74, 0x85, 0xfc, 0x42, 0x47, 0xbf, 0x10, 0xe4, 0xd0,
+	0x4b, 0x71, 0x8b, 0x14, 0x93, 0x1f, 0x85, 0x51, 0x12, 0x37, 0xcd, 0x33, 0xa8, 0xb3, 0xf9, 0xc2,
+	0x05, 0x95, 0xd8, 0x82, 0x18, 0x91, 0x2f, 0xe8, 0x9f, 0x0a, 0xf0, 0xe0, 0x0c, 0x07, 0x74, 0x23,
+	0x1c, 0xbb, 0xee, 0x07, 0x0d, 0xff, 0x6c, 0x8e, 0xfd, 0x20, 0x59, 0x16, 0xa4, 0x54, 0x59, 0x48,
+	0x6d, 0x02, 0x39, 0xb3, 0x09, 0x44, 0xc7, 0x14, 0x52, 0x8e, 0x79, 0x0a, 0xe0, 0x5b, 0xce, 0x04,
+	0xeb, 0x24, 0x5f, 0xf9, 0xc6, 0xa8, 0x51, 0x4a, 0xc7, 0x08, 0x30, 0xda, 0x81, 0x92, 0x6d, 0x4d,
+	0x2d, 0xb6, 0x1f, 0x4a, 0x1a, 0x1b, 0x90, 0x8f, 0x66, 0x24, 0x5f, 0xa8, 0xed, 0x34, 0x7e, 0x35,
+	0xad, 0x46, 0x28, 0x63, 0x42, 0x40, 0x1d, 0x00, 0xdf, 0xf5, 0x02, 0xdd, 0x25, 0xcb, 0xa0, 0x81,
+	0x6b, 0x1c, 0x3d, 0x0f, 0x7d, 0x93, 0xb3, 0xbc, 0x97, 0x23, 0xd7, 0x63, 0x44, 0xad, 0xe6, 0x87,
+	0x3f, 0xd1, 0x2b, 0x28, 0xfa, 0x96, 0x89, 0xe9, 0x5e, 0x69, 0x1c, 0xed, 0xad, 0xfc, 0xde, 0x32,
+	0xb1, 0x46, 0xa5, 0xd5, 0x43, 0x28, 0x92, 0x11, 0x42, 0xd0, 0x38, 0xbe, 0x7c, 0x37, 0xd2, 0xdb,
+	0x83, 0x8e, 0x3e, 0xea, 0xf6, 0xfb, 0x23, 0x76, 0x4e, 0x10, 0x9a, 0x22, 0x91, 0xc3, 0x83, 0x11,
+	0x65, 0xf5, 0x10, 0x6a, 0xd1, 0xf4, 0x48, 0x81, 0xcd, 0x41, 0xf7, 0xfb, 0xee, 0x68, 0xac, 0x9f,
+	0xf6, 0xb4, 0x11, 0x39, 0x5b, 0x14, 0xd8, 0x1c, 0xf6, 0x3b, 0x31, 0x45, 0x52, 0xaf, 0x60, 0x5b,
+	0x30, 0xc0, 0x9f, 0xb9, 0x8e, 0x4f, 0xb2, 0xba, 0x4c, 0x57, 0xeb, 0x37, 0x25, 0x9a, 0x0a, 0xf5,
+	0xd0, 0x5c, 0xb6, 0x2c, 0xce, 0x44, 0x5f, 0xc0, 0x96, 0x83, 0x7f, 0x1e, 0xe8, 0x82, 0xf7, 0x58,
+	0xb8, 0xea, 0x84, 0x7c, 0x11, 0x7a, 0x50, 0xfd, 0x95, 0x0c, 0x5b, 0xd1, 0x36, 0xe6, 0x39, 0xd0,
+	0x86, 0x8d, 0xab, 0xf9, 0x42, 0x77, 0x3d, 0xdd, 0xc7, 0xb6, 0x4d, 0xb3, 0xa0, 0x71, 0xf4, 0x59,
+	0x66, 0xd3, 0x73, 0x97, 0x1c, 0xcf, 0x17, 0x43, 0x6f, 0x84, 0x6d, 0x5b, 0xab, 0x5d, 0x85, 0x3f,
+	0x57, 0x96, 0x81, 0xb5, 0xa5, 0x34, 0x91, 0x83, 0xc5, 0x54, 0x0e, 0x66, 0xb6, 0x6b, 0x29, 0xa7,
+	0xc2, 0x7d, 0x09, 0x5b, 0x96, 0x89, 0xa7, 0x33, 0x37, 0xc0, 0xce, 0x64, 0xa1, 0x7f, 0xc0, 0x0b,
+	0x9e, 0x3b, 0x0d, 0x81, 0xfc, 0x1a, 0x2f, 0xd4, 0x4f, 0xa0, 0x16, 0xd9, 0x8f, 0x2a, 0x50, 0x38,
+	0xbe, 0x7c, 0xc7, 0xc2, 0x47, 0x82, 0xa6, 0x48, 0xea, 0x10, 0x76, 0x58, 0x4b, 0xd1, 0x9e, 0xd0,
+	0x6d, 0x16, 0x46, 0x21, 0x2c, 0xd1, 0x52, 0xaa, 0x44, 0xa7, 0x4a, 0xab, 0x9c, 0x29, 0xad, 0x0b,
+	0xd8, 0x25, 0x79, 0x35, 0xc3, 0x0e, 0xd3, 0xeb, 0x87, 0x4e, 0xff, 0x16, 0xaa, 0xac, 0x5b, 0xc1,
+	0x2c, 0xb2, 0x4b, 0x9b, 0x9a, 0x48, 0x8c, 0x1c, 0x39, 0x96, 0x33, 0xb1, 0xe7, 0x26, 0xd6, 0x85,
+	0xb2, 0x22, 0xd3, 0xae, 0x6b, 0x9b, 0x73, 0x46, 0x11, 0x43, 0x3d, 0x86, 0x87, 0xe9, 0xa9, 0xf9,
+	0x6a, 0xf6, 0xa1, 0xc2, 0xa6, 0x0a, 0x93, 0xaa, 0x91, 0x9c, 0x5a, 0x0b, 0xd9, 0xea, 0x2e, 0xad,
+	0x1a, 0x51, 0x25, 0xe4, 0xc6, 0xab, 0x67, 0xb0, 0x93, 0x24, 0x73, 0xc5, 0x87, 0xa4, 0x74, 0x71,
+	0x22, 0x3f, 0x3c, 0xb6, 0xe3, 0xd2, 0x15, 0x4a, 0xc7, 0x32, 0xea, 0xbf, 0x4b, 0xb0, 0x7d, 0x86,
+	0x83, 0x13, 0xc3, 0x31, 0x6d, 0x1c, 0xf9, 0x26, 0x95, 0x31, 0xd2, 0xea, 0x8c, 0x91, 0x53, 0x19,
+	0x73, 0x04, 0x55, 0xcb, 0x09, 0xb0, 0x77, 0x6b, 0xd8, 0x34, 0xd9, 0x1a, 0x47, 0x0f, 0x43, 0x1b,
+	0xd8, 0x3c, 0x3d, 0xce, 0xd5, 0x22, 0x39, 0x52, 0x77, 0xae, 0xf0, 0xb5, 0xe5, 0x24, 0x8a, 0x15,
+	0xa5, 0xd0, 0x62, 0xf5, 0x18, 0xaa, 0xd8, 0x31, 0x19, 0x93, 0xf5, 0x4e, 0x15, 0xec, 0x98, 0x84,
+	0xa5, 0xfe, 0x52, 0x82, 0x32, 0x53, 0x4b, 0x4a, 0x9a, 0x1f, 0x18, 0x5e, 0xc0, 0x0d, 0x66, 0x03,
+	0x84, 0xa0, 0x48, 0x6f, 0x02, 0x74, 0x63, 0x68, 0xf4, 0x37, 0xa1, 0xdd, 0x58, 0xd7, 0x37, 0xfc,
+	0x44, 0xa4, 0xbf, 0x91, 0x02, 0x05, 0xdb, 0xbd, 0xe3, 0x67, 0x20, 0xf9, 0x49, 0xf4, 0xd1, 0xee,
+	0x94, 0x9f, 0x78, 0x6c, 0xc0, 0xfa, 0x6d, 0x7b, 0x3e, 0xc5, 0xfc, 0x78, 0xe3, 0x23, 0xf5, 0x7f,
+	0x24, 0x40, 0xa2, 0x2b, 0x79, 0x48, 0xfe, 0x38, 0x71, 0x06, 0xb1, 0x70, 0x1f, 0x08, 0x25, 0x2f,
+	0x25, 0x1f, 0x6d, 0xf7, 0x90, 0x2e, 0x7c, 0xdd, 0xfa, 0x05, 0x6c, 0xa5, 0xd8, 0xeb, 0x43, 0x95,
+	0x68, 0x5c, 0xe5, 0x74, 0xe3, 0xba, 0x0f, 0x95, 0x09, 0xd3, 0xd4, 0x2c, 0x24, 0x33, 0x91, 0x4d,
+	0xa0, 0x85, 0x6c, 0xf5, 0x3b, 0x9a, 0x72, 0x71, 0x7a, 0xdf, 0xe7, 0x00, 0x53, 0x7b, 0xb0, 0x9b,
+	0xfa, 0x88, 0x7b, 0xe5, 0x9b, 0x1c, 0xaf, 0xac, 0xbc, 0x82, 0xa8, 0x01, 0x3c, 0x8a, 0x55, 0x2d,
+	0x48, 0xf3, 0x72, 0xff, 0x74, 0x4d, 0x66, 0x97, 0xbc, 0x2a, 0xbb, 0x0a, 0xc9, 0xec, 0xfa, 0x07,
+	0x09, 0x9a, 0xd9, 0x69, 0xf9, 0x22, 0x4e, 0xa0, 0x44, 0x2a, 0x4d, 0x68, 0xff, 0xd7, 0x42, 0x54,
+	0x73, 0x3f, 0x78, 0x29, 0x52, 0x35, 0xf6, 0x6d, 0xeb, 0xf7, 0x60, 0x53, 0x24, 0x93, 0xd4, 0xa4,
+	0x86, 0xb0, 0x55, 0xd0, 0xdf, 0x84, 0x46, 0xab, 0x1f, 0x4f, 0x61, 0xf2, 0x5b, 0xfd, 0x57, 0x09,
+	0x9e, 0x9c, 0xe1, 0xe0, 0x8d, 0x6b, 0x62, 0xfb, 0xc2, 0x73, 0xaf, 0x8c, 0x2b, 0xcb, 0xbe, 0x77,
+	0x60, 0x68, 0xa3, 0x4f, 0x6e, 0x9c, 0xac, 0x7c, 0x95, 0x34, 0x3e, 0x42, 0x7f, 0x04, 0x8d, 0x99,
+	0x61, 0x79, 0xa4, 0xbb, 0xf7, 0x17, 0x7e, 0x80, 0xa7, 0x7c, 0x07, 0x47, 0xb5, 0xf1, 0x82, 0x71,
+	0x47, 0x94, 0xa9, 0xd5, 0x67, 0xe2, 0x90, 0x5c, 0xa3, 0x7c, 0x6b, 0x3a, 0xb7, 0x8d, 0xb0, 0x77,
+	0x22, 0xaa, 0x45, 0x92, 0xfa, 0xcf, 0x32, 0x3c, 0x5d, 0x62, 0x35, 0x77, 0xaa, 0x0e, 0xf5, 0x99,
+	0xc8, 0xe0, 0xce, 0xfd, 0x03, 0xc1, 0xb9, 0xcb, 0xbf, 0x8e, 0x3c, 0x1c, 0x73, 0x17, 0x5a, 0x52,
+	0x5f, 0xeb, 0x07, 0x09, 0x1e, 0xe4, 0x88, 0xfd, 0xae, 0x3b, 0xe9, 0x05, 0x6c, 0x4f, 0x89, 0x5d,
+	0x7a, 0x3c, 0xdb, 0x82, 0xd7, 0x17, 0x65, 0x9a, 0x34, 0x78, 0x91, 0xba, 0xbc, 0x15, 0x53, 0x97,
+	0x37, 0xf5, 0xff, 0x25, 0x78, 0xc0, 0x6e, 0x18, 0xfc, 0x3c, 0xe0, 0x21, 0x4d, 0x5d, 0x53, 0xa5,
+	0xec, 0x35, 0x35, 0x05, 0x6e, 0xc8, 0xf7, 0x02, 0x37, 0x52, 0xb8, 0x45, 0x61, 0x1d, 0x6e, 0x51,
+	0xbc, 0x3f, 0x6e, 0x51, 0x5a, 0x8e, 0x5b, 0x08, 0xf8, 0x44, 0x39, 0x81, 0x4f, 0xa8, 0x5f, 0xc0,
+	0x4e, 0x72, 0xf5, 0x3c, 0x35, 0x52, 0xf7, 0x47, 0xf5, 0x19, 0x6c, 0xc7, 0xa7, 0x6b, 0xe8, 0xa3,
+	0xb4, 0xd0, 0xe7, 0x80, 0x28, 0x2a, 0xb4, 0x5a, 0xea, 0x19, 0x6c, 0x9f, 0x1b, 0x76, 0xb0, 0x56,
+	0xe8, 0xad, 0x6b, 0x99, 0xab, 0x85, 0xfe, 0x0c, 0x76, 0x47, 0x93, 0x1b, 0x6c, 0xce, 0xed, 0xd5,
+	0x53, 0x8a, 0x20, 0x89, 0xbc, 0x14, 0x24, 0x29, 0x24, 0x41, 0x92, 0x87, 0xb0, 0xd3, 0x36, 0xa7,
+	0x96, 0x33, 0xc2, 0xde, 0xad, 0x35, 0xc1, 0xa1, 0x67, 0xd4, 0xe7, 0xf0, 0xa0, 0x83, 0x6d, 0x1c,
+	0xac, 0x59, 0xe5, 0x7f, 0xc8, 0xe4, 0x7b, 0xf3, 0x37, 0x2b, 0xe2, 0xa8, 0x9b, 0xa8, 0xd5, 0x32,
+	0xdd, 0x8e, 0x51, 0xd3, 0x9f, 0xa7, 0x2e, 0xb7, 0x80, 0xb7, 0xfe, 0x53, 0x12, 0x00, 0x98, 0xf5,
+	0x99, 0xbc, 0x7a, 0xb7, 0xc5, 0xf0, 0x46, 0x61, 0x39, 0xbc, 0x51, 0x4c, 0xc3, 0x1b, 0x87, 0xf0,
+	0xc0, 0x72, 0xac, 0xc0, 0x32, 0x92, 0xbb, 0x94, 0x1d, 0xef, 0x88, 0xb3, 0xc4, 0x7d, 0x1a, 0xe3,
+	0x21, 0x65, 0x11, 0x0f, 0x51, 0x3b, 0xb0, 0xcb, 0xfc, 0x9d, 0x6e, 0xe5, 0xd3, 0x41, 0x5e, 0xd5,
+	0x28, 0xa9, 0x7f, 0x25, 0xc3, 0x0e, 0x07, 0x0f, 0x93, 0x71, 0x5b, 0x19, 0x8e, 0x3f, 0x81, 0x0d,
+	0x8a, 0x42, 0xce, 0xd9, 0x22, 0x59, 0x3c, 0x0e, 0xc3, 0x78, 0xe4, 0xe9, 0x8b, 0xe3, 0x11, 0x7d,
+	0xa7, 0x89, 0x3a, 0x48, 0xa3, 0xc3, 0xae, 0xf6, 0x6c, 0xeb, 0xb3, 0x01, 0x8d, 0x00, 0x87, 0x72,
+	0x98, 0x2f, 0x6b, 0x5a, 0x4c, 0x68, 0xf5, 0x00, 0x65, 0xd5, 0xae, 0x2f, 0xa2, 0x08, 0x8a, 0x77,
+	0x96, 0x13, 0x76, 0xcd, 0xf4, 0xb7, 0xfa, 0x08, 0x76, 0x53, 0x66, 0xf3, 0xb4, 0xfe, 0x47, 0x09,
+	0x2a, 0xfc, 0xc0, 0xa1, 0x37, 0x53, 0x1a, 0x63, 0xdd, 0x75, 0xc2, 0xd3, 0xb1, 0xc6, 0x28, 0x43,
+	0x07, 0x0b, 0xec, 0xe0, 0xce, 0x0d, 0xf3, 0x85, 0x51, 0xc6, 0x77, 0x2e, 0x3a, 0x80, 0xed, 0xf8,
+	0x6b, 0x9d, 0xc7, 0x94, 0xad, 0x76, 0x2b, 0x52, 0xa2, 0x51, 0xb2, 0x20, 0x1b, 0xdc, 0xb9, 0xa1,
+	0x6c, 0x51, 0x94, 0x1d, 0xdf, 0xb9, 0x4c, 0x56, 0xfd, 0x6b, 0x09, 0x3e, 0x0e, 0x6b, 0x55, 0x30,
+	0xb9, 0x99, 0xcf, 0x52, 0xb7, 0x8c, 0xf5, 0x79, 0xfe, 0x02, 0xaa, 0xfc, 0x10, 0x0d, 0x63, 0xb9,
+	0x95, 0x3a, 0x6b, 0xb5, 0x48, 0x60, 0x55, 0x69, 0xf8, 0x06, 0x9e, 0xe4, 0x1b, 0xc2, 0x8b, 0xa7,
+	0x02, 0x05, 0xcb, 0x64, 0xa7, 0x69, 0x4d, 0x23, 0x3f, 0xd5, 0xff, 0x95, 0xe0, 0x49, 0xe4, 0xf7,
+	0x3c, 0xe3, 0xbb, 0x50, 0xf1, 0xb0, 0x3f, 0xb7, 0xa3, 0x0e, 0xe7, 0x45, 0x26, 0xcb, 0x72, 0x3e,
+	0x23, 0xcc, 0xb9, 0x1d, 0x68, 0xe1, 0xb7, 0xad, 0x05, 0x94, 0x19, 0x69, 0x75, 0x5e, 0xef, 0x83,
+	0x22, 0x84, 0xc8, 0x9f, 0xb8, 0x1e, 0xe6, 0xcd, 0x49, 0x23, 0x8a, 0xd0, 0x88, 0x50, 0x05, 0x49,
+	0x12, 0x20, 0x26, 0x59, 0x10, 0x25, 0xc7, 0x77, 0x2e, 0x95, 0x54, 0x7f, 0x90, 0x00, 0xce, 0x8c,
+	0x29, 0xe6, 0xf3, 0xef, 0x40, 0x89, 0xf6, 0x39, 0x74, 0xee, 0x92, 0xc6, 0x06, 0x42, 0x2d, 0x91,
+	0x13, 0xb5, 0xa4, 0x05, 0x55, 0x77, 0x36, 0x73, 0x1d, 0xec, 0x84, 0xce, 0x8e, 0xc6, 0x04, 0x5a,
+	0xe3, 0x26, 0xb0, 0xe9, 0x79, 0xab, 0xc3, 0x68, 0xcc, 0xca, 0xe7, 0xd0, 0x08, 0xc5, 0xb9, 0x10,
+	0x43, 0x5a, 0xea, 0x21, 0x95, 0x99, 0x88, 0xa1, 0x39, 0x9a, 0x5f, 0x4d, 0xad, 0x20, 0xb6, 0xf3,
+	0x7e, 0x65, 0xf9, 0xab, 0x38, 0x3a, 0x2c, 0x6f, 0xa2, 0x63, 0x3e, 0xd6, 0x14, 0x05, 0x41, 0xfd,
+	0x11, 0x3c, 0xce, 0x99, 0x86, 0xe7, 0x46, 0x13, 0x2a, 0x93, 0x1b, 0xc3, 0xb9, 0xc6, 0xa1, 0x67,
+	0xc2, 0xa1, 0xfa, 0x6f, 0x12, 0x6c, 0xb0, 0xf0, 0xb2, 0x45, 0xad, 0xb4, 0xe8, 0xf7, 0x01, 0x26,
+	0x37, 0x78, 0xf2, 0x61, 0xe6, 0x5a, 0x1c, 0x88, 0x68, 0x1c, 0x3d, 0x8a, 0x9a, 0x7a, 0xf2, 0xfd,
+	0x49, 0xc4, 0xd6, 0x04, 0x51, 0x12, 0x97, 0x2b, 0xcf, 0xe2, 0xc5, 0x5c, 0xd2, 0xd8, 0x80, 0x24,
+	0xbb, 0xed, 0x5e, 0xeb, 0xb6, 0xeb, 0xfb, 0x7c, 0xfb, 0x55, 0x6c, 0xf7, 0xba, 0xef, 0xfa, 0x7e,
+	0xf4, 0x02, 0x44, 0xfd, 0x1a, 0x76, 0x19, 0xf4, 0x05, 0x88, 0x4e, 0x63, 0xaa, 0x3f, 0xa2, 0x77,
+	0x6f, 0xc1, 0xf2, 0xfb, 0xdd, 0x57, 0x4e, 0xe1, 0x51, 0xe6, 0x33, 0xee, 0xa3, 0x17, 0x50, 0xa6,
+	0xb3, 0x85, 0x7b, 0xe1, 0x41, 0xb2, 0xa9, 0xa2, 0xd2, 0x1a, 0x17, 0x51, 0x7f, 0x25, 0xd1, 0x8b,
+	0x4f, 0x1f, 0x1b, 0x26, 0xf6, 0xae, 0x5c, 0xc3, 0x33, 0xef, 0x15, 0xd2, 0xdf, 0xfa, 0x9e, 0x12,
+	0xa3, 0x79, 0x45, 0x11, 0xcd, 0x7b, 0x08, 0x65, 0xf7, 0xfd, 0x7b, 0x1f, 0x87, 0x20, 0x1f, 0x1f,
+	0xa9, 0xbf, 0x94, 0x41, 0x11, 0x6c, 0xeb, 0x3a, 0x81, 0xb7, 0x20, 0x95, 0xd9, 0x33, 0x9c, 0x0f,
+	0x3c, 0x03, 0xe8, 0xef, 0x95, 0x08, 0xfd, 0x97, 0xb0, 0x15, 0xe1, 0x08, 0xba, 0x78, 0x7c, 0x34,
+	0x22, 0x32, 0xc3, 0x85, 0xbf, 0x84, 0x2d, 0x7a, 0x13, 0x27, 0x97, 0x0a, 0x0e, 0xd5, 0xb2, 0x70,
+	0x36, 0x42, 0x32, 0x85, 0xc6, 0x7c, 0xb2, 0xa9, 0x12, 0xf0, 0x31, 0x3b, 0x97, 0x37, 0x44, 0xf0,
+	0xf8, 0x9e, 0x18, 0xf3, 0x17, 0xb0, 0xe5, 0xe1, 0x60, 0xee, 0x39, 0x3a, 0xe9, 0x47, 0xc9, 0x2c,
+	0xfc, 0x85, 0xa4, 0xce, 0xc8, 0x43, 0x67, 0x44, 0x88, 0xea, 0x15, 0x4d, 0x93, 0x44, 0x98, 0x78,
+	0xb8, 0x8f, 0xa0, 0x82, 0x9d, 0xc0, 0x8b, 0x2f, 0x20, 0xcd, 0x30, 0xde, 0x69, 0xc7, 0x69, 0xa1,
+	0x20, 0x09, 0x42, 0xe0, 0x06, 0x86, 0xcd, 0xcb, 0x16, 0x1b, 0xa8, 0xaf, 0x68, 0x2a, 0xfc, 0x86,
+	0xbb, 0x5b, 0x3d, 0x85, 0x87, 0xe9, 0xaf, 0xb8, 0x65, 0x5f, 0xa5, 0xab, 0xf2, 0xca, 0x7d, 0xff,
+	0x2f, 0x32, 0x6c, 0xf4, 0xb1, 0x79, 0x8d, 0x3d, 0x16, 0xe5, 0x74, 0x83, 0xf2, 0x02, 0x8a, 0x1f,
+	0x2c, 0xc7, 0x4c, 0xef, 0x56, 0xe1, 0x93, 0xd7, 0x96, 0x63, 0x6a, 0x54, 0x88, 0x04, 0xe8, 0xbd,
+	0xe7, 0x4e, 0x75, 0x63, 0x32, 0xa1, 0x58, 0x23, 0x7f, 0x9b, 0x25, 0xb4, 0x36, 0x23, 0x91, 0x14,
+	0x0e, 0xdc, 0x48, 0x80, 0x03, 0x39, 0x81, 0x1b, 0xb2, 0xef, 0x85, 0x26, 0x26, 0x1c, 0x53, 0x4e,
+	0xed, 0x91, 0xc7, 0x50, 0xa5, 0x90, 0x2b, 0xe1, 0xb1, 0x07, 0x99, 0x0a, 0x1d, 0xb3, 0xde, 0x82,
+	0xee, 0x8d, 0xaa, 0x70, 0x75, 0x7e, 0x0e, 0x8d, 0x2b, 0xc3, 0x36, 0x08, 0x0e, 0x9e, 0x78, 0x7e,
+	0xa9, 0x73, 0x2a, 0x87, 0x09, 0xfb, 0xa0, 0xd0, 0x44, 0x20, 0xab, 0x0e, 0xe3, 0x93, 0xdc, 0x8d,
+	0xd2, 0xaa, 0xdd, 0x28, 0x27, 0x51, 0x03, 0x0b, 0xb6, 0x05, 0x6d, 0x3c, 0x6e, 0x5f, 0xa7, 0x33,
+	0xea, 0x41, 0x8e, 0xb3, 0xe3, 0x64, 0xca, 0x1a, 0x2e, 0xe7, 0x19, 0x7e, 0x09, 0xe8, 0xcc, 0x33,
+	0x9c, 0x80, 0x6d, 0xa1, 0xd0, 0xf4, 0x55, 0x0f, 0x26, 0x99, 0x10, 0xc8, 0x39, 0xef, 0x2f, 0xff,
+	0x2d, 0x01, 0xea, 0x39, 0xb7, 0x86, 0x67, 0x19, 0x4e, 0xf0, 0xd6, 0x72, 0xd9, 0xfd, 0x9d, 0xe0,
+	0x8b, 0x56, 0x48, 0xe5, 0x38, 0x75, 0x84, 0x2f, 0x46, 0xe2, 0x5a, 0x2c, 0xb3, 0x1a, 0x28, 0x5c,
+	0x0b, 0x4c, 0x8b, 0xcb, 0x28, 0x66, 0xde, 0x7d, 0xaa, 0xf8, 0xe7, 0x33, 0x3c, 0x09, 0xa2, 0xfa,
+	0x1f, 0x8d, 0x49, 0xdd, 0x33, 0x26, 0xc1, 0xdc, 0xb0, 0x79, 0xf6, 0xf0, 0x91, 0xda, 0x80, 0xcd,
+	0xf6, 0xdc, 0xb4, 0xc2, 0xbe, 0x58, 0x7d, 0x0d, 0x75, 0x3e, 0xe6, 0x31, 0xfa, 0x31, 0xc0, 0x6d,
+	0xb8, 0xd8, 0x30, 0x4c, 0xad, 0xcc, 0x02, 0x23, 0x7f, 0x68, 0x82, 0xf4, 0xc1, 0x4f, 0x00, 0xe2,
+	0xfb, 0x35, 0x79, 0x95, 0xee, 0xfe, 0xf4, 0xa4, 0x7f, 0x39, 0xea, 0xbd, 0xed, 0x2a, 0x1f, 0x91,
+	0x97, 0xe8, 0xe3, 0xde, 0xa0, 0xad, 0xbd, 0x63, 0x4f, 0xd9, 0xa3, 0x93, 0x76, 0xbf, 0xad, 0x29,
+	0x32, 0xda, 0x80, 0x8a, 0xd6, 0x1e, 0xbc, 0xee, 0x0d, 0xce, 0x94, 0xc2, 0xc1, 0x1f, 0x42, 0x23,
+	0x09, 0x90, 0xa2, 0x06, 0xc0, 0x70, 0xd0, 0xd5, 0xdf, 0xf4, 0x06, 0x97, 0x63, 0xa2, 0x66, 0x13,
+	0xaa, 0x64, 0x7c, 0x3e, 0xbc, 0xd4, 0x14, 0x89, 0x7c, 0x4c, 0x46, 0x9d, 0xf6, 0x3b, 0x45, 0x3e,
+	0x68, 0x43, 0x3d, 0x81, 0xcd, 0xa0, 0x2d, 0xd8, 0xd0, 0x86, 0x97, 0x83, 0x8e, 0xae, 0x0d, 0x8f,
+	0x7b, 0x03, 0xe5, 0x23, 0xfa, 0x34, 0xf2, 0x7d, 0x6f, 0x44, 0x5e, 0x49, 0x76, 0x40, 0x21, 0x73,
+	0xea, 0xc3, 0x53, 0x7d, 0x7c, 0xde, 0xd5, 0xcf, 0x7b, 0xfd, 0xbe, 0x22, 0x1f, 0x9c, 0xc3, 0x56,
+	0xea, 0x94, 0x46, 0xdb, 0x50, 0x1f, 0xf7, 0xde, 0x74, 0xf5, 0xef, 0xbb, 0xbd, 0xb3, 0x73, 0xf2,
+	0xa8, 0x2e, 0xbe, 0xc9, 0xd7, 0xa1, 0x76, 0xda, 0x1b, 0xb4, 0xfb, 0xcc, 0x02, 0xa2, 0x9f, 0x3e,
+	0xd1, 0x2b, 0x85, 0x83, 0xb7, 0xb0, 0x95, 0xaa, 0x20, 0x84, 0x7b, 0xa6, 0xb5, 0x07, 0x63, 0x66,
+	0xc8, 0x58, 0x6b, 0x77, 0xba, 0x8a, 0x44, 0x9e, 0x00, 0x4e, 0xbb, 0x5d, 0x45, 0x26, 0x4e, 0xb9,
+	0x68, 0xbf, 0x1b, 0x5e, 0x8e, 0x95, 0x02, 0xf9, 0xad, 0x75, 0x4f, 0x2f, 0x07, 0xe4, 0x39, 0x7f,
+	0x13, 0xaa, 0x63, 0xad, 0x3d, 0x18, 0x9d, 0x76, 0x35, 0xa5, 0x74, 0xf0, 0x97, 0x50, 0x8b, 0xa2,
+	0x80, 0x1e, 0x02, 0x1a, 0x9d, 0xb7, 0xb5, 0xee, 0x48, 0x1f, 0x5e, 0x8e, 0x47, 0xe3, 0xf6, 0xa0,
+	0x43, 0xdc, 0xf8, 0x11, 0x71, 0x5a, 0xbf, 0x3d, 0x1a, 0xeb, 0x17, 0x5a, 0xef, 0x84, 0xcc, 0x41,
+	0xd6, 0x30, 0x7c, 0xdd, 0x1d, 0xe8, 0xc7, 0xed, 0x7e, 0x7b, 0x70, 0x42, 0x66, 0x6b, 0xc2, 0xce,
+	0x60, 0xa8, 0x0f, 0xba, 0x67, 0xed, 0x71, 0xef, 0x2d, 0xf1, 0x67, 0x9f, 0x7c, 0x3b, 0x52, 0x0a,
+	0x47, 0x7f, 0x53, 0x85, 0x3a, 0x3f, 0xd1, 0xd9, 0x1d, 0x1b, 0x9d, 0xc2, 0xa6, 0xf8, 0x32, 0x85,
+	0x3e, 0x5e, 0xf1, 0x5e, 0xd5, 0x7a, 0x9c, 0x78, 0x1d, 0x4a, 0x3c, 0x24, 0x0d, 0xa1, 0x91, 0x7c,
+	0x0e, 0x40, 0x4f, 0x45, 0x4d, 0x99, 0x17, 0x8a, 0xd6, 0x27, 0xcb, 0xd8, 0x5c, 0x61, 0x07, 0x36,
+	0x8e, 0xe7, 0x8b, 0xe8, 0x4a, 0xfd, 0x68, 0xc9, 0x83, 0x51, 0xeb, 0x49, 0xb2, 0x53, 0x49, 0xbd,
+	0xac, 0x74, 0x09, 0xfe, 0x68, 0xdb, 0xbf, 0xab, 0x9a, 0x1e, 0xf5, 0x52, 0xfc, 0x64, 0x2b, 0x7a,
+	0x29, 0xfd, 0x7c, 0xd1, 0x7a, 0x92, 0xcf, 0xe4, 0xaa, 0x2e, 0x69, 0x2d, 0x4e, 0x20, 0xa8, 0xe8,
+	0xd3, 0xe5, 0xd8, 0x2a, 0x53, 0xb9, 0xb7, 0x0e, 0x7c, 0x45, 0x7d, 0xa8, 0x27, 0xb0, 0x68, 0xf4,
+	0x24, 0xfb, 0x49, 0x8c, 0x61, 0xb4, 0x9e, 0x2e, 0xe1, 0x46, 0xd8, 0x2f, 0xc4, 0xe0, 0x3d, 0x7a,
+	0x9c, 0x07, 0xe8, 0x33, 0x3d, 0xad, 0xe5, 0x58, 0x3f, 0x32, 0x61, 0x37, 0x17, 0xce, 0x44, 0x9f,
+	0xaf, 0x41, 0x3b, 0x99, 0xea, 0xe7, 0xf7, 0xc2, 0x44, 0x79, 0xe2, 0x09, 0xad, 0x44, 0x22, 0xf1,
+	0xb2, 0x8d, 0x49, 0xeb, 0x93, 0x65, 0x6c, 0xae, 0x50, 0x83, 0xad, 0x54, 0x97, 0x8c, 0xc4, 0x4f,
+	0x72, 0xba, 0xee, 0xd6, 0xa7, 0x4b, 0xf9, 0x09, 0x23, 0x85, 0xde, 0x2a, 0x61, 0x64, 0xb6, 0x91,
+	0x6e, 0x7d, 0xb2, 0x8c, 0xcd, 0x15, 0xfe, 0x04, 0x6a, 0xd1, 0x19, 0x8c, 0x9a, 0x09, 0x61, 0xe1,
+	0x90, 0x6f, 0x3d, 0xce, 0xe1, 0x30, 0x0d, 0x47, 0x7f, 0x5f, 0x83, 0x4d, 0x11, 0x6d, 0x23, 0x39,
+	0x2e, 0xe2, 0x92, 0x71, 0x8e, 0xe7, 0x60, 0xb5, 0xad, 0x27, 0xf9, 0xcc, 0x68, 0xd7, 0x41, 0xbc,
+	0xa5, 0xe3, 0xf4, 0xc9, 0xc0, 0x99, 0xb1, 0x9a, 0x3c, 0xdc, 0x0f, 0x9d, 0xc1, 0x86, 0x00, 0x6e,
+	0xa2, 0x28, 0xd7, 0xb2, 0x88, 0xe7, 0x1a, 0x45, 0x5d, 0x80, 0x18, 0xff, 0x8c, 0xed, 0xc9, 0x60,
+	0xa2, 0xeb, 0xd5, 0xc4, 0x08, 0x69, 0xac, 0x26, 0x83, 0x9a, 0xae, 0x51, 0xf3, 0x06, 0x1a, 0x49,
+	0x0c, 0x35, 0x4e, 0x86, 0x5c, 0x6c, 0x75, 0x8d, 0xba, 0x1e, 0x6c, 0x8a, 0xe8, 0x68, 0x1c, 0xb7,
+	0x1c, 0xcc, 0x74, 0x8d, 0x2a, 0xd2, 0x31, 0x98, 0x66, 0x5e, 0x11, 0xc9, 0x03, 0x42, 0xd7, 0x2f,
+	0x33, 0x89, 0x22, 0xc6, 0xcb, 0xcc, 0x45, 0x17, 0xd7, 0xa8, 0xeb, 0x43, 0x3d, 0x01, 0xa3, 0xc5,
+	0xb6, 0xe5, 0x81, 0x82, 0xad, 0xa7, 0x4b, 0xb8, 0x5c, 0x9b, 0x11, 0x83, 0xf0, 0x22, 0xc8, 0x83,
+	0x9e, 0xa5, 0xf3, 0x3a, 0x07, 0x02, 0x6a, 0x7d, 0xbe, 0x5a, 0x88, 0x4f, 0xf1, 0xe7, 0x02, 0xee,
+	0x97, 0x98, 0xe3, 0xf3, 0xfb, 0xe0, 0x4c, 0xeb, 0x16, 0xf0, 0x53, 0xd8, 0xce, 0x20, 0x1e, 0x28,
+	0x3a, 0x26, 0x96, 0x61, 0x2e, 0xad, 0xcf, 0x56, 0x48, 0xc4, 0xbb, 0x4e, 0xe8, 0xb9, 0xe3, 0x5d,
+	0x97, 0x6d, 0xc4, 0xd7, 0x44, 0xec, 0x15, 0x94, 0x68, 0xff, 0x89, 0x76, 0x22, 0x31, 0xa1, 0x3d,
+	0x6d, 0xed, 0xa6, 0xa8, 0xec, 0xab, 0xe3, 0xaf, 0xfe, 0xf4, 0xe0, 0xda, 0x0a, 0x6e, 0xe6, 0x57,
+	0x2f, 0x27, 0xee, 0xf4, 0xd0, 0x74, 0xa7, 0x96, 0xe3, 0x7e, 0xfb, 0xea, 0xd0, 0x9f, 0x78, 0xc6,
+	0xd5, 0xfb, 0x79, 0x30, 0xf7, 0xb0, 0x7f, 0xe8, 0xcd, 0x26, 0x87, 0xf4, 0x9f, 0x72, 0xaf, 0xca,
+	0xf4, 0xcf, 0x77, 0xbf, 0x1e, 0x00, 0xe1, 0xe2, 0x77, 0xc6, 0xb1, 0x2b, 0x00, 0x00,
 }